@@ -19,7 +19,7 @@
 #   INTERVAL         - Check interval in seconds (default: 60)
 #   TIMEOUT          - Request timeout in seconds (default: 10)
 #   WAIT_TIME        - Seconds to wait for checks (default: 120)
-#   REQUIRED_CHECKS  - Number of successful checks required (default: 2)
+#   REQUIRED_CHECKS  - Consecutive successful checks required (default: 2)
 
 set -euo pipefail
 
@@ -87,41 +87,14 @@ else
     stackeye probe update "${PROBE_ID}" --url "${APP_URL}" >/dev/null 2>&1 || true
 fi
 
-# Run immediate health check
-log_info "Running immediate health check..."
-if stackeye probe test "${PROBE_ID}" 2>/dev/null; then
-    log_success "Immediate check passed"
-else
-    log_warn "Immediate check failed - this may be normal during deployment"
-fi
-
-# Wait for scheduled checks
-log_info "Waiting ${WAIT_TIME}s for scheduled checks to complete..."
-ELAPSED=0
-SUCCESS_COUNT=0
-CHECK_INTERVAL=30
-
-while [ $ELAPSED -lt $WAIT_TIME ]; do
-    sleep $CHECK_INTERVAL
-    ELAPSED=$((ELAPSED + CHECK_INTERVAL))
-
-    # Check probe status
-    STATUS=$(stackeye probe get "${PROBE_ID}" -o json 2>/dev/null | jq -r '.status // "unknown"')
-
-    if [ "$STATUS" = "up" ]; then
-        SUCCESS_COUNT=$((SUCCESS_COUNT + 1))
-        log_success "Check ${SUCCESS_COUNT}/${REQUIRED_CHECKS} passed (status: up)"
-
-        if [ $SUCCESS_COUNT -ge $REQUIRED_CHECKS ]; then
-            break
-        fi
-    else
-        log_warn "Check failed (status: ${STATUS})"
-        SUCCESS_COUNT=0  # Reset on failure
-    fi
-
-    log_info "Elapsed: ${ELAPSED}s / ${WAIT_TIME}s"
-done
+# Run an immediate check, then wait for consecutive successful scheduled checks.
+# stackeye wait exits 0 when the probe is up, 9 on timeout and 1 when the probe
+# stays down.
+log_info "Waiting up to ${WAIT_TIME}s for ${REQUIRED_CHECKS} consecutive successful checks..."
+WAIT_EXIT=0
+stackeye wait --for status=up "${PROBE_ID}" \
+    --consecutive "${REQUIRED_CHECKS}" \
+    --timeout "${WAIT_TIME}s" || WAIT_EXIT=$?
 
 # Final status check
 echo ""
@@ -136,7 +109,7 @@ PROBE_DETAILS=$(stackeye probe get "${PROBE_ID}" 2>/dev/null || echo "Unable to
 echo "$PROBE_DETAILS"
 echo ""
 
-if [ "$FINAL_STATUS" = "up" ] && [ $SUCCESS_COUNT -ge $REQUIRED_CHECKS ]; then
+if [ $WAIT_EXIT -eq 0 ]; then
     log_success "Deployment verification PASSED"
     echo ""
     echo "The service is responding correctly to health checks."
@@ -145,9 +118,12 @@ if [ "$FINAL_STATUS" = "up" ] && [ $SUCCESS_COUNT -ge $REQUIRED_CHECKS ]; then
 else
     log_error "Deployment verification FAILED"
     echo ""
-    echo "The service did not pass health checks."
+    if [ $WAIT_EXIT -eq 9 ]; then
+        echo "Timed out after ${WAIT_TIME}s waiting for ${REQUIRED_CHECKS} consecutive successful checks."
+    else
+        echo "The service did not pass health checks."
+    fi
     echo "Status: ${FINAL_STATUS}"
-    echo "Successful checks: ${SUCCESS_COUNT}/${REQUIRED_CHECKS}"
     echo ""
     echo "Check the probe history for details:"
     echo "  stackeye probe history ${PROBE_ID}"
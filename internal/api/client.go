@@ -6,6 +6,8 @@
 package api
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
@@ -286,3 +288,37 @@ func GetClientWithContext(contextName string) (*client.Client, error) {
 	apiClient := client.New(ctx.APIKey, ctx.EffectiveAPIURL(), opts...)
 	return apiClient, nil
 }
+
+// CurrentContextKey returns a stable identifier for the current context.
+//
+// The key combines the context name, its effective API URL and organization
+// ID so that anything cached under it (for example shell completion results)
+// is never served after switching contexts or organizations. The key is an
+// opaque hex digest and is safe to use as a file name.
+//
+// Returns the same errors as GetClient when no usable context is configured.
+func CurrentContextKey() (string, error) {
+	if currentConfigGetter == nil {
+		return "", ErrConfigNotLoaded
+	}
+
+	cfg := currentConfigGetter()
+	if cfg == nil {
+		return "", ErrConfigNotLoaded
+	}
+
+	if cfg.CurrentContext == "" {
+		return "", ErrNoCurrentContext
+	}
+
+	ctx, err := cfg.GetCurrentContext()
+	if err != nil {
+		if errors.Is(err, config.ErrContextNotFound) {
+			return "", fmt.Errorf("%w: %q", ErrContextNotFound, cfg.CurrentContext)
+		}
+		return "", fmt.Errorf("failed to get context: %w", err)
+	}
+
+	sum := sha256.Sum256([]byte(cfg.CurrentContext + "\x00" + ctx.EffectiveAPIURL() + "\x00" + ctx.OrganizationID))
+	return hex.EncodeToString(sum[:8]), nil
+}
@@ -432,3 +432,59 @@ func TestBuildClientOptions_NoWarningForNormalTimeout(t *testing.T) {
 		t.Errorf("expected no warning for 30s timeout, got: %s", output)
 	}
 }
+
+// TestCurrentContextKey_NoConfigGetter tests that CurrentContextKey returns an
+// error when no config getter is set.
+func TestCurrentContextKey_NoConfigGetter(t *testing.T) {
+	SetConfigGetter(nil)
+
+	key, err := CurrentContextKey()
+	if key != "" {
+		t.Errorf("expected empty key, got %q", key)
+	}
+	if !errors.Is(err, ErrConfigNotLoaded) {
+		t.Errorf("expected ErrConfigNotLoaded, got %v", err)
+	}
+}
+
+// TestCurrentContextKey_DiffersPerContext tests that the key is stable for a
+// context and changes when the context, API URL or organization changes.
+func TestCurrentContextKey_DiffersPerContext(t *testing.T) {
+	cfg := config.NewConfig()
+	cfg.CurrentContext = "prod"
+	cfg.SetContext("prod", &config.Context{
+		APIURL:         "https://api.example.com",
+		APIKey:         "se_test",
+		OrganizationID: "org-1",
+	})
+	cfg.SetContext("staging", &config.Context{
+		APIURL:         "https://api.example.com",
+		APIKey:         "se_test",
+		OrganizationID: "org-1",
+	})
+	SetConfigGetter(func() *config.Config {
+		return cfg
+	})
+	defer SetConfigGetter(nil)
+
+	prodKey, err := CurrentContextKey()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	again, _ := CurrentContextKey()
+	if prodKey != again {
+		t.Errorf("expected stable key, got %q and %q", prodKey, again)
+	}
+
+	cfg.CurrentContext = "staging"
+	stagingKey, _ := CurrentContextKey()
+	if stagingKey == prodKey {
+		t.Error("expected different keys for different contexts")
+	}
+
+	cfg.Contexts["staging"].OrganizationID = "org-2"
+	switchedKey, _ := CurrentContextKey()
+	if switchedKey == stagingKey {
+		t.Error("expected different keys after organization switch")
+	}
+}
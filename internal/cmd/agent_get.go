@@ -42,6 +42,9 @@ Examples:
 	cmd.Flags().StringVarP(&agentID, "id", "i", "", "Agent UUID (required)")
 	_ = cmd.MarkFlagRequired("id")
 
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("id", AgentCompletion())
+
 	return cmd
 }
 
@@ -31,7 +31,9 @@ Available Commands:
   ack           Acknowledge one or more alerts
   resolve       Resolve one or more alerts
   history       Show historical (resolved) alerts
+  report        Summarize MTTA, MTTR and outages over a period
   stats         Show alert statistics
+  watch         Stream new, acknowledged and resolved alerts
 
 Examples:
   # List all active alerts
@@ -57,7 +59,9 @@ For more information about a specific command:
 	cmd.AddCommand(NewAlertAckCmd())
 	cmd.AddCommand(NewAlertResolveCmd())
 	cmd.AddCommand(NewAlertHistoryCmd())
+	cmd.AddCommand(NewAlertReportCmd())
 	cmd.AddCommand(NewAlertStatsCmd())
+	cmd.AddCommand(NewAlertWatchCmd())
 
 	return cmd
 }
@@ -20,6 +20,7 @@ const alertAckTimeout = 30 * time.Second
 // alertAckFlags holds the flag values for the alert ack command.
 type alertAckFlags struct {
 	message string
+	triage  alertTriageFlags
 }
 
 // AlertAckResponse wraps the acknowledged alert data for output.
@@ -48,8 +49,9 @@ func NewAlertAckCmd() *cobra.Command {
 	flags := &alertAckFlags{}
 
 	cmd := &cobra.Command{
-		Use:   "ack <id> [id...]",
-		Short: "Acknowledge a monitoring alert",
+		Use:               "ack <id> [id...]",
+		Short:             "Acknowledge a monitoring alert",
+		ValidArgsFunction: RepeatedCompletion(AlertCompletion()),
 		Long: `Acknowledge one or more monitoring alerts.
 
 Acknowledging an alert indicates that the issue has been noticed and is being
@@ -64,6 +66,20 @@ Alert States After Acknowledgment:
   acknowledged  Alert status changes from 'active' to 'acknowledged'
                 Can still be resolved later when the issue is fixed
 
+Bulk Triage:
+  Instead of listing IDs, select alerts with --selector and/or --all-active.
+  Selector terms are comma-separated and combined with AND logic:
+    severity=<critical|warning|info>  type=<alert type>
+    probe=<name or UUID>              probe-label=<key=value|key!=value|key>
+  --older-than limits the selection to alerts triggered at least that long
+  ago. Matching alerts are processed in parallel and a summary table is
+  printed. --mute-for additionally mutes each affected probe for the given
+  duration. The selected alerts are listed and confirmed first; use --yes to
+  skip the prompt.
+
+Pass - instead of IDs to read them from stdin, one per line or as a JSON array
+of IDs or of objects with an "id" field (such as list output with -o json).
+
 Examples:
   # Acknowledge a single alert
   stackeye alert ack 550e8400-e29b-41d4-a716-446655440000
@@ -78,16 +94,33 @@ Examples:
   stackeye alert ack abc123... def456... -m "All related to network issue"
 
   # Output as JSON for scripting
-  stackeye alert ack 550e8400-e29b-41d4-a716-446655440000 -o json`,
+  stackeye alert ack 550e8400-e29b-41d4-a716-446655440000 -o json
+
+  # Acknowledge every warning alert for probes owned by the web team
+  stackeye alert ack --selector 'severity=warning,probe-label=team=web'
+
+  # Acknowledge all active alerts older than 2 hours and mute their probes for 1 hour
+  stackeye alert ack --all-active --older-than 2h --mute-for 1h
+
+  # Read alert IDs from another command's JSON output
+  stackeye alert list -s active -o json | stackeye alert ack -`,
 		Aliases: []string{"acknowledge"},
-		Args:    cobra.MinimumNArgs(1),
+		Args:    alertTriageArgs(&flags.triage),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runAlertAck(cmd.Context(), args, flags)
+			if flags.triage.targeted() {
+				return runAlertAckTriage(cmd.Context(), flags)
+			}
+			ids, _, err := readIDArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+			return runAlertAck(cmd.Context(), ids, flags)
 		},
 	}
 
 	// Define command-specific flags
 	cmd.Flags().StringVarP(&flags.message, "message", "m", "", "note to include with acknowledgment")
+	addAlertTriageFlags(cmd, &flags.triage)
 
 	return cmd
 }
@@ -213,3 +246,32 @@ func runBatchAlertAck(ctx context.Context, apiClient *client.Client, alertIDs []
 
 	return nil
 }
+
+// runAlertAckTriage acknowledges every alert selected by --selector, --all-active
+// and --older-than.
+func runAlertAckTriage(ctx context.Context, flags *alertAckFlags) error {
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	var req *client.AcknowledgeAlertRequest
+	if flags.message != "" {
+		req = &client.AcknowledgeAlertRequest{
+			Note: &flags.message,
+		}
+	}
+
+	action := alertTriageAction{
+		verb:     "acknowledge",
+		past:     "Acknowledged",
+		statuses: []client.AlertStatus{client.AlertStatusActive},
+		apply: func(ctx context.Context, apiClient *client.Client, alertID uuid.UUID) (*client.Alert, error) {
+			reqCtx, cancel := context.WithTimeout(ctx, alertAckTimeout)
+			defer cancel()
+			return client.AcknowledgeAlert(reqCtx, apiClient, alertID, req)
+		},
+	}
+
+	return runAlertTriage(ctx, apiClient, action, &flags.triage)
+}
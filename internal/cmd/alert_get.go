@@ -32,8 +32,9 @@ func NewAlertGetCmd() *cobra.Command {
 	flags := &alertGetFlags{}
 
 	cmd := &cobra.Command{
-		Use:   "get <id>",
-		Short: "Get details of a monitoring alert",
+		Use:               "get <id>",
+		Short:             "Get details of a monitoring alert",
+		ValidArgsFunction: AlertCompletion(),
 		Long: `Get detailed information about a specific monitoring alert.
 
 Displays the full alert information including status, severity, triggered time,
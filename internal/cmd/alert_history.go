@@ -46,6 +46,7 @@ Time Range Filtering:
 Time formats accepted:
   Relative:  24h, 7d, 30d (hours or days ago from now)
   Absolute:  RFC3339 format (e.g., 2024-01-15T10:30:00Z)
+  Date:      2024-01-15 (midnight UTC)
 
 Examples:
   # Show alerts from the last 24 hours
@@ -82,7 +83,8 @@ Examples:
 }
 
 // parseTimeFlag parses a time flag value into a time.Time pointer.
-// Accepts relative durations (e.g., "24h", "7d") or RFC3339 timestamps.
+// Accepts relative durations (e.g., "24h", "7d"), RFC3339 timestamps or
+// dates (e.g., "2024-01-15", midnight UTC).
 func parseTimeFlag(value string) (*time.Time, error) {
 	if value == "" {
 		return nil, nil
@@ -115,8 +117,14 @@ func parseTimeFlag(value string) (*time.Time, error) {
 
 	// Try RFC3339 format
 	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return &t, nil
+	}
+
+	// Try a plain date, taken as midnight UTC
+	t, err = time.Parse(time.DateOnly, value)
 	if err != nil {
-		return nil, fmt.Errorf("invalid time %q: use relative (24h, 7d) or RFC3339 format", value)
+		return nil, fmt.Errorf("invalid time %q: use relative (24h, 7d), a date (2024-01-15) or RFC3339 format", value)
 	}
 
 	return &t, nil
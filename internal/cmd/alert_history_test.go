@@ -162,6 +162,18 @@ func TestParseTimeFlag_RFC3339(t *testing.T) {
 	}
 }
 
+func TestParseTimeFlag_Date(t *testing.T) {
+	result, err := parseTimeFlag("2024-01-15")
+	if err != nil {
+		t.Fatalf("expected no error for date, got %v", err)
+	}
+
+	expected := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	if result == nil || !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
 func TestParseTimeFlag_InvalidFormat(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -24,6 +24,8 @@ type alertListFlags struct {
 	probeID  string
 	page     int
 	limit    int
+
+	groupByRoot bool
 }
 
 // NewAlertListCmd creates and returns the alert list subcommand.
@@ -48,6 +50,13 @@ Severity Levels:
   warning       Degraded performance or minor issue
   info          Informational, no action required
 
+Root-Cause Grouping:
+  --group-by-root reads the organization's dependency tree and collapses the
+  listed alerts under their highest failing ancestor probe, the suspected root
+  cause. Affected child probes are indented beneath it. A failing probe is one
+  that is down, unreachable or has a listed alert; grouping only follows
+  parents that are failing themselves.
+
 Examples:
   # List all alerts
   stackeye alert list
@@ -61,6 +70,9 @@ Examples:
   # List alerts for a specific probe
   stackeye alert list --probe abc123-def456-...
 
+  # Group active alerts by suspected root cause
+  stackeye alert list -s active --group-by-root --limit 100
+
   # Output as JSON for scripting
   stackeye alert list -o json
 
@@ -78,6 +90,7 @@ Examples:
 	cmd.Flags().StringVar(&flags.probeID, "probe", "", "filter by probe ID")
 	cmd.Flags().IntVar(&flags.page, "page", 1, "page number for pagination")
 	cmd.Flags().IntVar(&flags.limit, "limit", 20, "results per page (max: 100)")
+	cmd.Flags().BoolVar(&flags.groupByRoot, "group-by-root", false, "group alerts under their suspected root-cause probe")
 
 	return cmd
 }
@@ -161,6 +174,10 @@ func runAlertList(ctx context.Context, flags *alertListFlags) error {
 		return output.PrintEmpty("No alerts found")
 	}
 
+	if flags.groupByRoot {
+		return printAlertsByRoot(reqCtx, apiClient, result.Alerts)
+	}
+
 	// Print the alerts using the configured output format
 	return output.PrintAlerts(result.Alerts)
 }
@@ -0,0 +1,208 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+)
+
+// AlertRootGroup is a set of alerts that share a suspected root-cause probe.
+// This struct is exported to allow JSON/YAML serialization with proper field tags.
+type AlertRootGroup struct {
+	RootProbeID    uuid.UUID      `json:"root_probe_id" yaml:"root_probe_id"`
+	RootProbe      string         `json:"root_probe" yaml:"root_probe"`
+	RootStatus     string         `json:"root_status" yaml:"root_status"`
+	AffectedProbes int            `json:"affected_probes" yaml:"affected_probes"`
+	Alerts         []client.Alert `json:"alerts" yaml:"alerts"`
+}
+
+// alertRootGroup is a root-cause group with the dependency paths from the
+// root to each alerting probe, used to render the group as a tree.
+type alertRootGroup struct {
+	AlertRootGroup
+	children map[uuid.UUID][]uuid.UUID
+	alerts   map[uuid.UUID]int
+}
+
+// findRootCause walks up from a probe through failing parents and returns the
+// path from the highest failing ancestor down to the probe. The highest
+// ancestor is the one furthest away; ties are broken by name.
+func (g *dependencyGraph) findRootCause(probeID uuid.UUID, failing map[uuid.UUID]bool) []uuid.UUID {
+	prev := map[uuid.UUID]uuid.UUID{}
+	depth := map[uuid.UUID]int{probeID: 0}
+	queue := []uuid.UUID{probeID}
+	root := probeID
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		d := depth[id]
+		if d > depth[root] || (d == depth[root] && g.name(id) < g.name(root)) {
+			root = id
+		}
+
+		for _, parent := range g.parents[id] {
+			if _, seen := depth[parent]; seen || !failing[parent] {
+				continue
+			}
+			depth[parent] = d + 1
+			prev[parent] = id
+			queue = append(queue, parent)
+		}
+	}
+
+	path := []uuid.UUID{root}
+	for id := root; id != probeID; {
+		id = prev[id]
+		path = append(path, id)
+	}
+	return path
+}
+
+// groupAlertsByRoot groups alerts under the suspected root cause of each
+// alerting probe. Groups are sorted by alert count, then root probe name.
+func groupAlertsByRoot(alerts []client.Alert, g *dependencyGraph) []*alertRootGroup {
+	failing := make(map[uuid.UUID]bool)
+	for id := range g.nodes {
+		status := strings.ToLower(g.status(id))
+		if status == "down" || status == "unreachable" {
+			failing[id] = true
+		}
+	}
+	for _, a := range alerts {
+		failing[a.ProbeID] = true
+	}
+
+	groups := make(map[uuid.UUID]*alertRootGroup)
+	var order []uuid.UUID
+	for _, a := range alerts {
+		path := g.findRootCause(a.ProbeID, failing)
+		rootID := path[0]
+
+		group, ok := groups[rootID]
+		if !ok {
+			name := g.name(rootID)
+			if _, inTree := g.nodes[rootID]; !inTree {
+				name = alertReportProbeName(a)
+			}
+			group = &alertRootGroup{
+				AlertRootGroup: AlertRootGroup{
+					RootProbeID: rootID,
+					RootProbe:   name,
+					RootStatus:  g.status(rootID),
+				},
+				children: make(map[uuid.UUID][]uuid.UUID),
+				alerts:   make(map[uuid.UUID]int),
+			}
+			groups[rootID] = group
+			order = append(order, rootID)
+		}
+
+		group.Alerts = append(group.Alerts, a)
+		group.alerts[a.ProbeID]++
+		for i := 1; i < len(path); i++ {
+			parent, child := path[i-1], path[i]
+			if !slices.Contains(group.children[parent], child) {
+				group.children[parent] = append(group.children[parent], child)
+			}
+		}
+	}
+
+	result := make([]*alertRootGroup, 0, len(order))
+	for _, id := range order {
+		group := groups[id]
+		group.AffectedProbes = len(group.alerts)
+		result = append(result, group)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if len(result[i].Alerts) != len(result[j].Alerts) {
+			return len(result[i].Alerts) > len(result[j].Alerts)
+		}
+		return result[i].RootProbe < result[j].RootProbe
+	})
+	return result
+}
+
+// alertRootTreeNode builds the tree for a root-cause group, with each probe's
+// alert count after its name.
+func alertRootTreeNode(group *alertRootGroup, g *dependencyGraph) *output.TreeNode {
+	visited := make(map[uuid.UUID]bool)
+	var build func(id uuid.UUID, name string) *output.TreeNode
+	build = func(id uuid.UUID, name string) *output.TreeNode {
+		visited[id] = true
+		if n := group.alerts[id]; n > 0 {
+			name = fmt.Sprintf("%s (%d alert(s))", name, n)
+		}
+		node := &output.TreeNode{Name: name, Status: g.status(id)}
+		for _, child := range group.children[id] {
+			if !visited[child] {
+				node.Children = append(node.Children, build(child, g.name(child)))
+			}
+		}
+		return node
+	}
+	return build(group.RootProbeID, group.RootProbe)
+}
+
+// printAlertsByRoot fetches the dependency tree and prints alerts grouped by
+// their suspected root cause.
+func printAlertsByRoot(ctx context.Context, apiClient *client.Client, alerts []client.Alert) error {
+	orgID, err := currentOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	tree, err := client.GetOrganizationDependencyTree(ctx, apiClient, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to get dependency tree: %w", err)
+	}
+
+	return printAlertRootGroups(alerts, newDependencyGraph(tree))
+}
+
+// printAlertRootGroups prints alerts grouped by their suspected root cause in
+// g, as a tree or as []AlertRootGroup for JSON and YAML output.
+func printAlertRootGroups(alerts []client.Alert, g *dependencyGraph) error {
+	groups := groupAlertsByRoot(alerts, g)
+
+	format := output.NewPrinter(GetConfig()).Format()
+	if format == sdkoutput.FormatJSON || format == sdkoutput.FormatYAML {
+		data := make([]AlertRootGroup, 0, len(groups))
+		for _, group := range groups {
+			data = append(data, group.AlertRootGroup)
+		}
+		return output.Print(data)
+	}
+
+	nodes := make([]*output.TreeNode, 0, len(groups))
+	for _, group := range groups {
+		nodes = append(nodes, alertRootTreeNode(group, g))
+	}
+
+	fmt.Println("Suspected Root Causes")
+	fmt.Println("=====================")
+	fmt.Println()
+	output.NewTreePrinter(sdkoutput.ColorAuto, false).PrintTree(nodes)
+	fmt.Println()
+	fmt.Printf("%d alert(s) on %d probe(s), %d suspected root cause(s)\n",
+		len(alerts), countAlertProbes(alerts), len(groups))
+	return nil
+}
+
+// countAlertProbes returns the number of distinct probes with alerts.
+func countAlertProbes(alerts []client.Alert) int {
+	probes := make(map[uuid.UUID]bool)
+	for _, a := range alerts {
+		probes[a.ProbeID] = true
+	}
+	return len(probes)
+}
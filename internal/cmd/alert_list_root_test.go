@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/StackEye-IO/stackeye-go-sdk/config"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+)
+
+func TestAlertListCmd_GroupByRootFlag(t *testing.T) {
+	cmd := NewAlertListCmd()
+
+	flag := cmd.Flags().Lookup("group-by-root")
+	if flag == nil {
+		t.Fatal("expected --group-by-root flag to exist")
+	}
+	if flag.DefValue != "false" {
+		t.Errorf("--group-by-root default = %q, want false", flag.DefValue)
+	}
+}
+
+func TestGroupAlertsByRoot(t *testing.T) {
+	network := uuid.New()
+	db := uuid.New()
+	api := uuid.New()
+	worker := uuid.New()
+	web := uuid.New()
+	cdn := uuid.New()
+
+	// network (up) -> db (down) -> api, worker (unreachable)
+	//                 cdn (up) -> web (down)
+	g := testDependencyGraph([]client.DependencyTreeNode{
+		{ProbeID: network, Name: "Network", Status: "up"},
+		{ProbeID: db, Name: "Database", Status: "down"},
+		{ProbeID: api, Name: "API", Status: "down", IsUnreachable: true},
+		{ProbeID: worker, Name: "Worker", Status: "down", IsUnreachable: true},
+		{ProbeID: cdn, Name: "CDN", Status: "up"},
+		{ProbeID: web, Name: "Web", Status: "down"},
+	},
+		[2]uuid.UUID{network, db},
+		[2]uuid.UUID{db, api},
+		[2]uuid.UUID{db, worker},
+		[2]uuid.UUID{cdn, web},
+	)
+
+	alerts := []client.Alert{
+		{ID: uuid.New(), ProbeID: api},
+		{ID: uuid.New(), ProbeID: worker},
+		{ID: uuid.New(), ProbeID: api},
+		{ID: uuid.New(), ProbeID: web},
+	}
+
+	groups := groupAlertsByRoot(alerts, g)
+	if len(groups) != 2 {
+		t.Fatalf("groups = %d, want 2", len(groups))
+	}
+
+	first := groups[0]
+	if first.RootProbeID != db || first.RootProbe != "Database" || first.RootStatus != "down" {
+		t.Errorf("first root = %s (%s), want Database: the up Network probe is not a root cause", first.RootProbe, first.RootStatus)
+	}
+	if len(first.Alerts) != 3 || first.AffectedProbes != 2 {
+		t.Errorf("first group has %d alerts on %d probes, want 3 on 2", len(first.Alerts), first.AffectedProbes)
+	}
+
+	second := groups[1]
+	if second.RootProbeID != web || len(second.Alerts) != 1 {
+		t.Errorf("second group = %s with %d alerts, want Web as its own root", second.RootProbe, len(second.Alerts))
+	}
+
+	var buf bytes.Buffer
+	printer := output.NewTreePrinter(sdkoutput.ColorNever, true)
+	printer.SetWriter(&buf)
+	printer.PrintTree([]*output.TreeNode{alertRootTreeNode(first, g)})
+
+	want := "Database [DOWN]\n+-- API (2 alert(s)) [UNREACHABLE]\n`-- Worker (1 alert(s)) [UNREACHABLE]\n"
+	if buf.String() != want {
+		t.Errorf("tree =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestGroupAlertsByRoot_HighestFailingAncestor(t *testing.T) {
+	core := uuid.New()
+	regionA := uuid.New()
+	regionB := uuid.New()
+	app := uuid.New()
+
+	// core (down) -> regionA (unreachable) -> app
+	// regionB (down) -> app
+	g := testDependencyGraph([]client.DependencyTreeNode{
+		{ProbeID: core, Name: "Core", Status: "down"},
+		{ProbeID: regionA, Name: "Region A", Status: "down", IsUnreachable: true},
+		{ProbeID: regionB, Name: "Region B", Status: "down"},
+		{ProbeID: app, Name: "App", Status: "down"},
+	},
+		[2]uuid.UUID{core, regionA},
+		[2]uuid.UUID{regionA, app},
+		[2]uuid.UUID{regionB, app},
+	)
+
+	groups := groupAlertsByRoot([]client.Alert{{ID: uuid.New(), ProbeID: app}}, g)
+	if len(groups) != 1 || groups[0].RootProbeID != core {
+		t.Fatalf("root = %+v, want Core as the furthest failing ancestor", groups)
+	}
+	if children := groups[0].children[core]; len(children) != 1 || children[0] != regionA {
+		t.Errorf("path from Core = %v, want through Region A", children)
+	}
+}
+
+func TestGroupAlertsByRoot_ProbeNotInTree(t *testing.T) {
+	probeID := uuid.New()
+	alerts := []client.Alert{{ID: uuid.New(), ProbeID: probeID, Probe: &client.AlertProbe{ID: probeID, Name: "New probe"}}}
+
+	groups := groupAlertsByRoot(alerts, testDependencyGraph(nil))
+	if len(groups) != 1 || groups[0].RootProbe != "New probe" {
+		t.Errorf("groups = %+v, want the alert's own probe as root", groups)
+	}
+}
+
+// setTestOutputFormat makes the global config use format, as --output would,
+// for the duration of the test.
+func setTestOutputFormat(t *testing.T, format config.OutputFormat) {
+	t.Helper()
+	originalConfig := loadedConfig
+	t.Cleanup(func() { loadedConfig = originalConfig })
+
+	cfg := config.NewConfig()
+	cfg.Preferences = config.NewPreferences()
+	cfg.Preferences.OutputFormat = format
+	loadedConfig = cfg
+}
+
+// captureStdout returns everything fn writes to stdout.
+func captureStdout(t *testing.T, fn func() error) (string, error) {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+
+	oldStdout := os.Stdout
+	os.Stdout = w
+	outputCh := make(chan string)
+	go func() {
+		var buf bytes.Buffer
+		_, _ = io.Copy(&buf, r)
+		outputCh <- buf.String()
+	}()
+
+	runErr := fn()
+	w.Close()
+	os.Stdout = oldStdout
+	return <-outputCh, runErr
+}
+
+func TestPrintAlertRootGroups_JSON(t *testing.T) {
+	setTestOutputFormat(t, config.OutputFormatJSON)
+
+	db := uuid.New()
+	api := uuid.New()
+	g := testDependencyGraph([]client.DependencyTreeNode{
+		{ProbeID: db, Name: "Database", Status: "down"},
+		{ProbeID: api, Name: "API", Status: "down", IsUnreachable: true},
+	}, [2]uuid.UUID{db, api})
+	alerts := []client.Alert{{ID: uuid.New(), ProbeID: api}}
+
+	out, err := captureStdout(t, func() error { return printAlertRootGroups(alerts, g) })
+	if err != nil {
+		t.Fatalf("printAlertRootGroups() error = %v", err)
+	}
+
+	var groups []AlertRootGroup
+	if err := json.Unmarshal([]byte(out), &groups); err != nil {
+		t.Fatalf("expected JSON output with -o json, got:\n%s", out)
+	}
+	if len(groups) != 1 || groups[0].RootProbe != "Database" || groups[0].AffectedProbes != 1 {
+		t.Errorf("groups = %+v, want one Database group with 1 affected probe", groups)
+	}
+}
@@ -0,0 +1,775 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	clierrors "github.com/StackEye-IO/stackeye-cli/internal/errors"
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// alertReportTimeout is the maximum time to wait for all report API calls.
+const alertReportTimeout = 5 * time.Minute
+
+const (
+	// alertReportPageSize is the page size used when listing alerts.
+	alertReportPageSize = 100
+
+	// alertReportConcurrency limits parallel timeline requests.
+	alertReportConcurrency = 5
+)
+
+// Report formats accepted by --output in addition to the global formats.
+const (
+	alertReportFormatCSV      = "csv"
+	alertReportFormatMarkdown = "markdown"
+)
+
+// alertReportFlags holds the flag values for the alert report command.
+type alertReportFlags struct {
+	since         string
+	until         string
+	groupBy       string
+	flapThreshold int
+	top           int
+}
+
+// AlertReport is a reliability summary of the alerts triggered in a period.
+// This struct is exported to allow JSON/YAML serialization with proper field tags.
+type AlertReport struct {
+	From           time.Time                  `json:"from" yaml:"from"`
+	To             time.Time                  `json:"to" yaml:"to"`
+	GroupBy        string                     `json:"group_by" yaml:"group_by"`
+	Total          AlertReportGroup           `json:"total" yaml:"total"`
+	Groups         []AlertReportGroup         `json:"groups" yaml:"groups"`
+	FlappingProbes []AlertReportFlappingProbe `json:"flapping_probes" yaml:"flapping_probes"`
+	LongestOutages []AlertReportOutage        `json:"longest_outages" yaml:"longest_outages"`
+}
+
+// AlertReportGroup holds alert counts and response times for one group.
+type AlertReportGroup struct {
+	Group        string   `json:"group" yaml:"group"`
+	Alerts       int      `json:"alerts" yaml:"alerts"`
+	Critical     int      `json:"critical" yaml:"critical"`
+	Acknowledged int      `json:"acknowledged" yaml:"acknowledged"`
+	Resolved     int      `json:"resolved" yaml:"resolved"`
+	Open         int      `json:"open" yaml:"open"`
+	MTTASeconds  *float64 `json:"mtta_seconds" yaml:"mtta_seconds"`
+	MTTRSeconds  *float64 `json:"mttr_seconds" yaml:"mttr_seconds"`
+}
+
+// AlertReportFlappingProbe is a probe that went down repeatedly in the period.
+type AlertReportFlappingProbe struct {
+	ProbeID               uuid.UUID `json:"probe_id" yaml:"probe_id"`
+	Probe                 string    `json:"probe" yaml:"probe"`
+	DownAlerts            int       `json:"down_alerts" yaml:"down_alerts"`
+	MedianDurationSeconds *float64  `json:"median_duration_seconds" yaml:"median_duration_seconds"`
+}
+
+// AlertReportOutage is a single down alert and how long it lasted.
+type AlertReportOutage struct {
+	AlertID         uuid.UUID  `json:"alert_id" yaml:"alert_id"`
+	ProbeID         uuid.UUID  `json:"probe_id" yaml:"probe_id"`
+	Probe           string     `json:"probe" yaml:"probe"`
+	TriggeredAt     time.Time  `json:"triggered_at" yaml:"triggered_at"`
+	ResolvedAt      *time.Time `json:"resolved_at,omitempty" yaml:"resolved_at,omitempty"`
+	DurationSeconds float64    `json:"duration_seconds" yaml:"duration_seconds"`
+	Ongoing         bool       `json:"ongoing" yaml:"ongoing"`
+}
+
+// alertReportRow is a row in the report group table.
+type alertReportRow struct {
+	Group    string `table:"GROUP"`
+	Alerts   string `table:"ALERTS"`
+	Critical string `table:"CRITICAL"`
+	Acked    string `table:"ACKED"`
+	Resolved string `table:"RESOLVED"`
+	Open     string `table:"OPEN"`
+	MTTA     string `table:"MTTA"`
+	MTTR     string `table:"MTTR"`
+}
+
+// alertReportFlapRow is a row in the flapping probes table.
+type alertReportFlapRow struct {
+	Probe          string `table:"PROBE"`
+	DownAlerts     string `table:"DOWN ALERTS"`
+	MedianDuration string `table:"MEDIAN DURATION"`
+}
+
+// alertReportOutageRow is a row in the longest outages table.
+type alertReportOutageRow struct {
+	Probe     string `table:"PROBE"`
+	Triggered string `table:"TRIGGERED"`
+	Duration  string `table:"DURATION"`
+	AlertID   string `table:"ALERT ID,wide"`
+}
+
+// alertReportGrouping is a parsed --group-by value.
+type alertReportGrouping struct {
+	kind     string // probe, severity or label
+	labelKey string // set for label:<key>
+}
+
+// String returns the grouping as given on the command line.
+func (g alertReportGrouping) String() string {
+	if g.labelKey != "" {
+		return "label:" + g.labelKey
+	}
+	return g.kind
+}
+
+// alertReportTimes holds when an alert was first acknowledged and resolved.
+type alertReportTimes struct {
+	acked    *time.Time
+	resolved *time.Time
+}
+
+// NewAlertReportCmd creates and returns the alert report subcommand.
+func NewAlertReportCmd() *cobra.Command {
+	flags := &alertReportFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Summarize MTTA, MTTR and outages over a period",
+		Long: `Summarize alert reliability metrics over a period for reliability reviews.
+
+Lists every alert triggered between --since and --until, reads each alert's
+timeline to find when it was first acknowledged and resolved, and reports:
+  - Alert counts (total, critical, acknowledged, resolved, still open)
+  - MTTA: mean time from trigger to first acknowledgement
+  - MTTR: mean time from trigger to resolution
+  - Flapping probes: probes with at least --flap-threshold down alerts
+  - Longest outages: the --top longest down alerts
+
+Grouping (--group-by):
+  probe         One row per probe (default)
+  severity      One row per severity
+  label         One row per probe label (key=value); an alert counts once
+                for every label of its probe
+  label:<key>   One row per value of a label key, e.g. label:team
+
+Time formats accepted:
+  Relative:  24h, 7d, 30d (hours or days ago from now)
+  Date:      2024-01-15 (midnight UTC)
+  Absolute:  RFC3339 format (e.g., 2024-01-15T10:30:00Z)
+
+Output:
+  Besides table, JSON and YAML, -o csv writes the group table as CSV and
+  -o markdown writes a document to paste into a review.
+
+Examples:
+  # Monthly report grouped by probe
+  stackeye alert report --since 2026-09-01 --until 2026-10-01
+
+  # Per-team metrics for the last 30 days
+  stackeye alert report --since 30d --group-by label:team
+
+  # Markdown for the monthly reliability review
+  stackeye alert report --since 2026-09-01 --until 2026-10-01 -o markdown > september.md
+
+  # CSV per severity for a spreadsheet
+  stackeye alert report --since 90d --group-by severity -o csv`,
+		Annotations: map[string]string{
+			outputFormatsAnnotation: alertReportFormatCSV + "," + alertReportFormatMarkdown,
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAlertReport(cmd.Context(), flags)
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.since, "since", "30d", "start of the period (e.g., 30d, 2024-01-01, or RFC3339)")
+	cmd.Flags().StringVar(&flags.until, "until", "", "end of the period (default: now)")
+	cmd.Flags().StringVar(&flags.groupBy, "group-by", "probe", "group by: probe, severity, label, label:<key>")
+	cmd.Flags().IntVar(&flags.flapThreshold, "flap-threshold", 3, "down alerts in the period for a probe to count as flapping")
+	cmd.Flags().IntVar(&flags.top, "top", 5, "number of longest outages to list")
+
+	return cmd
+}
+
+// runAlertReport executes the alert report command logic.
+func runAlertReport(ctx context.Context, flags *alertReportFlags) error {
+	grouping, err := parseAlertReportGrouping(flags.groupBy)
+	if err != nil {
+		return err
+	}
+
+	if flags.flapThreshold < 2 {
+		return fmt.Errorf("--flap-threshold must be at least 2, got %d", flags.flapThreshold)
+	}
+	if flags.top < 1 {
+		return fmt.Errorf("--top must be at least 1, got %d", flags.top)
+	}
+
+	now := time.Now().UTC()
+	from, to, err := parseAlertReportPeriod(flags.since, flags.until, now)
+	if err != nil {
+		return err
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, alertReportTimeout)
+	defer cancel()
+
+	alerts, err := fetchAlertsInPeriod(reqCtx, apiClient, from, to)
+	if err != nil {
+		return err
+	}
+
+	times := fetchAlertReportTimes(reqCtx, apiClient, alerts)
+
+	var probeLabels map[uuid.UUID]map[string]string
+	if grouping.kind == "label" {
+		probeLabels, err = fetchProbeLabelMap(reqCtx, apiClient)
+		if err != nil {
+			return err
+		}
+	}
+
+	report := buildAlertReport(alerts, times, probeLabels, grouping, from, to, now, flags.flapThreshold, flags.top)
+
+	switch GetOutputFormat() {
+	case alertReportFormatCSV:
+		return writeAlertReportCSV(os.Stdout, report)
+	case alertReportFormatMarkdown:
+		return writeAlertReportMarkdown(os.Stdout, report)
+	}
+
+	outFormat := output.NewPrinter(GetConfig()).Format()
+	if outFormat == sdkoutput.FormatJSON || outFormat == sdkoutput.FormatYAML {
+		return output.Print(report)
+	}
+	return printAlertReportTables(report)
+}
+
+// parseAlertReportGrouping parses a --group-by value.
+func parseAlertReportGrouping(value string) (alertReportGrouping, error) {
+	switch {
+	case value == "probe", value == "severity", value == "label":
+		return alertReportGrouping{kind: value}, nil
+	case strings.HasPrefix(value, "label:"):
+		key := strings.TrimPrefix(value, "label:")
+		if err := validateLabelKey(key); err != nil {
+			return alertReportGrouping{}, fmt.Errorf("invalid --group-by %q: %w", value, err)
+		}
+		return alertReportGrouping{kind: "label", labelKey: key}, nil
+	}
+	return alertReportGrouping{}, clierrors.InvalidValueError("--group-by", value, []string{"probe", "severity", "label", "label:<key>"})
+}
+
+// parseAlertReportPeriod resolves --since and --until, defaulting --until to now.
+func parseAlertReportPeriod(since, until string, now time.Time) (time.Time, time.Time, error) {
+	sinceTime, err := parseTimeFlag(since)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid --since flag: %w", err)
+	}
+	if sinceTime == nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("--since is required")
+	}
+
+	to := now
+	untilTime, err := parseTimeFlag(until)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid --until flag: %w", err)
+	}
+	if untilTime != nil {
+		to = *untilTime
+	}
+
+	if !sinceTime.Before(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid time range: --since (%s) must be before --until (%s)",
+			sinceTime.Format(time.RFC3339), to.Format(time.RFC3339))
+	}
+	return sinceTime.UTC(), to.UTC(), nil
+}
+
+// fetchAlertsInPeriod lists every alert, in any status, triggered in the period.
+func fetchAlertsInPeriod(ctx context.Context, apiClient *client.Client, from, to time.Time) ([]client.Alert, error) {
+	var alerts []client.Alert
+	for offset := 0; ; offset += alertReportPageSize {
+		result, err := client.ListAlerts(ctx, apiClient, &client.ListAlertsOptions{
+			Limit:  alertReportPageSize,
+			Offset: offset,
+			From:   &from,
+			To:     &to,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list alerts: %w", err)
+		}
+		alerts = append(alerts, result.Alerts...)
+		if len(result.Alerts) < alertReportPageSize {
+			return alerts, nil
+		}
+	}
+}
+
+// fetchAlertReportTimes reads each alert's timeline to find when it was first
+// acknowledged and resolved. Alerts whose timeline cannot be fetched fall back
+// to the timestamps on the alert itself.
+func fetchAlertReportTimes(ctx context.Context, apiClient *client.Client, alerts []client.Alert) map[uuid.UUID]alertReportTimes {
+	times := make(map[uuid.UUID]alertReportTimes, len(alerts))
+	var mu sync.Mutex
+	failed := 0
+
+	sem := make(chan struct{}, alertReportConcurrency)
+	var wg sync.WaitGroup
+	for _, a := range alerts {
+		wg.Add(1)
+		go func(a client.Alert) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			timeline, err := client.GetAlertTimeline(ctx, apiClient, a.ID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failed++
+			}
+			times[a.ID] = alertReportTimesFor(a, timeline)
+		}(a)
+	}
+	wg.Wait()
+
+	if failed > 0 {
+		fmt.Fprintf(os.Stderr, "Warning: could not fetch the timeline for %d of %d alerts; using alert timestamps for those\n", failed, len(alerts))
+	}
+	return times
+}
+
+// alertReportTimesFor returns when an alert was first acknowledged and when it
+// was resolved. The timeline is preferred for acknowledgement because the
+// alert only records the latest one.
+func alertReportTimesFor(a client.Alert, timeline []client.AlertTimelineEvent) alertReportTimes {
+	t := alertReportTimes{acked: a.AcknowledgedAt, resolved: a.ResolvedAt}
+	for _, ev := range timeline {
+		at := ev.CreatedAt
+		switch strings.ToLower(string(ev.Event)) {
+		case "acknowledged":
+			if t.acked == nil || at.Before(*t.acked) {
+				t.acked = &at
+			}
+		case "resolved":
+			if t.resolved == nil {
+				t.resolved = &at
+			}
+		}
+	}
+	return t
+}
+
+// fetchProbeLabelMap returns the labels of every probe, keyed by probe ID.
+func fetchProbeLabelMap(ctx context.Context, apiClient *client.Client) (map[uuid.UUID]map[string]string, error) {
+	probes, err := fetchAllProbesForExport(ctx, apiClient, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make(map[uuid.UUID]map[string]string, len(probes))
+	for _, p := range probes {
+		m := make(map[string]string, len(p.Labels))
+		for _, l := range p.Labels {
+			value := ""
+			if l.Value != nil {
+				value = *l.Value
+			}
+			m[l.Key] = value
+		}
+		labels[p.ID] = m
+	}
+	return labels, nil
+}
+
+// alertReportProbeName returns the display name of an alert's probe.
+func alertReportProbeName(a client.Alert) string {
+	if a.Probe != nil && a.Probe.Name != "" {
+		return a.Probe.Name
+	}
+	return a.ProbeID.String()
+}
+
+// alertReportGroupKeys returns the groups an alert belongs to.
+func alertReportGroupKeys(a client.Alert, grouping alertReportGrouping, probeLabels map[uuid.UUID]map[string]string) []string {
+	switch grouping.kind {
+	case "severity":
+		return []string{string(a.Severity)}
+	case "label":
+		labels := probeLabels[a.ProbeID]
+		if grouping.labelKey != "" {
+			value, ok := labels[grouping.labelKey]
+			switch {
+			case !ok:
+				return []string{"(none)"}
+			case value == "":
+				return []string{grouping.labelKey}
+			}
+			return []string{value}
+		}
+		if len(labels) == 0 {
+			return []string{"(no labels)"}
+		}
+		keys := make([]string, 0, len(labels))
+		for k, v := range labels {
+			if v != "" {
+				k += "=" + v
+			}
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		return keys
+	default:
+		return []string{alertReportProbeName(a)}
+	}
+}
+
+// alertReportAccumulator sums response times for a group.
+type alertReportAccumulator struct {
+	group        AlertReportGroup
+	ackTotal     time.Duration
+	resolveTotal time.Duration
+}
+
+// add counts an alert in the group.
+func (acc *alertReportAccumulator) add(a client.Alert, t alertReportTimes) {
+	acc.group.Alerts++
+	if a.Severity == client.AlertSeverityCritical {
+		acc.group.Critical++
+	}
+	if t.acked != nil && !t.acked.Before(a.TriggeredAt) {
+		acc.group.Acknowledged++
+		acc.ackTotal += t.acked.Sub(a.TriggeredAt)
+	}
+	if t.resolved != nil && !t.resolved.Before(a.TriggeredAt) {
+		acc.group.Resolved++
+		acc.resolveTotal += t.resolved.Sub(a.TriggeredAt)
+	} else if a.Status != client.AlertStatusResolved {
+		acc.group.Open++
+	}
+}
+
+// result returns the group with mean response times filled in.
+func (acc *alertReportAccumulator) result() AlertReportGroup {
+	g := acc.group
+	if g.Acknowledged > 0 {
+		mtta := acc.ackTotal.Seconds() / float64(g.Acknowledged)
+		g.MTTASeconds = &mtta
+	}
+	if g.Resolved > 0 {
+		mttr := acc.resolveTotal.Seconds() / float64(g.Resolved)
+		g.MTTRSeconds = &mttr
+	}
+	return g
+}
+
+// buildAlertReport computes the report from the alerts in the period.
+func buildAlertReport(alerts []client.Alert, times map[uuid.UUID]alertReportTimes, probeLabels map[uuid.UUID]map[string]string,
+	grouping alertReportGrouping, from, to, now time.Time, flapThreshold, top int) *AlertReport {
+	total := &alertReportAccumulator{group: AlertReportGroup{Group: "Total"}}
+	groups := make(map[string]*alertReportAccumulator)
+
+	type probeDowns struct {
+		name      string
+		alerts    int
+		durations []float64
+	}
+	downs := make(map[uuid.UUID]*probeDowns)
+	var outages []AlertReportOutage
+
+	for _, a := range alerts {
+		t := times[a.ID]
+		total.add(a, t)
+		for _, key := range alertReportGroupKeys(a, grouping, probeLabels) {
+			acc, ok := groups[key]
+			if !ok {
+				acc = &alertReportAccumulator{group: AlertReportGroup{Group: key}}
+				groups[key] = acc
+			}
+			acc.add(a, t)
+		}
+
+		if a.AlertType != client.AlertTypeStatusDown {
+			continue
+		}
+		outage := AlertReportOutage{
+			AlertID:     a.ID,
+			ProbeID:     a.ProbeID,
+			Probe:       alertReportProbeName(a),
+			TriggeredAt: a.TriggeredAt,
+			ResolvedAt:  t.resolved,
+		}
+		end := now
+		if t.resolved != nil {
+			end = *t.resolved
+		} else {
+			outage.Ongoing = true
+		}
+		outage.DurationSeconds = end.Sub(a.TriggeredAt).Seconds()
+		outages = append(outages, outage)
+
+		pd, ok := downs[a.ProbeID]
+		if !ok {
+			pd = &probeDowns{name: outage.Probe}
+			downs[a.ProbeID] = pd
+		}
+		pd.alerts++
+		if !outage.Ongoing {
+			pd.durations = append(pd.durations, outage.DurationSeconds)
+		}
+	}
+
+	report := &AlertReport{
+		From:           from,
+		To:             to,
+		GroupBy:        grouping.String(),
+		Total:          total.result(),
+		Groups:         make([]AlertReportGroup, 0, len(groups)),
+		FlappingProbes: []AlertReportFlappingProbe{},
+		LongestOutages: []AlertReportOutage{},
+	}
+
+	for _, acc := range groups {
+		report.Groups = append(report.Groups, acc.result())
+	}
+	sort.Slice(report.Groups, func(i, j int) bool {
+		if report.Groups[i].Alerts != report.Groups[j].Alerts {
+			return report.Groups[i].Alerts > report.Groups[j].Alerts
+		}
+		return report.Groups[i].Group < report.Groups[j].Group
+	})
+
+	for id, pd := range downs {
+		if pd.alerts < flapThreshold {
+			continue
+		}
+		flap := AlertReportFlappingProbe{ProbeID: id, Probe: pd.name, DownAlerts: pd.alerts}
+		if len(pd.durations) > 0 {
+			sort.Float64s(pd.durations)
+			median := pd.durations[len(pd.durations)/2]
+			flap.MedianDurationSeconds = &median
+		}
+		report.FlappingProbes = append(report.FlappingProbes, flap)
+	}
+	sort.Slice(report.FlappingProbes, func(i, j int) bool {
+		if report.FlappingProbes[i].DownAlerts != report.FlappingProbes[j].DownAlerts {
+			return report.FlappingProbes[i].DownAlerts > report.FlappingProbes[j].DownAlerts
+		}
+		return report.FlappingProbes[i].Probe < report.FlappingProbes[j].Probe
+	})
+
+	sort.Slice(outages, func(i, j int) bool {
+		return outages[i].DurationSeconds > outages[j].DurationSeconds
+	})
+	if len(outages) > top {
+		outages = outages[:top]
+	}
+	report.LongestOutages = append(report.LongestOutages, outages...)
+
+	return report
+}
+
+// formatReportDuration formats seconds with two units of precision, e.g.
+// "4m 30s" or "1h 12m". Nil is shown as "-".
+func formatReportDuration(seconds *float64) string {
+	if seconds == nil {
+		return "-"
+	}
+	d := time.Duration(*seconds * float64(time.Second)).Round(time.Second)
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm %ds", int(d.Minutes()), int(d.Seconds())%60)
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh %dm", int(d.Hours()), int(d.Minutes())%60)
+	default:
+		return fmt.Sprintf("%dd %dh", int(d.Hours())/24, int(d.Hours())%24)
+	}
+}
+
+// formatReportPeriod formats the report period for headings.
+func formatReportPeriod(r *AlertReport) string {
+	const layout = "2006-01-02 15:04 MST"
+	return fmt.Sprintf("%s to %s", r.From.Format(layout), r.To.Format(layout))
+}
+
+// alertReportRows converts the groups and total to table rows.
+func alertReportRows(r *AlertReport) []alertReportRow {
+	rows := make([]alertReportRow, 0, len(r.Groups)+1)
+	for _, g := range append(append([]AlertReportGroup{}, r.Groups...), r.Total) {
+		rows = append(rows, alertReportRow{
+			Group:    g.Group,
+			Alerts:   strconv.Itoa(g.Alerts),
+			Critical: strconv.Itoa(g.Critical),
+			Acked:    strconv.Itoa(g.Acknowledged),
+			Resolved: strconv.Itoa(g.Resolved),
+			Open:     strconv.Itoa(g.Open),
+			MTTA:     formatReportDuration(g.MTTASeconds),
+			MTTR:     formatReportDuration(g.MTTRSeconds),
+		})
+	}
+	return rows
+}
+
+// printAlertReportTables prints the report as tables for terminal output.
+func printAlertReportTables(r *AlertReport) error {
+	fmt.Printf("Alert report: %s (grouped by %s)\n\n", formatReportPeriod(r), r.GroupBy)
+	if r.Total.Alerts == 0 {
+		return output.PrintEmpty("No alerts were triggered in this period")
+	}
+	if err := output.Print(alertReportRows(r)); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	if len(r.FlappingProbes) == 0 {
+		fmt.Println("Flapping probes: none")
+	} else {
+		fmt.Println("Flapping probes:")
+		rows := make([]alertReportFlapRow, 0, len(r.FlappingProbes))
+		for _, f := range r.FlappingProbes {
+			rows = append(rows, alertReportFlapRow{
+				Probe:          f.Probe,
+				DownAlerts:     strconv.Itoa(f.DownAlerts),
+				MedianDuration: formatReportDuration(f.MedianDurationSeconds),
+			})
+		}
+		if err := output.Print(rows); err != nil {
+			return err
+		}
+	}
+
+	fmt.Println()
+	if len(r.LongestOutages) == 0 {
+		fmt.Println("Longest outages: none")
+		return nil
+	}
+	fmt.Println("Longest outages:")
+	rows := make([]alertReportOutageRow, 0, len(r.LongestOutages))
+	for _, o := range r.LongestOutages {
+		rows = append(rows, alertReportOutageRow{
+			Probe:     o.Probe,
+			Triggered: o.TriggeredAt.UTC().Format("2006-01-02 15:04"),
+			Duration:  formatOutageDuration(o),
+			AlertID:   o.AlertID.String(),
+		})
+	}
+	return output.Print(rows)
+}
+
+// formatOutageDuration formats an outage duration, marking ongoing outages.
+func formatOutageDuration(o AlertReportOutage) string {
+	d := formatReportDuration(&o.DurationSeconds)
+	if o.Ongoing {
+		d += " (ongoing)"
+	}
+	return d
+}
+
+// writeAlertReportCSV writes the group table, including the total row, as CSV.
+func writeAlertReportCSV(w io.Writer, r *AlertReport) error {
+	cw := csv.NewWriter(w)
+	header := []string{"group", "alerts", "critical", "acknowledged", "resolved", "open", "mtta_seconds", "mttr_seconds"}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	seconds := func(v *float64) string {
+		if v == nil {
+			return ""
+		}
+		return strconv.FormatFloat(*v, 'f', 0, 64)
+	}
+	for _, g := range append(append([]AlertReportGroup{}, r.Groups...), r.Total) {
+		record := []string{
+			g.Group,
+			strconv.Itoa(g.Alerts),
+			strconv.Itoa(g.Critical),
+			strconv.Itoa(g.Acknowledged),
+			strconv.Itoa(g.Resolved),
+			strconv.Itoa(g.Open),
+			seconds(g.MTTASeconds),
+			seconds(g.MTTRSeconds),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeAlertReportMarkdown writes the report as a markdown document.
+func writeAlertReportMarkdown(w io.Writer, r *AlertReport) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Alert report\n\n")
+	fmt.Fprintf(&b, "**Period:** %s\n\n", formatReportPeriod(r))
+	fmt.Fprintf(&b, "- Alerts: %d (%d critical)\n", r.Total.Alerts, r.Total.Critical)
+	fmt.Fprintf(&b, "- Acknowledged: %d, resolved: %d, still open: %d\n", r.Total.Acknowledged, r.Total.Resolved, r.Total.Open)
+	fmt.Fprintf(&b, "- MTTA: %s\n", formatReportDuration(r.Total.MTTASeconds))
+	fmt.Fprintf(&b, "- MTTR: %s\n\n", formatReportDuration(r.Total.MTTRSeconds))
+
+	fmt.Fprintf(&b, "## By %s\n\n", r.GroupBy)
+	if len(r.Groups) == 0 {
+		b.WriteString("No alerts were triggered in this period.\n\n")
+	} else {
+		b.WriteString("| Group | Alerts | Critical | Acked | Resolved | Open | MTTA | MTTR |\n")
+		b.WriteString("|---|---:|---:|---:|---:|---:|---:|---:|\n")
+		for _, row := range alertReportRows(r) {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %s | %s | %s |\n",
+				markdownCell(row.Group), row.Alerts, row.Critical, row.Acked, row.Resolved, row.Open, row.MTTA, row.MTTR)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Flapping probes\n\n")
+	if len(r.FlappingProbes) == 0 {
+		b.WriteString("None.\n\n")
+	} else {
+		b.WriteString("| Probe | Down alerts | Median duration |\n")
+		b.WriteString("|---|---:|---:|\n")
+		for _, f := range r.FlappingProbes {
+			fmt.Fprintf(&b, "| %s | %d | %s |\n", markdownCell(f.Probe), f.DownAlerts, formatReportDuration(f.MedianDurationSeconds))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Longest outages\n\n")
+	if len(r.LongestOutages) == 0 {
+		b.WriteString("None.\n")
+	} else {
+		b.WriteString("| Probe | Triggered | Duration |\n")
+		b.WriteString("|---|---|---:|\n")
+		for _, o := range r.LongestOutages {
+			fmt.Fprintf(&b, "| %s | %s | %s |\n", markdownCell(o.Probe), o.TriggeredAt.UTC().Format("2006-01-02 15:04 MST"), formatOutageDuration(o))
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// markdownCell escapes text for use in a markdown table cell.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "\n", " ")
+}
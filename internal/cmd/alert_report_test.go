@@ -0,0 +1,329 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+)
+
+func TestNewAlertReportCmd(t *testing.T) {
+	cmd := NewAlertReportCmd()
+
+	if cmd.Use != "report" {
+		t.Errorf("Use = %q, want %q", cmd.Use, "report")
+	}
+	if cmd.Short == "" {
+		t.Error("Short description should not be empty")
+	}
+
+	tests := []struct {
+		name     string
+		defValue string
+	}{
+		{"since", "30d"},
+		{"until", ""},
+		{"group-by", "probe"},
+		{"flap-threshold", "3"},
+		{"top", "5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flag := cmd.Flags().Lookup(tt.name)
+			if flag == nil {
+				t.Fatalf("flag --%s not found", tt.name)
+			}
+			if flag.DefValue != tt.defValue {
+				t.Errorf("--%s default = %q, want %q", tt.name, flag.DefValue, tt.defValue)
+			}
+		})
+	}
+}
+
+func TestParseAlertReportGrouping(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    alertReportGrouping
+		wantErr bool
+	}{
+		{"probe", alertReportGrouping{kind: "probe"}, false},
+		{"severity", alertReportGrouping{kind: "severity"}, false},
+		{"label", alertReportGrouping{kind: "label"}, false},
+		{"label:team", alertReportGrouping{kind: "label", labelKey: "team"}, false},
+		{"label:", alertReportGrouping{}, true},
+		{"region", alertReportGrouping{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseAlertReportGrouping(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("grouping = %+v, want %+v", got, tt.want)
+			}
+			if !tt.wantErr && got.String() != tt.value {
+				t.Errorf("String() = %q, want %q", got.String(), tt.value)
+			}
+		})
+	}
+}
+
+func TestParseAlertReportPeriod(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	from, to, err := parseAlertReportPeriod("2026-09-01", "2026-10-01", now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !from.Equal(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)) || !to.Equal(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("period = %v to %v", from, to)
+	}
+
+	_, to, err = parseAlertReportPeriod("2026-09-01", "", now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !to.Equal(now) {
+		t.Errorf("to = %v, want now", to)
+	}
+
+	if _, _, err := parseAlertReportPeriod("2026-10-01", "2026-09-01", now); err == nil || !strings.Contains(err.Error(), "must be before") {
+		t.Errorf("error = %v, want range error", err)
+	}
+	if _, _, err := parseAlertReportPeriod("", "", now); err == nil || !strings.Contains(err.Error(), "--since is required") {
+		t.Errorf("error = %v, want --since required", err)
+	}
+}
+
+func TestAlertReportTimesFor(t *testing.T) {
+	triggered := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	firstAck := triggered.Add(3 * time.Minute)
+	lastAck := triggered.Add(20 * time.Minute)
+	resolved := triggered.Add(time.Hour)
+
+	a := client.Alert{TriggeredAt: triggered, AcknowledgedAt: &lastAck}
+	timeline := []client.AlertTimelineEvent{
+		{Event: "triggered", CreatedAt: triggered},
+		{Event: "acknowledged", CreatedAt: firstAck},
+		{Event: "acknowledged", CreatedAt: lastAck},
+		{Event: "resolved", CreatedAt: resolved},
+	}
+
+	got := alertReportTimesFor(a, timeline)
+	if got.acked == nil || !got.acked.Equal(firstAck) {
+		t.Errorf("acked = %v, want first acknowledgement %v", got.acked, firstAck)
+	}
+	if got.resolved == nil || !got.resolved.Equal(resolved) {
+		t.Errorf("resolved = %v, want %v", got.resolved, resolved)
+	}
+
+	got = alertReportTimesFor(a, nil)
+	if got.acked == nil || !got.acked.Equal(lastAck) || got.resolved != nil {
+		t.Errorf("without timeline = %+v, want alert timestamps", got)
+	}
+}
+
+func TestBuildAlertReport(t *testing.T) {
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	now := to
+	api := uuid.New()
+	web := uuid.New()
+
+	var alerts []client.Alert
+	times := make(map[uuid.UUID]alertReportTimes)
+	add := func(probeID uuid.UUID, name string, severity client.AlertSeverity, triggered time.Time, ack, resolve time.Duration) {
+		a := client.Alert{
+			ID:          uuid.New(),
+			ProbeID:     probeID,
+			Status:      client.AlertStatusActive,
+			Severity:    severity,
+			AlertType:   client.AlertTypeStatusDown,
+			TriggeredAt: triggered,
+			Probe:       &client.AlertProbe{ID: probeID, Name: name},
+		}
+		var rt alertReportTimes
+		if ack > 0 {
+			at := triggered.Add(ack)
+			rt.acked = &at
+		}
+		if resolve > 0 {
+			at := triggered.Add(resolve)
+			rt.resolved = &at
+			a.Status = client.AlertStatusResolved
+		}
+		alerts = append(alerts, a)
+		times[a.ID] = rt
+	}
+
+	day := 24 * time.Hour
+	add(api, "API", client.AlertSeverityCritical, from.Add(day), 2*time.Minute, 10*time.Minute)
+	add(api, "API", client.AlertSeverityCritical, from.Add(2*day), 4*time.Minute, 30*time.Minute)
+	add(api, "API", client.AlertSeverityWarning, from.Add(3*day), 0, 20*time.Minute)
+	add(web, "Web", client.AlertSeverityWarning, to.Add(-2*time.Hour), 0, 0)
+
+	report := buildAlertReport(alerts, times, nil, alertReportGrouping{kind: "probe"}, from, to, now, 3, 2)
+
+	if report.Total.Alerts != 4 || report.Total.Critical != 2 || report.Total.Acknowledged != 2 ||
+		report.Total.Resolved != 3 || report.Total.Open != 1 {
+		t.Errorf("total = %+v", report.Total)
+	}
+	if report.Total.MTTASeconds == nil || *report.Total.MTTASeconds != 180 {
+		t.Errorf("MTTA = %v, want 180s", report.Total.MTTASeconds)
+	}
+	if report.Total.MTTRSeconds == nil || *report.Total.MTTRSeconds != 1200 {
+		t.Errorf("MTTR = %v, want 1200s", report.Total.MTTRSeconds)
+	}
+
+	if len(report.Groups) != 2 || report.Groups[0].Group != "API" || report.Groups[0].Alerts != 3 {
+		t.Fatalf("groups = %+v, want API first with 3 alerts", report.Groups)
+	}
+	if report.Groups[1].MTTASeconds != nil || report.Groups[1].MTTRSeconds != nil {
+		t.Errorf("open group should have no MTTA/MTTR, got %+v", report.Groups[1])
+	}
+
+	if len(report.FlappingProbes) != 1 || report.FlappingProbes[0].ProbeID != api || report.FlappingProbes[0].DownAlerts != 3 {
+		t.Errorf("flapping = %+v, want API with 3 down alerts", report.FlappingProbes)
+	}
+	if m := report.FlappingProbes[0].MedianDurationSeconds; m == nil || *m != 1200 {
+		t.Errorf("median = %v, want 1200s", m)
+	}
+
+	if len(report.LongestOutages) != 2 {
+		t.Fatalf("longest outages = %d, want 2 (--top)", len(report.LongestOutages))
+	}
+	if o := report.LongestOutages[0]; o.Probe != "Web" || !o.Ongoing || o.DurationSeconds != 7200 {
+		t.Errorf("longest outage = %+v, want ongoing Web outage of 2h", o)
+	}
+}
+
+func TestAlertReportGroupKeys_Labels(t *testing.T) {
+	probeID := uuid.New()
+	a := client.Alert{ProbeID: probeID}
+	labels := map[uuid.UUID]map[string]string{
+		probeID: {"team": "payments", "critical": ""},
+	}
+
+	got := alertReportGroupKeys(a, alertReportGrouping{kind: "label"}, labels)
+	if strings.Join(got, ",") != "critical,team=payments" {
+		t.Errorf("label keys = %v", got)
+	}
+
+	got = alertReportGroupKeys(a, alertReportGrouping{kind: "label", labelKey: "team"}, labels)
+	if strings.Join(got, ",") != "payments" {
+		t.Errorf("label:team keys = %v", got)
+	}
+
+	got = alertReportGroupKeys(a, alertReportGrouping{kind: "label", labelKey: "env"}, labels)
+	if strings.Join(got, ",") != "(none)" {
+		t.Errorf("label:env keys = %v", got)
+	}
+
+	got = alertReportGroupKeys(client.Alert{ProbeID: uuid.New()}, alertReportGrouping{kind: "label"}, labels)
+	if strings.Join(got, ",") != "(no labels)" {
+		t.Errorf("unlabeled keys = %v", got)
+	}
+}
+
+func TestFormatReportDuration(t *testing.T) {
+	tests := []struct {
+		seconds float64
+		want    string
+	}{
+		{45, "45s"},
+		{270, "4m 30s"},
+		{4320, "1h 12m"},
+		{183600, "2d 3h"},
+	}
+	for _, tt := range tests {
+		if got := formatReportDuration(&tt.seconds); got != tt.want {
+			t.Errorf("formatReportDuration(%v) = %q, want %q", tt.seconds, got, tt.want)
+		}
+	}
+	if got := formatReportDuration(nil); got != "-" {
+		t.Errorf("formatReportDuration(nil) = %q, want -", got)
+	}
+}
+
+func TestWriteAlertReportCSVAndMarkdown(t *testing.T) {
+	mtta := 90.0
+	report := &AlertReport{
+		From:    time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		To:      time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		GroupBy: "probe",
+		Total:   AlertReportGroup{Group: "Total", Alerts: 2, Acknowledged: 1, MTTASeconds: &mtta},
+		Groups: []AlertReportGroup{
+			{Group: "API | prod", Alerts: 2, Acknowledged: 1, MTTASeconds: &mtta},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := writeAlertReportCSV(&buf, report); err != nil {
+		t.Fatalf("writeAlertReportCSV() error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("CSV lines = %d, want header, group and total", len(lines))
+	}
+	if lines[1] != "API | prod,2,0,1,0,0,90," || lines[2] != "Total,2,0,1,0,0,90," {
+		t.Errorf("CSV rows = %q", lines[1:])
+	}
+
+	buf.Reset()
+	if err := writeAlertReportMarkdown(&buf, report); err != nil {
+		t.Fatalf("writeAlertReportMarkdown() error: %v", err)
+	}
+	md := buf.String()
+	for _, want := range []string{"# Alert report", "- MTTA: 1m 30s", "## By probe", `| API \| prod | 2 |`, "## Flapping probes\n\nNone."} {
+		if !strings.Contains(md, want) {
+			t.Errorf("markdown missing %q:\n%s", want, md)
+		}
+	}
+}
+
+func TestNewAlertReportCmd_OutputFormats(t *testing.T) {
+	formats := commandOutputFormats(NewAlertReportCmd())
+	if len(formats) != 2 || formats[0] != "csv" || formats[1] != "markdown" {
+		t.Errorf("expected csv and markdown output formats, got %v", formats)
+	}
+}
+
+func TestRunAlertReport_Validation(t *testing.T) {
+	valid := alertReportFlags{since: "30d", groupBy: "probe", flapThreshold: 3, top: 5}
+
+	tests := []struct {
+		name    string
+		modify  func(f *alertReportFlags)
+		wantErr string
+	}{
+		{"invalid group-by", func(f *alertReportFlags) { f.groupBy = "region" }, "--group-by"},
+		{"flap threshold too low", func(f *alertReportFlags) { f.flapThreshold = 1 }, "--flap-threshold"},
+		{"top too low", func(f *alertReportFlags) { f.top = 0 }, "--top"},
+		{"invalid since", func(f *alertReportFlags) { f.since = "last week" }, "invalid --since"},
+		{"since after until", func(f *alertReportFlags) { f.since = "2026-10-01"; f.until = "2026-09-01" }, "must be before"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := valid
+			tt.modify(&flags)
+
+			err := runAlertReport(context.Background(), &flags)
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", err.Error(), tt.wantErr)
+			}
+			if strings.Contains(err.Error(), "API client") {
+				t.Errorf("validation should not need the API client, got %q", err.Error())
+			}
+		})
+	}
+}
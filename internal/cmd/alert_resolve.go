@@ -20,6 +20,7 @@ const alertResolveTimeout = 30 * time.Second
 // alertResolveFlags holds the flag values for the alert resolve command.
 type alertResolveFlags struct {
 	message string
+	triage  alertTriageFlags
 }
 
 // AlertResolveResponse wraps the resolved alert data for output.
@@ -48,8 +49,9 @@ func NewAlertResolveCmd() *cobra.Command {
 	flags := &alertResolveFlags{}
 
 	cmd := &cobra.Command{
-		Use:   "resolve <id> [id...]",
-		Short: "Resolve a monitoring alert",
+		Use:               "resolve <id> [id...]",
+		Short:             "Resolve a monitoring alert",
+		ValidArgsFunction: RepeatedCompletion(AlertCompletion()),
 		Long: `Resolve one or more monitoring alerts.
 
 Resolving an alert marks the issue as fixed. This is typically done after the
@@ -65,6 +67,20 @@ Alert States After Resolution:
   resolved    Alert status changes to 'resolved'
               Duration is calculated from triggered_at to resolved_at
 
+Bulk Triage:
+  Instead of listing IDs, select alerts with --selector and/or --all-active.
+  Selector terms are comma-separated and combined with AND logic:
+    severity=<critical|warning|info>  type=<alert type>
+    probe=<name or UUID>              probe-label=<key=value|key!=value|key>
+  --older-than limits the selection to alerts triggered at least that long
+  ago. Matching alerts are processed in parallel and a summary table is
+  printed. --mute-for additionally mutes each affected probe for the given
+  duration. The selected alerts are listed and confirmed first; use --yes to
+  skip the prompt.
+
+Pass - instead of IDs to read them from stdin, one per line or as a JSON array
+of IDs or of objects with an "id" field (such as list output with -o json).
+
 Examples:
   # Resolve a single alert
   stackeye alert resolve 550e8400-e29b-41d4-a716-446655440000
@@ -79,15 +95,32 @@ Examples:
   stackeye alert resolve abc123... def456... -m "All resolved by infrastructure upgrade"
 
   # Output as JSON for scripting
-  stackeye alert resolve 550e8400-e29b-41d4-a716-446655440000 -o json`,
-		Args: cobra.MinimumNArgs(1),
+  stackeye alert resolve 550e8400-e29b-41d4-a716-446655440000 -o json
+
+  # Resolve every warning alert for probes owned by the web team
+  stackeye alert resolve --selector 'severity=warning,probe-label=team=web'
+
+  # Resolve all active alerts older than 2 hours and mute their probes for 1 hour
+  stackeye alert resolve --all-active --older-than 2h --mute-for 1h
+
+  # Read alert IDs from another command's JSON output
+  stackeye alert list -s acknowledged -o json | stackeye alert resolve -`,
+		Args: alertTriageArgs(&flags.triage),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runAlertResolve(cmd.Context(), args, flags)
+			if flags.triage.targeted() {
+				return runAlertResolveTriage(cmd.Context(), flags)
+			}
+			ids, _, err := readIDArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+			return runAlertResolve(cmd.Context(), ids, flags)
 		},
 	}
 
 	// Define command-specific flags
 	cmd.Flags().StringVarP(&flags.message, "message", "m", "", "note to include with resolution")
+	addAlertTriageFlags(cmd, &flags.triage)
 
 	return cmd
 }
@@ -213,3 +246,32 @@ func runBatchAlertResolve(ctx context.Context, apiClient *client.Client, alertID
 
 	return nil
 }
+
+// runAlertResolveTriage resolves every alert selected by --selector, --all-active
+// and --older-than.
+func runAlertResolveTriage(ctx context.Context, flags *alertResolveFlags) error {
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	var req *client.ResolveAlertRequest
+	if flags.message != "" {
+		req = &client.ResolveAlertRequest{
+			Note: &flags.message,
+		}
+	}
+
+	action := alertTriageAction{
+		verb:     "resolve",
+		past:     "Resolved",
+		statuses: []client.AlertStatus{client.AlertStatusActive, client.AlertStatusAcknowledged},
+		apply: func(ctx context.Context, apiClient *client.Client, alertID uuid.UUID) (*client.Alert, error) {
+			reqCtx, cancel := context.WithTimeout(ctx, alertResolveTimeout)
+			defer cancel()
+			return client.ResolveAlert(reqCtx, apiClient, alertID, req)
+		},
+	}
+
+	return runAlertTriage(ctx, apiClient, action, &flags.triage)
+}
@@ -0,0 +1,608 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/dryrun"
+	clierrors "github.com/StackEye-IO/stackeye-cli/internal/errors"
+	cliinteractive "github.com/StackEye-IO/stackeye-cli/internal/interactive"
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// alertTriageListTimeout is the maximum time to wait while listing the alerts
+// matched by a selector.
+const alertTriageListTimeout = 60 * time.Second
+
+// alertTriageConcurrency is the number of alerts acknowledged or resolved in
+// parallel during bulk triage.
+const alertTriageConcurrency = 5
+
+// alertTriagePageSize is the page size used when listing alerts for triage.
+const alertTriagePageSize = 100
+
+// alertTriageFlags holds the bulk targeting flags shared by alert ack and
+// alert resolve.
+type alertTriageFlags struct {
+	selector  string
+	allActive bool
+	olderThan string
+	muteFor   string
+	yes       bool
+}
+
+// targeted reports whether alerts are selected by flags instead of IDs.
+func (f *alertTriageFlags) targeted() bool {
+	return f.selector != "" || f.allActive
+}
+
+// alertTriageAction describes one bulk triage operation (ack or resolve).
+type alertTriageAction struct {
+	verb     string               // "acknowledge" or "resolve"
+	past     string               // "Acknowledged" or "Resolved"
+	statuses []client.AlertStatus // alert statuses eligible for the action
+	apply    func(ctx context.Context, apiClient *client.Client, alertID uuid.UUID) (*client.Alert, error)
+}
+
+// AlertTriageResult is the outcome of a bulk triage action on one alert.
+type AlertTriageResult struct {
+	ID          string               `json:"id"`
+	Probe       string               `json:"probe,omitempty"`
+	ProbeID     string               `json:"probe_id,omitempty"`
+	Severity    client.AlertSeverity `json:"severity"`
+	TriggeredAt time.Time            `json:"triggered_at"`
+	Success     bool                 `json:"success"`
+	Error       string               `json:"error,omitempty"`
+}
+
+// AlertTriageMute is a probe mute created by --mute-for.
+type AlertTriageMute struct {
+	ProbeID string `json:"probe_id"`
+	Probe   string `json:"probe,omitempty"`
+	MuteID  string `json:"mute_id,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// AlertTriageResponse wraps the results of a bulk triage action for output.
+type AlertTriageResponse struct {
+	Action       string              `json:"action"`
+	Results      []AlertTriageResult `json:"results"`
+	Mutes        []AlertTriageMute   `json:"mutes,omitempty"`
+	Total        int                 `json:"total"`
+	SuccessCount int                 `json:"success_count"`
+	FailedCount  int                 `json:"failed_count"`
+}
+
+// alertTriageRow is a row in the bulk triage summary table.
+type alertTriageRow struct {
+	ID       string `table:"ID"`
+	Probe    string `table:"PROBE"`
+	Severity string `table:"SEVERITY"`
+	Age      string `table:"AGE"`
+	Result   string `table:"RESULT"`
+}
+
+// alertSelector is a parsed alert selector such as
+// "severity=warning,probe-label=team=web".
+type alertSelector struct {
+	severity    client.AlertSeverity
+	alertType   client.AlertType
+	probe       string         // probe name (case-insensitive) or UUID
+	probeLabels *labelSelector // labels of the alert's probe
+}
+
+// addAlertTriageFlags registers the bulk targeting flags on an alert command.
+func addAlertTriageFlags(cmd *cobra.Command, flags *alertTriageFlags) {
+	cmd.Flags().StringVar(&flags.selector, "selector", "", "select alerts instead of IDs: severity=,type=,probe=,probe-label=key=value (AND logic)")
+	cmd.Flags().BoolVar(&flags.allActive, "all-active", false, "select every open (unresolved) alert instead of IDs")
+	cmd.Flags().StringVar(&flags.olderThan, "older-than", "", "only select alerts triggered more than this long ago (e.g. 30m, 2h, 1d)")
+	cmd.Flags().StringVar(&flags.muteFor, "mute-for", "", "also mute the probes of the selected alerts for this long (e.g. 30m, 1h)")
+	cmd.Flags().BoolVarP(&flags.yes, "yes", "y", false, "skip the confirmation prompt for --selector and --all-active")
+}
+
+// alertTriageArgs returns a cobra.PositionalArgs validator that rejects alert
+// IDs when alerts are selected by flags and requires at least one otherwise.
+func alertTriageArgs(flags *alertTriageFlags) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if !flags.targeted() {
+			if flags.olderThan != "" || flags.muteFor != "" {
+				return fmt.Errorf("--older-than and --mute-for require --selector or --all-active")
+			}
+			return cobra.MinimumNArgs(1)(cmd, args)
+		}
+		if len(args) > 0 {
+			return fmt.Errorf("alert IDs cannot be combined with --selector or --all-active; use one or the other")
+		}
+		return nil
+	}
+}
+
+// parseAlertSelector parses a comma-separated alert selector. Supported terms:
+//
+//	severity=critical|warning|info
+//	type=<alert type>
+//	probe=<probe name or UUID>
+//	probe-label=<label selector term>   (repeatable, e.g. probe-label=team=web)
+func parseAlertSelector(selector string) (*alertSelector, error) {
+	sel := &alertSelector{}
+
+	var labelTerms []string
+	for _, part := range strings.Split(selector, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(part, "=")
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid selector term %q: expected key=value", part)
+		}
+
+		switch key {
+		case "severity":
+			severity := client.AlertSeverity(strings.ToLower(value))
+			switch severity {
+			case client.AlertSeverityCritical, client.AlertSeverityWarning, client.AlertSeverityInfo:
+				sel.severity = severity
+			default:
+				return nil, clierrors.InvalidValueError("--selector severity", value, clierrors.ValidSeverities)
+			}
+		case "type":
+			sel.alertType = client.AlertType(strings.ToLower(value))
+		case "probe":
+			sel.probe = value
+		case "probe-label":
+			labelTerms = append(labelTerms, value)
+		default:
+			return nil, fmt.Errorf("unknown selector key %q: must be one of severity, type, probe, probe-label", key)
+		}
+	}
+
+	if len(labelTerms) > 0 {
+		labels, err := parseLabelSelector(strings.Join(labelTerms, ","))
+		if err != nil {
+			return nil, fmt.Errorf("invalid probe-label selector: %w", err)
+		}
+		sel.probeLabels = labels
+	}
+
+	if sel.severity == "" && sel.alertType == "" && sel.probe == "" && sel.probeLabels == nil {
+		return nil, fmt.Errorf("alert selector %q is empty", selector)
+	}
+
+	return sel, nil
+}
+
+// matches reports whether an alert satisfies the selector. labeledProbes is
+// the set of probe IDs matching the probe-label terms and is ignored when the
+// selector has none.
+func (s *alertSelector) matches(a client.Alert, labeledProbes map[uuid.UUID]bool) bool {
+	if s.severity != "" && a.Severity != s.severity {
+		return false
+	}
+	if s.alertType != "" && a.AlertType != s.alertType {
+		return false
+	}
+	probeID := triageAlertProbeID(a)
+	if s.probe != "" {
+		if probeID == uuid.Nil {
+			return false
+		}
+		nameMatches := a.Probe != nil && strings.EqualFold(a.Probe.Name, s.probe)
+		if probeID.String() != s.probe && !nameMatches {
+			return false
+		}
+	}
+	if s.probeLabels != nil && (probeID == uuid.Nil || !labeledProbes[probeID]) {
+		return false
+	}
+	return true
+}
+
+// triageAlertProbeID returns the ID of the alert's probe, falling back to
+// ProbeID for alerts listed without an embedded probe.
+func triageAlertProbeID(a client.Alert) uuid.UUID {
+	if a.Probe != nil {
+		return a.Probe.ID
+	}
+	return a.ProbeID
+}
+
+// filterAlertsForTriage returns the alerts matching the selector (nil matches
+// everything) that were triggered before cutoff (zero cutoff disables the age
+// filter), oldest first.
+func filterAlertsForTriage(alerts []client.Alert, sel *alertSelector, labeledProbes map[uuid.UUID]bool, cutoff time.Time) []client.Alert {
+	matched := make([]client.Alert, 0, len(alerts))
+	for _, a := range alerts {
+		if sel != nil && !sel.matches(a, labeledProbes) {
+			continue
+		}
+		if !cutoff.IsZero() && a.TriggeredAt.After(cutoff) {
+			continue
+		}
+		matched = append(matched, a)
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].TriggeredAt.Before(matched[j].TriggeredAt)
+	})
+	return matched
+}
+
+// selectAlertsForTriage lists every alert in the given statuses and returns
+// those matching the selector and age cutoff.
+func selectAlertsForTriage(ctx context.Context, apiClient *client.Client, statuses []client.AlertStatus, sel *alertSelector, cutoff time.Time) ([]client.Alert, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, alertTriageListTimeout)
+	defer cancel()
+
+	var severity client.AlertSeverity
+	if sel != nil {
+		severity = sel.severity
+	}
+
+	var alerts []client.Alert
+	for _, status := range statuses {
+		for offset := 0; ; offset += alertTriagePageSize {
+			result, err := client.ListAlerts(reqCtx, apiClient, &client.ListAlertsOptions{
+				Limit:    alertTriagePageSize,
+				Offset:   offset,
+				Status:   status,
+				Severity: severity,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list alerts: %w", err)
+			}
+			alerts = append(alerts, result.Alerts...)
+			if len(result.Alerts) < alertTriagePageSize {
+				break
+			}
+		}
+	}
+
+	var labeledProbes map[uuid.UUID]bool
+	if sel != nil && sel.probeLabels != nil {
+		probes, err := fetchAllProbesForExport(reqCtx, apiClient, "", sel.probeLabels.include)
+		if err != nil {
+			return nil, err
+		}
+		labeledProbes = make(map[uuid.UUID]bool, len(probes))
+		for _, p := range probes {
+			if sel.probeLabels.matches(p) {
+				labeledProbes[p.ID] = true
+			}
+		}
+	}
+
+	return filterAlertsForTriage(alerts, sel, labeledProbes, cutoff), nil
+}
+
+// runAlertTriage selects alerts with --selector/--all-active/--older-than,
+// applies the action to them in parallel and prints per-alert results and a
+// summary. With --mute-for, the probes of the triaged alerts are muted too.
+func runAlertTriage(ctx context.Context, apiClient *client.Client, action alertTriageAction, flags *alertTriageFlags) error {
+	// Validate all flags before making any API calls
+	var sel *alertSelector
+	if flags.selector != "" {
+		parsed, err := parseAlertSelector(flags.selector)
+		if err != nil {
+			return err
+		}
+		sel = parsed
+	}
+
+	var cutoff time.Time
+	if flags.olderThan != "" {
+		d, err := parseSinceDuration(flags.olderThan)
+		if err != nil {
+			return fmt.Errorf("invalid --older-than: %w", err)
+		}
+		cutoff = time.Now().Add(-d)
+	}
+
+	var muteMinutes int
+	if flags.muteFor != "" {
+		d, err := parseSinceDuration(flags.muteFor)
+		if err != nil {
+			return fmt.Errorf("invalid --mute-for: %w", err)
+		}
+		if d < time.Minute {
+			return fmt.Errorf("invalid --mute-for: must be at least 1m")
+		}
+		muteMinutes = int(d.Minutes())
+	}
+
+	alerts, err := selectAlertsForTriage(ctx, apiClient, action.statuses, sel, cutoff)
+	if err != nil {
+		return err
+	}
+
+	if len(alerts) == 0 {
+		return output.PrintEmpty("No alerts match the selection")
+	}
+
+	if GetDryRun() {
+		ids := make([]string, len(alerts))
+		for i, a := range alerts {
+			ids[i] = a.ID.String()
+		}
+		dryrun.PrintBatchAction(action.verb, "alert", ids)
+		if muteMinutes > 0 {
+			dryrun.PrintAction("create", "mute",
+				"Scope", string(client.MuteScopeProbe),
+				"Probes", strings.Join(alertProbeNames(alerts), ", "),
+				"Duration", fmt.Sprintf("%d minutes", muteMinutes),
+			)
+		}
+		return nil
+	}
+
+	// Prompt for confirmation unless --yes flag is set or --no-input is enabled
+	if !flags.yes && !GetNoInput() {
+		printAlertSelectionPreview(alerts)
+	}
+	confirmed, err := cliinteractive.Confirm(alertTriageConfirmMessage(action, len(alerts), muteMinutes),
+		cliinteractive.WithYesFlag(flags.yes))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Operation cancelled.")
+		return nil
+	}
+
+	printer := output.NewPrinter(GetConfig())
+	format := printer.Format()
+	structured := format == sdkoutput.FormatJSON || format == sdkoutput.FormatYAML
+
+	results := applyAlertTriage(ctx, apiClient, action, alerts, !structured)
+
+	response := &AlertTriageResponse{
+		Action:  action.verb,
+		Results: results,
+		Total:   len(results),
+	}
+	for _, r := range results {
+		if r.Success {
+			response.SuccessCount++
+		} else {
+			response.FailedCount++
+		}
+	}
+
+	if muteMinutes > 0 {
+		response.Mutes = muteTriagedProbes(ctx, apiClient, results, muteMinutes, action.verb)
+	}
+
+	if structured {
+		if err := output.Print(response); err != nil {
+			return err
+		}
+	} else {
+		if err := printAlertTriageSummary(response, action, muteMinutes); err != nil {
+			return err
+		}
+	}
+
+	if response.FailedCount > 0 {
+		return fmt.Errorf("failed to %s %d of %d alerts", action.verb, response.FailedCount, response.Total)
+	}
+	for _, m := range response.Mutes {
+		if m.Error != "" {
+			return fmt.Errorf("failed to mute one or more probes")
+		}
+	}
+
+	return nil
+}
+
+// applyAlertTriage applies the action to every alert using a bounded worker
+// pool. Results keep the order of alerts. When progress is true each outcome
+// is printed as soon as it is known.
+func applyAlertTriage(ctx context.Context, apiClient *client.Client, action alertTriageAction, alerts []client.Alert, progress bool) []AlertTriageResult {
+	results := make([]AlertTriageResult, len(alerts))
+	sem := make(chan struct{}, alertTriageConcurrency)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for i, a := range alerts {
+		wg.Add(1)
+		go func(i int, a client.Alert) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result := AlertTriageResult{
+				ID:          a.ID.String(),
+				Severity:    a.Severity,
+				TriggeredAt: a.TriggeredAt,
+			}
+			if probeID := triageAlertProbeID(a); probeID != uuid.Nil {
+				result.ProbeID = probeID.String()
+			}
+			if a.Probe != nil {
+				result.Probe = a.Probe.Name
+			}
+
+			alert, err := action.apply(ctx, apiClient, a.ID)
+			switch {
+			case err != nil:
+				result.Error = err.Error()
+			case alert == nil:
+				result.Error = "alert not found or already resolved"
+			default:
+				result.Success = true
+			}
+			results[i] = result
+
+			if progress {
+				mu.Lock()
+				if result.Success {
+					fmt.Printf("%s alert %s (%s)\n", action.past, result.ID, alertProbeLabel(result))
+				} else {
+					fmt.Printf("Failed to %s alert %s (%s): %s\n", action.verb, result.ID, alertProbeLabel(result), result.Error)
+				}
+				mu.Unlock()
+			}
+		}(i, a)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// muteTriagedProbes creates one probe mute per distinct probe among the
+// successfully triaged alerts.
+func muteTriagedProbes(ctx context.Context, apiClient *client.Client, results []AlertTriageResult, minutes int, verb string) []AlertTriageMute {
+	reason := fmt.Sprintf("Muted via bulk alert %s", verb)
+	seen := make(map[string]bool)
+	var mutes []AlertTriageMute
+
+	for _, r := range results {
+		if !r.Success || r.ProbeID == "" || seen[r.ProbeID] {
+			continue
+		}
+		seen[r.ProbeID] = true
+
+		probeID, err := uuid.Parse(r.ProbeID)
+		if err != nil {
+			continue
+		}
+
+		m := AlertTriageMute{ProbeID: r.ProbeID, Probe: r.Probe}
+		reqCtx, cancel := context.WithTimeout(ctx, alertAckTimeout)
+		mute, err := client.CreateMute(reqCtx, apiClient, &client.CreateMuteRequest{
+			ScopeType:       client.MuteScopeProbe,
+			ProbeID:         &probeID,
+			DurationMinutes: minutes,
+			Reason:          &reason,
+		})
+		cancel()
+		if err != nil {
+			m.Error = err.Error()
+		} else if mute != nil {
+			m.MuteID = mute.ID.String()
+		}
+		mutes = append(mutes, m)
+	}
+
+	return mutes
+}
+
+// printAlertTriageSummary prints the summary table and totals for table output.
+func printAlertTriageSummary(response *AlertTriageResponse, action alertTriageAction, muteMinutes int) error {
+	rows := make([]alertTriageRow, 0, len(response.Results))
+	for _, r := range response.Results {
+		result := strings.ToLower(action.past)
+		if !r.Success {
+			result = "failed: " + r.Error
+		}
+		rows = append(rows, alertTriageRow{
+			ID:       r.ID,
+			Probe:    alertProbeLabel(r),
+			Severity: string(r.Severity),
+			Age:      formatTriageAge(time.Since(r.TriggeredAt)),
+			Result:   result,
+		})
+	}
+
+	fmt.Println()
+	if err := output.Print(rows); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%s %d of %d alerts", action.past, response.SuccessCount, response.Total)
+	if response.FailedCount > 0 {
+		fmt.Printf(" (%d failed)", response.FailedCount)
+	}
+	fmt.Println(".")
+
+	for _, m := range response.Mutes {
+		name := m.Probe
+		if name == "" {
+			name = m.ProbeID
+		}
+		if m.Error != "" {
+			fmt.Printf("Failed to mute probe %q: %s\n", name, m.Error)
+			continue
+		}
+		fmt.Printf("Muted probe %q for %d minutes\n", name, muteMinutes)
+	}
+
+	return nil
+}
+
+// alertProbeLabel returns the probe name of a triage result, or "-".
+func alertProbeLabel(r AlertTriageResult) string {
+	if r.Probe == "" {
+		return "-"
+	}
+	return r.Probe
+}
+
+// alertTriageConfirmMessage builds the confirmation prompt for a bulk action.
+func alertTriageConfirmMessage(action alertTriageAction, count, muteMinutes int) string {
+	message := fmt.Sprintf("Are you sure you want to %s %d alert(s)", action.verb, count)
+	if muteMinutes > 0 {
+		message += fmt.Sprintf(" and mute their probes for %d minutes", muteMinutes)
+	}
+	return message + "?"
+}
+
+// printAlertSelectionPreview lists the selected alerts on stderr so they can
+// be reviewed before confirming.
+func printAlertSelectionPreview(alerts []client.Alert) {
+	fmt.Fprintf(os.Stderr, "Selected %d alert(s):\n", len(alerts))
+	now := time.Now()
+	for i, a := range alerts {
+		if i == probeSelectorPreviewLimit {
+			fmt.Fprintf(os.Stderr, "  ... and %d more\n", len(alerts)-probeSelectorPreviewLimit)
+			break
+		}
+		probe := a.ProbeID.String()
+		if a.Probe != nil {
+			probe = a.Probe.Name
+		}
+		fmt.Fprintf(os.Stderr, "  - %s %s (%s, %s ago)\n", a.ID, probe, a.Severity, formatTriageAge(now.Sub(a.TriggeredAt)))
+	}
+	fmt.Fprintln(os.Stderr)
+}
+
+// alertProbeNames returns the distinct probe names of alerts in order.
+func alertProbeNames(alerts []client.Alert) []string {
+	seen := make(map[uuid.UUID]bool)
+	var names []string
+	for _, a := range alerts {
+		if a.Probe == nil || seen[a.Probe.ID] {
+			continue
+		}
+		seen[a.Probe.ID] = true
+		names = append(names, a.Probe.Name)
+	}
+	return names
+}
+
+// formatTriageAge formats an alert age compactly (e.g. "45m", "3h", "2d").
+func formatTriageAge(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+}
@@ -0,0 +1,186 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAlertSelector(t *testing.T) {
+	sel, err := parseAlertSelector("severity=Warning, type=ssl_expiry, probe=API, probe-label=team=web, probe-label=env!=dev")
+	require.NoError(t, err)
+
+	assert.Equal(t, client.AlertSeverityWarning, sel.severity)
+	assert.Equal(t, client.AlertType("ssl_expiry"), sel.alertType)
+	assert.Equal(t, "API", sel.probe)
+	require.NotNil(t, sel.probeLabels)
+	assert.Equal(t, map[string]string{"team": "web"}, sel.probeLabels.include)
+	assert.Equal(t, map[string]string{"env": "dev"}, sel.probeLabels.exclude)
+}
+
+func TestParseAlertSelector_Invalid(t *testing.T) {
+	tests := []struct {
+		selector string
+		wantErr  string
+	}{
+		{"", "is empty"},
+		{"severity", "expected key=value"},
+		{"severity=urgent", "must be one of"},
+		{"status=active", "unknown selector key"},
+		{"probe-label=Team=web", "invalid probe-label selector"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			_, err := parseAlertSelector(tt.selector)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestFilterAlertsForTriage(t *testing.T) {
+	now := time.Now()
+	webProbe := &client.AlertProbe{ID: uuid.New(), Name: "Web"}
+	apiProbe := &client.AlertProbe{ID: uuid.New(), Name: "API"}
+
+	recentWarning := client.Alert{ID: uuid.New(), Severity: client.AlertSeverityWarning, TriggeredAt: now.Add(-10 * time.Minute), Probe: webProbe}
+	oldWarning := client.Alert{ID: uuid.New(), Severity: client.AlertSeverityWarning, TriggeredAt: now.Add(-3 * time.Hour), Probe: webProbe}
+	oldCritical := client.Alert{ID: uuid.New(), Severity: client.AlertSeverityCritical, TriggeredAt: now.Add(-5 * time.Hour), Probe: apiProbe}
+	alerts := []client.Alert{recentWarning, oldWarning, oldCritical}
+
+	// No selector and no cutoff keeps everything, oldest first
+	got := filterAlertsForTriage(alerts, nil, nil, time.Time{})
+	assert.Equal(t, []client.Alert{oldCritical, oldWarning, recentWarning}, got)
+
+	// Age cutoff
+	got = filterAlertsForTriage(alerts, nil, nil, now.Add(-2*time.Hour))
+	assert.Equal(t, []client.Alert{oldCritical, oldWarning}, got)
+
+	// Probe label terms only match probes in the labeled set
+	sel, err := parseAlertSelector("severity=warning,probe-label=team=web")
+	require.NoError(t, err)
+	got = filterAlertsForTriage(alerts, sel, map[uuid.UUID]bool{webProbe.ID: true}, time.Time{})
+	assert.Equal(t, []client.Alert{oldWarning, recentWarning}, got)
+
+	got = filterAlertsForTriage(alerts, sel, map[uuid.UUID]bool{apiProbe.ID: true}, time.Time{})
+	assert.Empty(t, got)
+
+	// Probe names match case-insensitively
+	sel, err = parseAlertSelector("probe=api")
+	require.NoError(t, err)
+	got = filterAlertsForTriage(alerts, sel, nil, time.Time{})
+	assert.Equal(t, []client.Alert{oldCritical}, got)
+}
+
+func TestFilterAlertsForTriage_WithoutEmbeddedProbe(t *testing.T) {
+	probeID := uuid.New()
+	alert := client.Alert{ID: uuid.New(), Severity: client.AlertSeverityWarning, ProbeID: probeID}
+
+	sel, err := parseAlertSelector("probe=" + probeID.String())
+	require.NoError(t, err)
+	assert.Equal(t, []client.Alert{alert}, filterAlertsForTriage([]client.Alert{alert}, sel, nil, time.Time{}))
+
+	sel, err = parseAlertSelector("probe-label=team=web")
+	require.NoError(t, err)
+	assert.Equal(t, []client.Alert{alert}, filterAlertsForTriage([]client.Alert{alert}, sel, map[uuid.UUID]bool{probeID: true}, time.Time{}))
+
+	sel, err = parseAlertSelector("probe=" + uuid.New().String())
+	require.NoError(t, err)
+	assert.Empty(t, filterAlertsForTriage([]client.Alert{alert}, sel, nil, time.Time{}))
+}
+
+func TestApplyAlertTriage_MutesProbeWithoutEmbeddedProbe(t *testing.T) {
+	probeID := uuid.New()
+	alert := client.Alert{ID: uuid.New(), Severity: client.AlertSeverityWarning, ProbeID: probeID}
+	action := alertTriageAction{
+		verb: "acknowledge",
+		past: "Acknowledged",
+		apply: func(ctx context.Context, apiClient *client.Client, alertID uuid.UUID) (*client.Alert, error) {
+			return &alert, nil
+		},
+	}
+
+	results := applyAlertTriage(context.Background(), nil, action, []client.Alert{alert}, false)
+	require.Len(t, results, 1)
+	assert.True(t, results[0].Success)
+	assert.Equal(t, probeID.String(), results[0].ProbeID)
+
+	var muteBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		muteBody = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	setupTestConfigWithURL(t, server.URL)
+	apiClient, err := api.GetClient()
+	require.NoError(t, err)
+
+	mutes := muteTriagedProbes(context.Background(), apiClient, results, 30, action.verb)
+	require.Len(t, mutes, 1)
+	assert.Equal(t, probeID.String(), mutes[0].ProbeID)
+	assert.Contains(t, muteBody, probeID.String())
+}
+
+func TestAlertTriageArgs(t *testing.T) {
+	flags := &alertTriageFlags{}
+	validate := alertTriageArgs(flags)
+	cmd := &cobra.Command{}
+
+	assert.Error(t, validate(cmd, nil))
+	assert.NoError(t, validate(cmd, []string{"id"}))
+
+	flags.olderThan = "2h"
+	assert.ErrorContains(t, validate(cmd, []string{"id"}), "require --selector or --all-active")
+
+	flags.allActive = true
+	assert.NoError(t, validate(cmd, nil))
+	assert.ErrorContains(t, validate(cmd, []string{"id"}), "cannot be combined")
+}
+
+func TestFormatTriageAge(t *testing.T) {
+	assert.Equal(t, "30s", formatTriageAge(30*time.Second))
+	assert.Equal(t, "45m", formatTriageAge(45*time.Minute))
+	assert.Equal(t, "3h", formatTriageAge(3*time.Hour+20*time.Minute))
+	assert.Equal(t, "2d", formatTriageAge(50*time.Hour))
+}
+
+func TestAlertTriageCommands_HaveFlags(t *testing.T) {
+	for _, cmd := range []*cobra.Command{NewAlertAckCmd(), NewAlertResolveCmd()} {
+		for _, name := range []string{"selector", "all-active", "older-than", "mute-for", "yes"} {
+			assert.NotNil(t, cmd.Flags().Lookup(name), "alert %s should have --%s", cmd.Name(), name)
+		}
+	}
+}
+
+func TestAlertTriageConfirmMessage(t *testing.T) {
+	action := alertTriageAction{verb: "resolve"}
+	assert.Equal(t, "Are you sure you want to resolve 12 alert(s)?", alertTriageConfirmMessage(action, 12, 0))
+	assert.Equal(t, "Are you sure you want to resolve 3 alert(s) and mute their probes for 60 minutes?",
+		alertTriageConfirmMessage(action, 3, 60))
+}
+
+func TestAlertAckCmd_InvalidSelector(t *testing.T) {
+	setupTestConfigWithURL(t, "http://127.0.0.1:0")
+
+	cmd := NewAlertAckCmd()
+	cmd.SetArgs([]string{"--selector", "severity=urgent"})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must be one of")
+}
@@ -0,0 +1,352 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	clierrors "github.com/StackEye-IO/stackeye-cli/internal/errors"
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// alertWatchTimeout is the maximum time to wait for each poll during watch.
+const alertWatchTimeout = 30 * time.Second
+
+// alertWatchPageSize is the page size used when listing open alerts.
+const alertWatchPageSize = 100
+
+// Alert watch event types.
+const (
+	alertEventNew          = "new"
+	alertEventAcknowledged = "acknowledged"
+	alertEventResolved     = "resolved"
+)
+
+// alertWatchFlags holds the flag values for the alert watch command.
+type alertWatchFlags struct {
+	interval time.Duration
+	severity string
+	probe    string
+	bell     bool
+	exec     string
+}
+
+// AlertWatchEvent is a single alert transition emitted by alert watch.
+type AlertWatchEvent struct {
+	Time  time.Time    `json:"time" yaml:"time"`
+	Event string       `json:"event" yaml:"event"`
+	Alert client.Alert `json:"alert" yaml:"alert"`
+}
+
+// NewAlertWatchCmd creates and returns the alert watch subcommand.
+func NewAlertWatchCmd() *cobra.Command {
+	flags := &alertWatchFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Stream new, acknowledged and resolved alerts",
+		Long: `Stream alert transitions as they happen.
+
+Polls the StackEye API at a configurable interval and prints one line per
+transition: a new alert, an alert being acknowledged, or an alert being
+resolved. Alerts that are already open when the watch starts are not
+reported. Press Ctrl+C to stop watching.
+
+With -o json, each transition is printed as a single JSON object per line
+so the stream can be piped into tools like jq.
+
+Hooks:
+  --exec runs a shell command for every new alert. The alert is written to
+  the command's stdin as JSON and these environment variables are set:
+
+    STACKEYE_ALERT_ID         Alert UUID
+    STACKEYE_ALERT_SEVERITY   critical, warning or info
+    STACKEYE_ALERT_STATUS     Alert status
+    STACKEYE_ALERT_PROBE_ID   Probe UUID (if the alert has a probe)
+    STACKEYE_ALERT_PROBE      Probe name (if the alert has a probe)
+
+  Hook output is written to stderr. A failing hook is reported and the
+  watch continues.
+
+Examples:
+  # Watch all alerts (default 10s poll)
+  stackeye alert watch
+
+  # Ring the terminal bell for new critical alerts
+  stackeye alert watch --bell
+
+  # Only critical alerts for one probe
+  stackeye alert watch --severity critical --probe "Production API"
+
+  # Post every new alert to a local script
+  stackeye alert watch --exec './notify.sh'
+
+  # Stream transitions as JSON lines
+  stackeye alert watch -o json | jq -r '.event + " " + .alert.id'`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAlertWatch(cmd.Context(), flags)
+		},
+	}
+
+	cmd.Flags().DurationVarP(&flags.interval, "interval", "i", 10*time.Second, "poll interval (minimum 2s)")
+	cmd.Flags().StringVar(&flags.severity, "severity", "", "only watch alerts of this severity: critical, warning, info")
+	cmd.Flags().StringVar(&flags.probe, "probe", "", "only watch alerts for this probe (ID or name)")
+	cmd.Flags().BoolVar(&flags.bell, "bell", false, "ring the terminal bell for new critical alerts")
+	cmd.Flags().StringVar(&flags.exec, "exec", "", "shell command to run for each new alert (alert JSON on stdin)")
+
+	_ = cmd.RegisterFlagCompletionFunc("probe", ProbeCompletion())
+
+	return cmd
+}
+
+// runAlertWatch executes the alert watch command logic.
+func runAlertWatch(ctx context.Context, flags *alertWatchFlags) error {
+	// Validate all flags before making any API calls
+	if flags.interval < 2*time.Second {
+		return fmt.Errorf("invalid interval %s: minimum is 2s", flags.interval)
+	}
+
+	var severity client.AlertSeverity
+	if flags.severity != "" {
+		switch flags.severity {
+		case "critical":
+			severity = client.AlertSeverityCritical
+		case "warning":
+			severity = client.AlertSeverityWarning
+		case "info":
+			severity = client.AlertSeverityInfo
+		default:
+			return clierrors.InvalidValueError("--severity", flags.severity, clierrors.ValidSeverities)
+		}
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	opts := client.ListAlertsOptions{Severity: severity}
+	if flags.probe != "" {
+		probeID, err := ResolveProbeID(ctx, apiClient, flags.probe)
+		if err != nil {
+			return err
+		}
+		opts.ProbeID = &probeID
+	}
+
+	// The first poll is the baseline: alerts already open are not reported
+	open, err := fetchOpenAlerts(ctx, apiClient, opts)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Watching alerts every %s, %d currently open (Ctrl+C to stop)\n", flags.interval, len(open))
+
+	printer := output.NewPrinter(GetConfig())
+	format := printer.Format()
+	emit := func(ev AlertWatchEvent) {
+		if err := printAlertWatchEvent(os.Stdout, ev, format); err != nil {
+			fmt.Fprintf(os.Stderr, "Error printing: %v\n", err)
+		}
+		if ev.Event != alertEventNew {
+			return
+		}
+		if flags.bell && ev.Alert.Severity == client.AlertSeverityCritical {
+			fmt.Fprint(os.Stderr, "\a")
+		}
+		if flags.exec != "" {
+			if err := runExecHook(ctx, flags.exec, ev.Alert, alertHookEnv(ev.Alert)); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			}
+		}
+	}
+
+	ticker := time.NewTicker(flags.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			fmt.Fprintln(os.Stderr, "\nWatch stopped.")
+			return nil
+		case <-ticker.C:
+			current, err := fetchOpenAlerts(ctx, apiClient, opts)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error polling: %v (retrying...)\n", err)
+				continue
+			}
+
+			now := time.Now()
+			events, gone := diffAlertSnapshots(open, current, now)
+			for _, ev := range events {
+				emit(ev)
+			}
+			lookup := func(a client.Alert) (*client.Alert, error) {
+				return getWatchedAlert(ctx, apiClient, a.ID)
+			}
+			for _, ev := range reconcileGoneAlerts(gone, current, now, lookup) {
+				emit(ev)
+			}
+			open = current
+		}
+	}
+}
+
+// fetchOpenAlerts lists every active and acknowledged alert matching opts,
+// keyed by alert ID.
+func fetchOpenAlerts(ctx context.Context, apiClient *client.Client, opts client.ListAlertsOptions) (map[uuid.UUID]client.Alert, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, alertWatchTimeout)
+	defer cancel()
+
+	open := make(map[uuid.UUID]client.Alert)
+	for _, status := range []client.AlertStatus{client.AlertStatusActive, client.AlertStatusAcknowledged} {
+		for offset := 0; ; offset += alertWatchPageSize {
+			pageOpts := opts
+			pageOpts.Status = status
+			pageOpts.Limit = alertWatchPageSize
+			pageOpts.Offset = offset
+
+			result, err := client.ListAlerts(reqCtx, apiClient, &pageOpts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list alerts: %w", err)
+			}
+			for _, a := range result.Alerts {
+				open[a.ID] = a
+			}
+			if len(result.Alerts) < alertWatchPageSize {
+				break
+			}
+		}
+	}
+	return open, nil
+}
+
+// diffAlertSnapshots compares two polls of open alerts. It returns new and
+// newly acknowledged alerts as events (oldest first) and the alerts missing
+// from the latest poll, which reconcileGoneAlerts checks before reporting.
+func diffAlertSnapshots(prev, curr map[uuid.UUID]client.Alert, now time.Time) ([]AlertWatchEvent, []client.Alert) {
+	var events []AlertWatchEvent
+	for id, a := range curr {
+		old, seen := prev[id]
+		switch {
+		case !seen:
+			events = append(events, AlertWatchEvent{Time: now, Event: alertEventNew, Alert: a})
+		case old.Status != client.AlertStatusAcknowledged && a.Status == client.AlertStatusAcknowledged:
+			events = append(events, AlertWatchEvent{Time: now, Event: alertEventAcknowledged, Alert: a})
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Alert.TriggeredAt.Before(events[j].Alert.TriggeredAt)
+	})
+
+	var gone []client.Alert
+	for id, a := range prev {
+		if _, ok := curr[id]; !ok {
+			gone = append(gone, a)
+		}
+	}
+	sort.SliceStable(gone, func(i, j int) bool {
+		return gone[i].TriggeredAt.Before(gone[j].TriggeredAt)
+	})
+
+	return events, gone
+}
+
+// reconcileGoneAlerts looks up every alert missing from the latest poll and
+// reports it as resolved only if its current status is resolved. An alert can
+// also go missing when its status changes between the per-status list calls
+// or when it falls past the page limit; alerts still open, and alerts whose
+// lookup failed, are put back into curr so they are not reported as new on
+// the next poll. Deleted alerts are dropped without an event.
+func reconcileGoneAlerts(gone []client.Alert, curr map[uuid.UUID]client.Alert, now time.Time, lookup func(client.Alert) (*client.Alert, error)) []AlertWatchEvent {
+	var events []AlertWatchEvent
+	for _, last := range gone {
+		alert, err := lookup(last)
+		switch {
+		case err != nil:
+			curr[last.ID] = last
+		case alert == nil:
+			// Deleted: no longer open, but not resolved either
+		case alert.Status == client.AlertStatusResolved:
+			events = append(events, AlertWatchEvent{Time: now, Event: alertEventResolved, Alert: *alert})
+		default:
+			if last.Status != client.AlertStatusAcknowledged && alert.Status == client.AlertStatusAcknowledged {
+				events = append(events, AlertWatchEvent{Time: now, Event: alertEventAcknowledged, Alert: *alert})
+			}
+			curr[alert.ID] = *alert
+		}
+	}
+	return events
+}
+
+// getWatchedAlert fetches the current state of one alert.
+func getWatchedAlert(ctx context.Context, apiClient *client.Client, id uuid.UUID) (*client.Alert, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, alertWatchTimeout)
+	defer cancel()
+
+	return client.GetAlert(reqCtx, apiClient, id)
+}
+
+// printAlertWatchEvent writes one event: a JSON object per line for JSON
+// output, a YAML document for YAML output, or a single text line otherwise.
+func printAlertWatchEvent(w io.Writer, ev AlertWatchEvent, format sdkoutput.Format) error {
+	switch format {
+	case sdkoutput.FormatJSON:
+		return json.NewEncoder(w).Encode(ev)
+	case sdkoutput.FormatYAML:
+		return output.Print(ev)
+	}
+
+	probe := "-"
+	if ev.Alert.Probe != nil {
+		probe = ev.Alert.Probe.Name
+	}
+	line := fmt.Sprintf("%s  %-12s  %-8s  %-30s  %s",
+		ev.Time.Format("2006-01-02 15:04:05"), alertEventLabel(ev.Event), ev.Alert.Severity,
+		truncate(probe, 30), ev.Alert.ID)
+	if ev.Alert.Message != nil && *ev.Alert.Message != "" {
+		line += "  " + *ev.Alert.Message
+	}
+	_, err := fmt.Fprintln(w, line)
+	return err
+}
+
+// alertEventLabel returns the display label for an event type.
+func alertEventLabel(event string) string {
+	switch event {
+	case alertEventNew:
+		return "NEW"
+	case alertEventAcknowledged:
+		return "ACKNOWLEDGED"
+	case alertEventResolved:
+		return "RESOLVED"
+	default:
+		return event
+	}
+}
+
+// alertHookEnv returns the environment variables passed to --exec hooks.
+func alertHookEnv(a client.Alert) []string {
+	env := []string{
+		"STACKEYE_ALERT_ID=" + a.ID.String(),
+		"STACKEYE_ALERT_SEVERITY=" + string(a.Severity),
+		"STACKEYE_ALERT_STATUS=" + string(a.Status),
+	}
+	if a.Probe != nil {
+		env = append(env,
+			"STACKEYE_ALERT_PROBE_ID="+a.Probe.ID.String(),
+			"STACKEYE_ALERT_PROBE="+a.Probe.Name,
+		)
+	}
+	return env
+}
@@ -0,0 +1,236 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+)
+
+func watchAlert(status client.AlertStatus, triggered time.Time) client.Alert {
+	return client.Alert{
+		ID:          uuid.New(),
+		Status:      status,
+		Severity:    client.AlertSeverityCritical,
+		TriggeredAt: triggered,
+	}
+}
+
+func TestNewAlertWatchCmd(t *testing.T) {
+	cmd := NewAlertWatchCmd()
+
+	if cmd.Use != "watch" {
+		t.Errorf("expected Use='watch', got %q", cmd.Use)
+	}
+
+	for _, name := range []string{"interval", "severity", "probe", "bell", "exec"} {
+		if cmd.Flags().Lookup(name) == nil {
+			t.Errorf("expected --%s flag", name)
+		}
+	}
+
+	if err := cmd.Args(cmd, []string{"extra"}); err == nil {
+		t.Error("expected positional arguments to be rejected")
+	}
+}
+
+func TestRunAlertWatch_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   alertWatchFlags
+		wantErr string
+	}{
+		{"interval too short", alertWatchFlags{interval: time.Second}, "minimum is 2s"},
+		{"invalid severity", alertWatchFlags{interval: 10 * time.Second, severity: "urgent"}, "urgent"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runAlertWatch(context.Background(), &tt.flags)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestDiffAlertSnapshots(t *testing.T) {
+	now := time.Now()
+	stillActive := watchAlert(client.AlertStatusActive, now.Add(-time.Hour))
+	toAck := watchAlert(client.AlertStatusActive, now.Add(-30*time.Minute))
+	toResolve := watchAlert(client.AlertStatusAcknowledged, now.Add(-20*time.Minute))
+	newer := watchAlert(client.AlertStatusActive, now.Add(-time.Minute))
+	older := watchAlert(client.AlertStatusActive, now.Add(-2*time.Minute))
+
+	prev := map[uuid.UUID]client.Alert{
+		stillActive.ID: stillActive,
+		toAck.ID:       toAck,
+		toResolve.ID:   toResolve,
+	}
+
+	acked := toAck
+	acked.Status = client.AlertStatusAcknowledged
+	curr := map[uuid.UUID]client.Alert{
+		stillActive.ID: stillActive,
+		acked.ID:       acked,
+		newer.ID:       newer,
+		older.ID:       older,
+	}
+
+	events, gone := diffAlertSnapshots(prev, curr, now)
+
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d: %+v", len(events), events)
+	}
+	// Events are ordered oldest first by trigger time
+	if events[0].Event != alertEventAcknowledged || events[0].Alert.ID != toAck.ID {
+		t.Errorf("expected acknowledged event first, got %s for %s", events[0].Event, events[0].Alert.ID)
+	}
+	if events[1].Event != alertEventNew || events[1].Alert.ID != older.ID {
+		t.Errorf("expected new event for older alert, got %s for %s", events[1].Event, events[1].Alert.ID)
+	}
+	if events[2].Event != alertEventNew || events[2].Alert.ID != newer.ID {
+		t.Errorf("expected new event for newer alert, got %s for %s", events[2].Event, events[2].Alert.ID)
+	}
+
+	if len(gone) != 1 || gone[0].ID != toResolve.ID {
+		t.Errorf("expected resolved alert %s, got %+v", toResolve.ID, gone)
+	}
+}
+
+func TestDiffAlertSnapshots_NoChanges(t *testing.T) {
+	a := watchAlert(client.AlertStatusActive, time.Now())
+	snap := map[uuid.UUID]client.Alert{a.ID: a}
+
+	events, gone := diffAlertSnapshots(snap, snap, time.Now())
+	if len(events) != 0 || len(gone) != 0 {
+		t.Errorf("expected no transitions, got %d events and %d resolved", len(events), len(gone))
+	}
+}
+
+func TestReconcileGoneAlerts(t *testing.T) {
+	now := time.Now()
+	resolved := watchAlert(client.AlertStatusActive, now.Add(-time.Hour))
+	stillActive := watchAlert(client.AlertStatusActive, now.Add(-50*time.Minute))
+	nowAcked := watchAlert(client.AlertStatusActive, now.Add(-40*time.Minute))
+	lookupFails := watchAlert(client.AlertStatusAcknowledged, now.Add(-30*time.Minute))
+	deleted := watchAlert(client.AlertStatusActive, now.Add(-20*time.Minute))
+
+	lookup := func(a client.Alert) (*client.Alert, error) {
+		switch a.ID {
+		case resolved.ID:
+			a.Status = client.AlertStatusResolved
+		case nowAcked.ID:
+			a.Status = client.AlertStatusAcknowledged
+		case lookupFails.ID:
+			return nil, errors.New("connection reset")
+		case deleted.ID:
+			return nil, nil
+		}
+		return &a, nil
+	}
+
+	curr := map[uuid.UUID]client.Alert{}
+	gone := []client.Alert{resolved, stillActive, nowAcked, lookupFails, deleted}
+	events := reconcileGoneAlerts(gone, curr, now, lookup)
+
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d: %+v", len(events), events)
+	}
+	if events[0].Event != alertEventResolved || events[0].Alert.ID != resolved.ID {
+		t.Errorf("expected resolved event for %s, got %s for %s", resolved.ID, events[0].Event, events[0].Alert.ID)
+	}
+	if events[1].Event != alertEventAcknowledged || events[1].Alert.ID != nowAcked.ID {
+		t.Errorf("expected acknowledged event for %s, got %s for %s", nowAcked.ID, events[1].Event, events[1].Alert.ID)
+	}
+
+	// Alerts still open or not looked up stay in the snapshot
+	for _, a := range []client.Alert{stillActive, nowAcked, lookupFails} {
+		if _, ok := curr[a.ID]; !ok {
+			t.Errorf("expected %s to be kept in the snapshot", a.ID)
+		}
+	}
+	if curr[nowAcked.ID].Status != client.AlertStatusAcknowledged {
+		t.Errorf("expected snapshot to hold the fetched status, got %s", curr[nowAcked.ID].Status)
+	}
+	for _, a := range []client.Alert{resolved, deleted} {
+		if _, ok := curr[a.ID]; ok {
+			t.Errorf("expected %s to be dropped from the snapshot", a.ID)
+		}
+	}
+}
+
+func TestPrintAlertWatchEvent_Text(t *testing.T) {
+	msg := "HTTP 503"
+	a := watchAlert(client.AlertStatusActive, time.Now())
+	a.Probe = &client.AlertProbe{ID: uuid.New(), Name: "Production API"}
+	a.Message = &msg
+
+	var buf bytes.Buffer
+	ev := AlertWatchEvent{Time: time.Date(2026, 1, 2, 3, 4, 5, 0, time.Local), Event: alertEventNew, Alert: a}
+	if err := printAlertWatchEvent(&buf, ev, sdkoutput.FormatTable); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	line := buf.String()
+	for _, want := range []string{"2026-01-02 03:04:05", "NEW", "critical", "Production API", a.ID.String(), "HTTP 503"} {
+		if !strings.Contains(line, want) {
+			t.Errorf("expected %q in output, got %q", want, line)
+		}
+	}
+	if strings.Count(line, "\n") != 1 {
+		t.Errorf("expected a single line, got %q", line)
+	}
+}
+
+func TestPrintAlertWatchEvent_JSONLines(t *testing.T) {
+	a := watchAlert(client.AlertStatusResolved, time.Now())
+
+	var buf bytes.Buffer
+	ev := AlertWatchEvent{Time: time.Now(), Event: alertEventResolved, Alert: a}
+	if err := printAlertWatchEvent(&buf, ev, sdkoutput.FormatJSON); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if strings.Count(buf.String(), "\n") != 1 {
+		t.Errorf("expected one JSON object per line, got %q", buf.String())
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if decoded["event"] != alertEventResolved {
+		t.Errorf("expected event %q, got %v", alertEventResolved, decoded["event"])
+	}
+}
+
+func TestAlertHookEnv(t *testing.T) {
+	a := watchAlert(client.AlertStatusActive, time.Now())
+
+	env := alertHookEnv(a)
+	if len(env) != 3 {
+		t.Errorf("expected 3 variables without a probe, got %v", env)
+	}
+
+	a.Probe = &client.AlertProbe{ID: uuid.New(), Name: "web"}
+	env = alertHookEnv(a)
+	joined := strings.Join(env, "\n")
+	for _, want := range []string{
+		"STACKEYE_ALERT_ID=" + a.ID.String(),
+		"STACKEYE_ALERT_SEVERITY=critical",
+		"STACKEYE_ALERT_STATUS=active",
+		"STACKEYE_ALERT_PROBE=web",
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("expected %q in env, got %v", want, env)
+		}
+	}
+}
@@ -31,6 +31,7 @@ Available Commands:
   update      Update an existing channel
   delete      Delete a notification channel
   test        Send a test notification through a channel
+  audit       Check that every probe notifies someone
   wizard      Interactive wizard for creating channels
 
 Examples:
@@ -64,6 +65,9 @@ Examples:
   # Delete without confirmation
   stackeye channel delete <channel-id> --yes
 
+  # Find probes without channels and channels linked to nothing
+  stackeye channel audit
+
 For more information about a specific command:
   stackeye channel [command] --help`,
 		Aliases: []string{"channels", "ch"},
@@ -76,6 +80,7 @@ For more information about a specific command:
 	cmd.AddCommand(NewChannelUpdateCmd())
 	cmd.AddCommand(NewChannelDeleteCmd())
 	cmd.AddCommand(NewChannelTestCmd())
+	cmd.AddCommand(NewChannelAuditCmd())
 	cmd.AddCommand(NewChannelWizardCmd())
 
 	return cmd
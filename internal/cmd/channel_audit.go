@@ -0,0 +1,262 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	clierrors "github.com/StackEye-IO/stackeye-cli/internal/errors"
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// channelAuditTimeout is the maximum time to wait for probes and channels.
+const channelAuditTimeout = 60 * time.Second
+
+// channelAuditPageSize is the number of channels requested per page.
+const channelAuditPageSize = 100
+
+// Channel audit checks.
+const (
+	channelCheckNoChannel       = "no-channel"
+	channelCheckUnusedChannel   = "unused-channel"
+	channelCheckDisabledChannel = "disabled-channel"
+	channelCheckEmailOnly       = "email-only"
+	channelCheckMissingChannel  = "missing-channel"
+)
+
+// channelAuditFlags holds the flag values for the channel audit command.
+type channelAuditFlags struct {
+	critical string
+	failOn   string
+}
+
+// ChannelAuditFinding is a single problem reported by channel audit.
+// This struct is exported to allow JSON/YAML serialization with proper field tags.
+type ChannelAuditFinding struct {
+	Severity string `json:"severity" yaml:"severity" table:"SEVERITY"`
+	Check    string `json:"check" yaml:"check" table:"CHECK"`
+	Kind     string `json:"kind" yaml:"kind" table:"KIND"`
+	Name     string `json:"name" yaml:"name" table:"NAME"`
+	ID       string `json:"id" yaml:"id" table:"ID,wide"`
+	Message  string `json:"message" yaml:"message" table:"MESSAGE"`
+}
+
+// NewChannelAuditCmd creates and returns the channel audit subcommand.
+func NewChannelAuditCmd() *cobra.Command {
+	flags := &channelAuditFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Check that every probe notifies someone",
+		Long: `Cross-reference probes and their linked channels to find gaps in alert coverage.
+
+Checks:
+  no-channel        error    an active probe has no enabled alert channels
+  disabled-channel  error    a critical probe is linked to a disabled channel
+  missing-channel   warning  an active probe is linked to a deleted channel
+  email-only        warning  an active probe is only notified by email
+  unused-channel    warning  a channel is not linked to any probe
+
+Paused probes are skipped. By default every active probe is critical; use
+--critical with a label selector to limit the disabled-channel check to
+matching probes.
+
+Exit Codes:
+  0  No findings at or above the --fail-on severity
+  1  At least one finding at or above the --fail-on severity
+
+Examples:
+  # Audit channel coverage
+  stackeye channel audit
+
+  # Only flag disabled channels on probes labelled tier=critical
+  stackeye channel audit --critical tier=critical
+
+  # Fail CI on warnings too
+  stackeye channel audit --fail-on warning
+
+  # Output findings as JSON
+  stackeye channel audit -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runChannelAudit(cmd.Context(), flags)
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.critical, "critical", "", "label selector for critical probes (default: all active probes)")
+	cmd.Flags().StringVar(&flags.failOn, "fail-on", lintSeverityError, "exit with 1 on findings of this severity or higher: error, warning, none")
+
+	return cmd
+}
+
+// runChannelAudit executes the channel audit command logic.
+func runChannelAudit(ctx context.Context, flags *channelAuditFlags) error {
+	switch flags.failOn {
+	case lintSeverityError, lintSeverityWarning, "none":
+	default:
+		return clierrors.InvalidValueError("--fail-on", flags.failOn, []string{lintSeverityError, lintSeverityWarning, "none"})
+	}
+
+	var critical *labelSelector
+	if flags.critical != "" {
+		var err error
+		critical, err = parseLabelSelector(flags.critical)
+		if err != nil {
+			return err
+		}
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, channelAuditTimeout)
+	defer cancel()
+
+	probes, err := fetchAllProbesForExport(reqCtx, apiClient, "", nil)
+	if err != nil {
+		return err
+	}
+	channels, err := fetchAllChannels(reqCtx, apiClient)
+	if err != nil {
+		return err
+	}
+
+	findings := auditChannels(probes, channels, critical)
+	summary := fmt.Sprintf("No problems found in %d probe(s) and %d channel(s)", len(probes), len(channels))
+	if err := output.PrintIfNotEmpty(findings, summary); err != nil {
+		return err
+	}
+
+	errorCount := 0
+	for _, f := range findings {
+		if f.Severity == lintSeverityError {
+			errorCount++
+		}
+	}
+	warningCount := len(findings) - errorCount
+	if len(findings) > 0 {
+		fmt.Fprintf(os.Stderr, "\n%d error(s), %d warning(s) in %d probe(s) and %d channel(s)\n",
+			errorCount, warningCount, len(probes), len(channels))
+	}
+
+	if (errorCount > 0 && flags.failOn != "none") || (warningCount > 0 && flags.failOn == lintSeverityWarning) {
+		return clierrors.WithExitCode(clierrors.ExitError, nil)
+	}
+	return nil
+}
+
+// fetchAllChannels lists every notification channel, paginating through all
+// results.
+func fetchAllChannels(ctx context.Context, apiClient *client.Client) ([]client.Channel, error) {
+	var channels []client.Channel
+	for offset := 0; ; offset += channelAuditPageSize {
+		result, err := client.ListChannels(ctx, apiClient, &client.ListChannelsOptions{
+			Limit:  channelAuditPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list channels: %w", err)
+		}
+		channels = append(channels, result.Channels...)
+		if len(result.Channels) < channelAuditPageSize {
+			return channels, nil
+		}
+	}
+}
+
+// auditChannels cross-references probes and channels. A nil critical selector
+// treats every active probe as critical. Probe findings come first, sorted by
+// probe name, followed by channel findings sorted by channel name.
+func auditChannels(probes []client.Probe, channels []client.Channel, critical *labelSelector) []ChannelAuditFinding {
+	byID := make(map[uuid.UUID]*client.Channel, len(channels))
+	for i := range channels {
+		byID[channels[i].ID] = &channels[i]
+	}
+
+	sorted := make([]client.Probe, len(probes))
+	copy(sorted, probes)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	var findings []ChannelAuditFinding
+	linked := make(map[uuid.UUID]bool)
+	for _, p := range sorted {
+		for _, id := range p.AlertChannelIDs {
+			linked[id] = true
+		}
+		if strings.EqualFold(p.Status, "paused") {
+			continue
+		}
+
+		add := func(severity, check, format string, args ...any) {
+			findings = append(findings, ChannelAuditFinding{
+				Severity: severity,
+				Check:    check,
+				Kind:     "probe",
+				Name:     p.Name,
+				ID:       p.ID.String(),
+				Message:  fmt.Sprintf(format, args...),
+			})
+		}
+
+		if len(p.AlertChannelIDs) == 0 {
+			add(lintSeverityError, channelCheckNoChannel, "no alert channels linked")
+			continue
+		}
+
+		isCritical := critical == nil || critical.matches(p)
+		enabled, email := 0, 0
+		for _, id := range p.AlertChannelIDs {
+			ch, ok := byID[id]
+			if !ok {
+				add(lintSeverityWarning, channelCheckMissingChannel, "linked to deleted channel %s", id)
+				continue
+			}
+			if !ch.Enabled {
+				if isCritical {
+					add(lintSeverityError, channelCheckDisabledChannel, "linked to disabled channel %q", ch.Name)
+				}
+				continue
+			}
+			enabled++
+			if ch.Type == client.ChannelTypeEmail {
+				email++
+			}
+		}
+		if enabled == 0 {
+			add(lintSeverityError, channelCheckNoChannel, "no enabled alert channels (%d linked)", len(p.AlertChannelIDs))
+			continue
+		}
+		if email == enabled {
+			add(lintSeverityWarning, channelCheckEmailOnly, "only notified by email (%d channel(s))", email)
+		}
+	}
+
+	sortedChannels := make([]client.Channel, len(channels))
+	copy(sortedChannels, channels)
+	sort.SliceStable(sortedChannels, func(i, j int) bool { return sortedChannels[i].Name < sortedChannels[j].Name })
+	for _, ch := range sortedChannels {
+		if linked[ch.ID] {
+			continue
+		}
+		findings = append(findings, ChannelAuditFinding{
+			Severity: lintSeverityWarning,
+			Check:    channelCheckUnusedChannel,
+			Kind:     "channel",
+			Name:     ch.Name,
+			ID:       ch.ID.String(),
+			Message:  fmt.Sprintf("%s channel not linked to any probe", ch.Type),
+		})
+	}
+
+	return findings
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+)
+
+func TestNewChannelAuditCmd(t *testing.T) {
+	cmd := NewChannelAuditCmd()
+
+	if cmd.Use != "audit" {
+		t.Errorf("Use = %q, want %q", cmd.Use, "audit")
+	}
+	if cmd.Short == "" {
+		t.Error("Short description should not be empty")
+	}
+	if cmd.Flags().Lookup("critical") == nil {
+		t.Error("expected --critical flag to be defined")
+	}
+	if f := cmd.Flags().Lookup("fail-on"); f == nil || f.DefValue != "error" {
+		t.Error("expected --fail-on flag with default error")
+	}
+}
+
+func TestRunChannelAudit_Validation(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags channelAuditFlags
+	}{
+		{"invalid fail-on", channelAuditFlags{failOn: "sometimes"}},
+		{"invalid selector", channelAuditFlags{failOn: "error", critical: ","}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runChannelAudit(context.Background(), &tt.flags)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if strings.Contains(err.Error(), "API client") {
+				t.Errorf("expected validation before API client init, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestAuditChannels(t *testing.T) {
+	slack := client.Channel{ID: uuid.New(), Name: "ops-slack", Type: client.ChannelTypeSlack, Enabled: true}
+	email := client.Channel{ID: uuid.New(), Name: "ops-email", Type: client.ChannelTypeEmail, Enabled: true}
+	disabled := client.Channel{ID: uuid.New(), Name: "old-pager", Type: client.ChannelTypePagerDuty, Enabled: false}
+	unused := client.Channel{ID: uuid.New(), Name: "unused-hook", Type: client.ChannelTypeWebhook, Enabled: true}
+	channels := []client.Channel{slack, email, disabled, unused}
+
+	tier := "critical"
+	probes := []client.Probe{
+		{ID: uuid.New(), Name: "api", Status: "up", AlertChannelIDs: []uuid.UUID{slack.ID, disabled.ID},
+			Labels: []client.ProbeLabel{{Key: "tier", Value: &tier}}},
+		{ID: uuid.New(), Name: "blog", Status: "up", AlertChannelIDs: []uuid.UUID{email.ID}},
+		{ID: uuid.New(), Name: "cron", Status: "down"},
+		{ID: uuid.New(), Name: "docs", Status: "up", AlertChannelIDs: []uuid.UUID{email.ID, disabled.ID}},
+		{ID: uuid.New(), Name: "legacy", Status: "paused"},
+	}
+
+	format := func(findings []ChannelAuditFinding) string {
+		var lines []string
+		for _, f := range findings {
+			lines = append(lines, f.Severity+" "+f.Check+" "+f.Name)
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	got := format(auditChannels(probes, channels, nil))
+	want := strings.Join([]string{
+		"error disabled-channel api",
+		"warning email-only blog",
+		"error no-channel cron",
+		"error disabled-channel docs",
+		"warning email-only docs",
+		"warning unused-channel unused-hook",
+	}, "\n")
+	if got != want {
+		t.Errorf("findings:\n%s\nwant:\n%s", got, want)
+	}
+
+	sel, err := parseLabelSelector("tier=critical")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = format(auditChannels(probes, channels, sel))
+	if strings.Contains(got, "disabled-channel docs") || !strings.Contains(got, "disabled-channel api") {
+		t.Errorf("expected disabled-channel only for probes matching --critical, got:\n%s", got)
+	}
+}
+
+func TestAuditChannels_NoEnabledChannels(t *testing.T) {
+	disabled := client.Channel{ID: uuid.New(), Name: "old-pager", Type: client.ChannelTypePagerDuty, Enabled: false}
+	slack := client.Channel{ID: uuid.New(), Name: "ops-slack", Type: client.ChannelTypeSlack, Enabled: true}
+	deleted := uuid.New()
+	channels := []client.Channel{disabled, slack}
+
+	tier := "critical"
+	probes := []client.Probe{
+		{ID: uuid.New(), Name: "api", Status: "up", AlertChannelIDs: []uuid.UUID{slack.ID, deleted}},
+		{ID: uuid.New(), Name: "blog", Status: "up", AlertChannelIDs: []uuid.UUID{disabled.ID}},
+		{ID: uuid.New(), Name: "cron", Status: "up", AlertChannelIDs: []uuid.UUID{deleted}},
+		{ID: uuid.New(), Name: "db", Status: "up", AlertChannelIDs: []uuid.UUID{slack.ID},
+			Labels: []client.ProbeLabel{{Key: "tier", Value: &tier}}},
+	}
+
+	sel, err := parseLabelSelector("tier=critical")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, f := range auditChannels(probes, channels, sel) {
+		got = append(got, f.Severity+" "+f.Check+" "+f.Name)
+	}
+	want := []string{
+		"warning missing-channel api",
+		"error no-channel blog",
+		"warning missing-channel cron",
+		"error no-channel cron",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("findings:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestAuditChannels_Clean(t *testing.T) {
+	slack := client.Channel{ID: uuid.New(), Name: "ops", Type: client.ChannelTypeSlack, Enabled: true}
+	probes := []client.Probe{{ID: uuid.New(), Name: "api", Status: "up", AlertChannelIDs: []uuid.UUID{slack.ID}}}
+
+	if findings := auditChannels(probes, []client.Channel{slack}, nil); len(findings) != 0 {
+		t.Errorf("expected no findings, got %+v", findings)
+	}
+}
@@ -27,8 +27,9 @@ func NewChannelDeleteCmd() *cobra.Command {
 	flags := &channelDeleteFlags{}
 
 	cmd := &cobra.Command{
-		Use:   "delete <id>",
-		Short: "Delete a notification channel",
+		Use:               "delete <id>",
+		Short:             "Delete a notification channel",
+		ValidArgsFunction: ChannelCompletion(),
 		Long: `Delete a notification channel by its ID.
 
 This permanently removes the channel configuration. Probes that were using this
@@ -19,8 +19,9 @@ const channelGetTimeout = 30 * time.Second
 // NewChannelGetCmd creates and returns the channel get subcommand.
 func NewChannelGetCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get <id>",
-		Short: "Get details of a notification channel",
+		Use:               "get <id>",
+		Short:             "Get details of a notification channel",
+		ValidArgsFunction: ChannelCompletion(),
 		Long: `Get detailed information about a specific notification channel.
 
 Displays the full channel configuration including name, type, enabled status,
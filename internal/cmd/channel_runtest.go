@@ -4,10 +4,16 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/StackEye-IO/stackeye-cli/internal/api"
 	"github.com/StackEye-IO/stackeye-cli/internal/dryrun"
+	clierrors "github.com/StackEye-IO/stackeye-cli/internal/errors"
 	"github.com/StackEye-IO/stackeye-cli/internal/output"
 	"github.com/StackEye-IO/stackeye-go-sdk/client"
 	"github.com/google/uuid"
@@ -18,6 +24,16 @@ import (
 // This includes time to send a test notification and receive the result.
 const channelTestTimeout = 60 * time.Second
 
+// channelTestConcurrency is the maximum number of channels tested at once by
+// channel test --all.
+const channelTestConcurrency = 5
+
+// channelTestFlags holds the flag values for the channel test command.
+type channelTestFlags struct {
+	all         bool
+	channelType string
+}
+
 // ChannelTestResult wraps the test response with channel metadata for output formatting.
 // This struct is exported to allow JSON/YAML serialization with proper field tags.
 type ChannelTestResult struct {
@@ -30,11 +46,25 @@ type ChannelTestResult struct {
 	ResponseTimeMs int       `json:"response_time_ms" yaml:"response_time_ms"`
 }
 
+// ChannelTestRunResult is one row of the channel test --all report.
+// This struct is exported to allow JSON/YAML serialization with proper field tags.
+type ChannelTestRunResult struct {
+	Channel string `json:"channel" yaml:"channel" table:"CHANNEL"`
+	ID      string `json:"id" yaml:"id" table:"ID,wide"`
+	Type    string `json:"type" yaml:"type" table:"TYPE"`
+	Status  string `json:"status" yaml:"status" table:"STATUS"`
+	Latency string `json:"latency" yaml:"latency" table:"LATENCY"`
+	Error   string `json:"error,omitempty" yaml:"error,omitempty" table:"ERROR"`
+}
+
 // NewChannelTestCmd creates and returns the channel test subcommand.
 func NewChannelTestCmd() *cobra.Command {
+	flags := &channelTestFlags{}
+
 	cmd := &cobra.Command{
-		Use:   "test <id>",
-		Short: "Send a test notification through a channel",
+		Use:               "test <id>",
+		Short:             "Send a test notification through a channel",
+		ValidArgsFunction: ChannelCompletion(),
 		Long: `Send a test notification through a notification channel to verify it's configured correctly.
 
 This command sends a test notification message through the specified channel and
@@ -54,6 +84,11 @@ This is useful for:
   - Testing Slack/Discord/Teams integrations
   - Troubleshooting channel delivery issues
 
+With --all, or --type to limit the run to one channel type, every channel is
+tested in parallel instead of a single one. The results are printed as a table
+of delivery status, latency and error per channel, and the command exits with
+1 if any channel fails - useful for a periodic notification fire drill.
+
 Examples:
   # Test a notification channel
   stackeye channel test 550e8400-e29b-41d4-a716-446655440000
@@ -62,13 +97,33 @@ Examples:
   stackeye channel test 550e8400-e29b-41d4-a716-446655440000 -o json
 
   # Using short form
-  stackeye ch test 550e8400-e29b-41d4-a716-446655440000`,
-		Args: cobra.ExactArgs(1),
+  stackeye ch test 550e8400-e29b-41d4-a716-446655440000
+
+  # Test every channel
+  stackeye channel test --all
+
+  # Test every Slack channel
+  stackeye channel test --type slack`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if flags.all || flags.channelType != "" {
+				if len(args) > 0 {
+					return fmt.Errorf("a channel ID cannot be combined with --all or --type")
+				}
+				return nil
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if flags.all || flags.channelType != "" {
+				return runChannelTestAll(cmd.Context(), flags)
+			}
 			return runChannelTest(cmd.Context(), args[0])
 		},
 	}
 
+	cmd.Flags().BoolVar(&flags.all, "all", false, "test every notification channel")
+	cmd.Flags().StringVarP(&flags.channelType, "type", "t", "", "test every channel of this type: email, slack, webhook, pagerduty, discord, teams, sms")
+
 	return cmd
 }
 
@@ -131,3 +186,120 @@ func runChannelTest(ctx context.Context, idArg string) error {
 	// Print using configured output format (supports json, yaml, table)
 	return output.Print(testResult)
 }
+
+// runChannelTestAll tests every channel, or every channel of flags.channelType,
+// and exits with 1 if any test fails.
+func runChannelTestAll(ctx context.Context, flags *channelTestFlags) error {
+	if flags.channelType != "" && !slices.Contains(clierrors.ValidChannelTypes, flags.channelType) {
+		return clierrors.InvalidValueError("--type", flags.channelType, clierrors.ValidChannelTypes)
+	}
+
+	if GetDryRun() {
+		details := []string{"Channels", "all"}
+		if flags.channelType != "" {
+			details = []string{"Channel Type", flags.channelType}
+		}
+		dryrun.PrintAction("send test notifications to", "channels", details...)
+		return nil
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	listCtx, cancel := context.WithTimeout(ctx, channelTestTimeout)
+	channels, err := fetchAllChannels(listCtx, apiClient)
+	cancel()
+	if err != nil {
+		return err
+	}
+	channels = filterChannelsByType(channels, parseChannelType(flags.channelType))
+	if len(channels) == 0 {
+		return output.PrintEmpty("No channels to test.")
+	}
+
+	fmt.Fprintf(os.Stderr, "Sending test notifications to %d channel(s)...\n", len(channels))
+	results := testChannels(ctx, channels, func(ctx context.Context, id uuid.UUID) (*client.ChannelTestResponse, error) {
+		return client.TestChannel(ctx, apiClient, id)
+	})
+	if err := output.Print(results); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, r := range results {
+		if r.Status != "ok" {
+			failed++
+		}
+	}
+	fmt.Fprintf(os.Stderr, "\n%d of %d channel(s) delivered, %d failed\n", len(results)-failed, len(results), failed)
+	if failed > 0 {
+		return clierrors.WithExitCode(clierrors.ExitError, nil)
+	}
+	return nil
+}
+
+// filterChannelsByType returns the channels of the given type, or all of them
+// when channelType is empty.
+func filterChannelsByType(channels []client.Channel, channelType client.ChannelType) []client.Channel {
+	if channelType == "" {
+		return channels
+	}
+	var filtered []client.Channel
+	for _, ch := range channels {
+		if ch.Type == channelType {
+			filtered = append(filtered, ch)
+		}
+	}
+	return filtered
+}
+
+// testChannels runs test against every channel using a bounded worker pool.
+// Each test gets its own channelTestTimeout, starting when a worker picks it
+// up. Results are sorted by channel name.
+func testChannels(ctx context.Context, channels []client.Channel, test func(context.Context, uuid.UUID) (*client.ChannelTestResponse, error)) []ChannelTestRunResult {
+	results := make([]ChannelTestRunResult, len(channels))
+	sem := make(chan struct{}, channelTestConcurrency)
+	var wg sync.WaitGroup
+
+	for i, ch := range channels {
+		wg.Add(1)
+		go func(i int, ch client.Channel) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result := ChannelTestRunResult{
+				Channel: ch.Name,
+				ID:      ch.ID.String(),
+				Type:    string(ch.Type),
+				Status:  "failed",
+				Latency: "-",
+			}
+			testCtx, cancel := context.WithTimeout(ctx, channelTestTimeout)
+			resp, err := test(testCtx, ch.ID)
+			cancel()
+			switch {
+			case err != nil:
+				result.Error = err.Error()
+			case resp == nil:
+				result.Error = "empty response"
+			default:
+				result.Latency = fmt.Sprintf("%dms", resp.ResponseTimeMs)
+				if resp.Success {
+					result.Status = "ok"
+				} else if resp.Error != nil && *resp.Error != "" {
+					result.Error = *resp.Error
+				} else {
+					result.Error = strings.TrimSpace(resp.Message)
+				}
+			}
+			results[i] = result
+		}(i, ch)
+	}
+	wg.Wait()
+
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Channel < results[j].Channel })
+	return results
+}
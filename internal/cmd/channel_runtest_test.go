@@ -2,8 +2,13 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"strings"
 	"testing"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
 )
 
 func TestNewChannelTestCmd(t *testing.T) {
@@ -159,3 +164,85 @@ func TestChannelTestResult_FieldsExist(t *testing.T) {
 		t.Errorf("Error = %v, want %q", result.Error, "test error")
 	}
 }
+
+func TestChannelTestCmd_AllWithID(t *testing.T) {
+	cmd := NewChannelTestCmd()
+	cmd.SetArgs([]string{"--all", "550e8400-e29b-41d4-a716-446655440000"})
+
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "cannot be combined") {
+		t.Errorf("Error = %v, want channel ID and --all conflict", err)
+	}
+}
+
+func TestChannelTestCmd_InvalidType(t *testing.T) {
+	cmd := NewChannelTestCmd()
+	cmd.SetArgs([]string{"--type", "carrier-pigeon"})
+
+	err := cmd.Execute()
+	if err == nil {
+		t.Fatal("Expected error for invalid --type, got nil")
+	}
+	if strings.Contains(err.Error(), "API client") {
+		t.Errorf("--type should be validated before creating the API client: %v", err)
+	}
+}
+
+func TestFilterChannelsByType(t *testing.T) {
+	channels := []client.Channel{
+		{Name: "ops", Type: client.ChannelTypeSlack},
+		{Name: "oncall", Type: client.ChannelTypeEmail},
+		{Name: "dev", Type: client.ChannelTypeSlack},
+	}
+
+	if got := filterChannelsByType(channels, ""); len(got) != 3 {
+		t.Errorf("empty type returned %d channels, want 3", len(got))
+	}
+	got := filterChannelsByType(channels, client.ChannelTypeSlack)
+	if len(got) != 2 || got[0].Name != "ops" || got[1].Name != "dev" {
+		t.Errorf("slack filter = %v, want ops and dev", got)
+	}
+	if got := filterChannelsByType(channels, client.ChannelTypeSMS); len(got) != 0 {
+		t.Errorf("sms filter returned %d channels, want 0", len(got))
+	}
+}
+
+func TestTestChannels(t *testing.T) {
+	ok := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	rejected := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	broken := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	channels := []client.Channel{
+		{ID: rejected, Name: "webhook", Type: client.ChannelTypeWebhook},
+		{ID: ok, Name: "slack", Type: client.ChannelTypeSlack},
+		{ID: broken, Name: "email", Type: client.ChannelTypeEmail},
+	}
+
+	webhookErr := "HTTP 404"
+	results := testChannels(context.Background(), channels, func(ctx context.Context, id uuid.UUID) (*client.ChannelTestResponse, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			return nil, errors.New("test called without its own timeout")
+		}
+		switch id {
+		case ok:
+			return &client.ChannelTestResponse{Success: true, ResponseTimeMs: 120}, nil
+		case rejected:
+			return &client.ChannelTestResponse{Success: false, Error: &webhookErr, ResponseTimeMs: 80}, nil
+		default:
+			return nil, errors.New("connection refused")
+		}
+	})
+
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3", len(results))
+	}
+	want := []ChannelTestRunResult{
+		{Channel: "email", ID: broken.String(), Type: "email", Status: "failed", Latency: "-", Error: "connection refused"},
+		{Channel: "slack", ID: ok.String(), Type: "slack", Status: "ok", Latency: "120ms"},
+		{Channel: "webhook", ID: rejected.String(), Type: "webhook", Status: "failed", Latency: "80ms", Error: "HTTP 404"},
+	}
+	for i := range want {
+		if results[i] != want[i] {
+			t.Errorf("results[%d] = %+v, want %+v", i, results[i], want[i])
+		}
+	}
+}
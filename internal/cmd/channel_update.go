@@ -77,8 +77,9 @@ func NewChannelUpdateCmd() *cobra.Command {
 	flags := &channelUpdateFlags{}
 
 	cmd := &cobra.Command{
-		Use:   "update <id>",
-		Short: "Update an existing notification channel",
+		Use:               "update <id>",
+		Short:             "Update an existing notification channel",
+		ValidArgsFunction: ChannelCompletion(),
 		Long: `Update an existing notification channel configuration.
 
 Only the specified flags will be updated; all other fields remain unchanged.
@@ -0,0 +1,167 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// completionCacheTTL is how long fetched completion candidates are reused.
+// Every TAB press runs a fresh process, so the cache lives on disk; keep the
+// TTL short so newly created resources show up almost immediately.
+const completionCacheTTL = 60 * time.Second
+
+// completionFetchTimeout is the maximum time to wait for the API when
+// fetching completion candidates. Keep this short to avoid slowing down tab
+// completion.
+const completionFetchTimeout = 5 * time.Second
+
+// completionCandidate is a single completion value with an optional
+// description shown by shells that support it (zsh, fish, PowerShell).
+type completionCandidate struct {
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+// completionFetcher fetches all completion candidates for one resource type.
+type completionFetcher func(ctx context.Context, c *client.Client) ([]completionCandidate, error)
+
+// completionCacheEntry is the on-disk representation of cached candidates.
+type completionCacheEntry struct {
+	FetchedAt  time.Time             `json:"fetched_at"`
+	Candidates []completionCandidate `json:"candidates"`
+}
+
+// completionCacheDir returns the directory used to cache completion
+// candidates. It is a variable so tests can redirect it to a temp directory.
+// An empty string disables the on-disk cache.
+var completionCacheDir = func() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "stackeye", "completion")
+}
+
+// fetchCompletionCandidates returns the completion candidates for a resource,
+// serving them from the on-disk cache when a fresh entry exists for the
+// current context. On any error (not authenticated, offline, API failure) it
+// returns nil so completion degrades gracefully to no suggestions.
+func fetchCompletionCandidates(cmd *cobra.Command, resource string, fetch completionFetcher) []completionCandidate {
+	contextKey, err := api.CurrentContextKey()
+	if err != nil {
+		return nil
+	}
+
+	cachePath := completionCachePath(contextKey, resource)
+	if candidates, ok := readCompletionCache(cachePath); ok {
+		return candidates
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return nil
+	}
+
+	// Handle nil context (can happen during shell completion)
+	parentCtx := cmd.Context()
+	if parentCtx == nil {
+		parentCtx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(parentCtx, completionFetchTimeout)
+	defer cancel()
+
+	candidates, err := fetch(ctx, apiClient)
+	if err != nil {
+		return nil
+	}
+
+	writeCompletionCache(cachePath, candidates)
+	return candidates
+}
+
+// completionCachePath returns the cache file for a resource within a context.
+// Returns an empty string when caching is disabled.
+func completionCachePath(contextKey, resource string) string {
+	dir := completionCacheDir()
+	if dir == "" {
+		return ""
+	}
+	// Resource keys may embed parent IDs (e.g. "incidents:42"); keep the file
+	// name portable.
+	name := strings.NewReplacer(":", "_", "/", "_", `\`, "_").Replace(resource)
+	return filepath.Join(dir, contextKey, name+".json")
+}
+
+// readCompletionCache returns cached candidates if the entry exists and is
+// younger than completionCacheTTL.
+func readCompletionCache(path string) ([]completionCandidate, bool) {
+	if path == "" {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	var entry completionCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+
+	if time.Since(entry.FetchedAt) > completionCacheTTL {
+		return nil, false
+	}
+
+	return entry.Candidates, true
+}
+
+// writeCompletionCache stores candidates on disk. Failures are ignored: the
+// cache is an optimization and must never break completion.
+func writeCompletionCache(path string, candidates []completionCandidate) {
+	if path == "" {
+		return
+	}
+
+	data, err := json.Marshal(completionCacheEntry{
+		FetchedAt:  time.Now(),
+		Candidates: candidates,
+	})
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return
+	}
+	_ = os.WriteFile(path, data, 0600)
+}
+
+// filterCompletionCandidates formats candidates whose value starts with the
+// user's input (case-insensitive) using cobra's "value\tdescription" format.
+func filterCompletionCandidates(candidates []completionCandidate, toComplete string) []string {
+	var completions []string
+	loweredComplete := strings.ToLower(toComplete)
+
+	for _, c := range candidates {
+		if toComplete != "" && !strings.HasPrefix(strings.ToLower(c.Value), loweredComplete) {
+			continue
+		}
+		if c.Description == "" {
+			completions = append(completions, c.Value)
+			continue
+		}
+		completions = append(completions, c.Value+"\t"+c.Description)
+	}
+
+	return completions
+}
@@ -0,0 +1,142 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupTestCompletionCache redirects the completion cache to a temp directory.
+func setupTestCompletionCache(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	prev := completionCacheDir
+	completionCacheDir = func() string { return dir }
+	t.Cleanup(func() {
+		completionCacheDir = prev
+	})
+	return dir
+}
+
+// seedCompletionCache writes candidates for a resource in the current context.
+func seedCompletionCache(t *testing.T, resource string, candidates []completionCandidate) {
+	t.Helper()
+
+	contextKey, err := api.CurrentContextKey()
+	require.NoError(t, err)
+	writeCompletionCache(completionCachePath(contextKey, resource), candidates)
+}
+
+func TestCompletionCache_RoundTrip(t *testing.T) {
+	dir := setupTestCompletionCache(t)
+	path := completionCachePath("ctx", "channels")
+	assert.Equal(t, filepath.Join(dir, "ctx", "channels.json"), path)
+
+	want := []completionCandidate{{Value: "a", Description: "first"}, {Value: "b"}}
+	writeCompletionCache(path, want)
+
+	got, ok := readCompletionCache(path)
+	require.True(t, ok)
+	assert.Equal(t, want, got)
+}
+
+func TestCompletionCache_Expired(t *testing.T) {
+	setupTestCompletionCache(t)
+	path := completionCachePath("ctx", "alerts")
+
+	data, err := json.Marshal(completionCacheEntry{
+		FetchedAt:  time.Now().Add(-2 * completionCacheTTL),
+		Candidates: []completionCandidate{{Value: "stale"}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, os.WriteFile(path, data, 0600))
+
+	_, ok := readCompletionCache(path)
+	assert.False(t, ok)
+}
+
+func TestCompletionCache_Disabled(t *testing.T) {
+	prev := completionCacheDir
+	completionCacheDir = func() string { return "" }
+	defer func() { completionCacheDir = prev }()
+
+	path := completionCachePath("ctx", "channels")
+	assert.Empty(t, path)
+
+	writeCompletionCache(path, []completionCandidate{{Value: "a"}})
+	_, ok := readCompletionCache(path)
+	assert.False(t, ok)
+}
+
+func TestCompletionCachePath_SanitizesResource(t *testing.T) {
+	dir := setupTestCompletionCache(t)
+	assert.Equal(t, filepath.Join(dir, "ctx", "incidents_42.json"), completionCachePath("ctx", "incidents:42"))
+}
+
+func TestFetchCompletionCandidates_ServesFromCache(t *testing.T) {
+	setupTestCompletionCache(t)
+	setupTestConfigWithURL(t, "http://127.0.0.1:0")
+	seedCompletionCache(t, "channels", []completionCandidate{{Value: "cached"}})
+
+	fetched := false
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+
+	got := fetchCompletionCandidates(cmd, "channels", func(ctx context.Context, c *client.Client) ([]completionCandidate, error) {
+		fetched = true
+		return nil, nil
+	})
+
+	assert.False(t, fetched, "fresh cache entry should avoid the API call")
+	assert.Equal(t, []completionCandidate{{Value: "cached"}}, got)
+}
+
+func TestFetchCompletionCandidates_FetchErrorReturnsNil(t *testing.T) {
+	setupTestCompletionCache(t)
+	setupTestConfigWithURL(t, "http://127.0.0.1:0")
+
+	cmd := &cobra.Command{}
+	got := fetchCompletionCandidates(cmd, "channels", func(ctx context.Context, c *client.Client) ([]completionCandidate, error) {
+		return nil, errors.New("offline")
+	})
+
+	assert.Nil(t, got)
+}
+
+func TestFetchCompletionCandidates_NoConfig(t *testing.T) {
+	setupTestCompletionCache(t)
+	api.SetConfigGetter(nil)
+
+	cmd := &cobra.Command{}
+	got := fetchCompletionCandidates(cmd, "channels", func(ctx context.Context, c *client.Client) ([]completionCandidate, error) {
+		t.Fatal("fetcher should not be called without configuration")
+		return nil, nil
+	})
+
+	assert.Nil(t, got)
+}
+
+func TestFilterCompletionCandidates(t *testing.T) {
+	candidates := []completionCandidate{
+		{Value: "Production", Description: "prod"},
+		{Value: "preview"},
+		{Value: "staging", Description: "stage"},
+	}
+
+	assert.Equal(t, []string{"Production\tprod", "preview", "staging\tstage"}, filterCompletionCandidates(candidates, ""))
+	assert.Equal(t, []string{"Production\tprod", "preview"}, filterCompletionCandidates(candidates, "pr"))
+	assert.Empty(t, filterCompletionCandidates(candidates, "x"))
+}
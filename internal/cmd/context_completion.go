@@ -2,8 +2,6 @@
 package cmd
 
 import (
-	"strings"
-
 	"github.com/StackEye-IO/stackeye-cli/internal/config"
 	"github.com/spf13/cobra"
 )
@@ -18,68 +16,47 @@ import (
 //	    Use:               "use <name>",
 //	    ValidArgsFunction: ContextCompletion(),
 //	}
-func ContextCompletion() func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+func ContextCompletion() completionFunc {
 	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		// Only complete the first positional argument (context name)
 		if len(args) >= 1 {
 			return nil, cobra.ShellCompDirectiveNoFileComp
 		}
 
-		// Try to load configuration
-		cfg, err := config.Load()
-		if err != nil {
-			// Config load error - return empty completions silently
-			return nil, cobra.ShellCompDirectiveNoFileComp
-		}
+		return filterCompletionCandidates(contextCandidates(), toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
 
-		if cfg == nil {
-			return nil, cobra.ShellCompDirectiveNoFileComp
-		}
+// contextCandidates lists the contexts in the local config file, described by
+// organization name. They bypass the on-disk completion cache: the config is
+// already local, and cached names would hide a newly added context.
+func contextCandidates() []completionCandidate {
+	// Config load error - return empty completions silently
+	cfg, err := config.Load()
+	if err != nil || cfg == nil {
+		return nil
+	}
 
-		// Get available context names
-		names := cfg.ContextNames()
-		if len(names) == 0 {
-			return nil, cobra.ShellCompDirectiveNoFileComp
+	names := cfg.ContextNames()
+	candidates := make([]completionCandidate, 0, len(names))
+	for _, name := range names {
+		ctx, err := cfg.GetContext(name)
+		if err != nil || ctx == nil {
+			// Include without description if context details unavailable
+			candidates = append(candidates, completionCandidate{Value: name})
+			continue
 		}
 
-		// Build completion list
-		var completions []string
-		loweredComplete := strings.ToLower(toComplete)
-
-		for _, name := range names {
-			// Filter by prefix (case-insensitive)
-			loweredName := strings.ToLower(name)
-
-			if toComplete == "" || strings.HasPrefix(loweredName, loweredComplete) {
-				// Get context details for description
-				ctx, err := cfg.GetContext(name)
-				if err != nil || ctx == nil {
-					// Include without description if context details unavailable
-					completions = append(completions, name)
-					continue
-				}
-
-				// Build description with organization name if available
-				var desc string
-				if ctx.OrganizationName != "" {
-					desc = ctx.OrganizationName
-				} else {
-					desc = "(no org)"
-				}
-
-				// Mark current context
-				if cfg.CurrentContext == name {
-					desc += " [current]"
-				}
-
-				// Add completion with description (format: "value\tdescription")
-				completion := name + "\t" + desc
-				completions = append(completions, completion)
-			}
+		desc := "(no org)"
+		if ctx.OrganizationName != "" {
+			desc = ctx.OrganizationName
 		}
-
-		return completions, cobra.ShellCompDirectiveNoFileComp
+		if cfg.CurrentContext == name {
+			desc += " [current]"
+		}
+		candidates = append(candidates, completionCandidate{Value: name, Description: desc})
 	}
+	return candidates
 }
 
 // ContextNameCompletion is an alias for ContextCompletion for clarity in command definitions.
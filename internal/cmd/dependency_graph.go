@@ -0,0 +1,262 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+)
+
+// dependencyGraph indexes a dependency tree by probe.
+type dependencyGraph struct {
+	nodes    map[uuid.UUID]*client.DependencyTreeNode
+	parents  map[uuid.UUID][]uuid.UUID
+	children map[uuid.UUID][]uuid.UUID
+}
+
+// newDependencyGraph builds lookup maps for a dependency tree.
+func newDependencyGraph(tree *client.DependencyTree) *dependencyGraph {
+	g := &dependencyGraph{
+		nodes:    make(map[uuid.UUID]*client.DependencyTreeNode, len(tree.Nodes)),
+		parents:  make(map[uuid.UUID][]uuid.UUID),
+		children: make(map[uuid.UUID][]uuid.UUID),
+	}
+	for i := range tree.Nodes {
+		g.nodes[tree.Nodes[i].ProbeID] = &tree.Nodes[i]
+	}
+	for _, edge := range tree.Edges {
+		g.parents[edge.ToProbeID] = append(g.parents[edge.ToProbeID], edge.FromProbeID)
+		g.children[edge.FromProbeID] = append(g.children[edge.FromProbeID], edge.ToProbeID)
+	}
+	return g
+}
+
+// name returns the display name of a probe in the graph.
+func (g *dependencyGraph) name(id uuid.UUID) string {
+	if node, ok := g.nodes[id]; ok && node.Name != "" {
+		return node.Name
+	}
+	return id.String()
+}
+
+// status returns the display status of a probe in the graph.
+func (g *dependencyGraph) status(id uuid.UUID) string {
+	node, ok := g.nodes[id]
+	if !ok {
+		return ""
+	}
+	if node.IsUnreachable {
+		return "unreachable"
+	}
+	return node.Status
+}
+
+// sortByName sorts probe IDs by display name, then ID.
+func (g *dependencyGraph) sortByName(ids []uuid.UUID) {
+	sort.Slice(ids, func(i, j int) bool {
+		ni, nj := g.name(ids[i]), g.name(ids[j])
+		if ni != nj {
+			return ni < nj
+		}
+		return ids[i].String() < ids[j].String()
+	})
+}
+
+// dependencyDescendant is a probe that depends, directly or transitively, on
+// another probe.
+type dependencyDescendant struct {
+	id     uuid.UUID
+	depth  int
+	parent uuid.UUID // the parent through which it was first reached
+}
+
+// descendants returns every probe that depends on id, directly or
+// transitively, ordered by depth and then name.
+func (g *dependencyGraph) descendants(id uuid.UUID) []dependencyDescendant {
+	seen := map[uuid.UUID]bool{id: true}
+	queue := []dependencyDescendant{{id: id}}
+	var result []dependencyDescendant
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, child := range g.children[current.id] {
+			if seen[child] {
+				continue
+			}
+			seen[child] = true
+			d := dependencyDescendant{id: child, depth: current.depth + 1, parent: current.id}
+			result = append(result, d)
+			queue = append(queue, d)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].depth != result[j].depth {
+			return result[i].depth < result[j].depth
+		}
+		return g.name(result[i].id) < g.name(result[j].id)
+	})
+	return result
+}
+
+// path returns the shortest chain of dependencies from one probe down to
+// another, starting with from and ending with to, or nil if to does not
+// depend on from.
+func (g *dependencyGraph) path(from, to uuid.UUID) []uuid.UUID {
+	prev := map[uuid.UUID]uuid.UUID{}
+	seen := map[uuid.UUID]bool{from: true}
+	queue := []uuid.UUID{from}
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if id == to {
+			path := []uuid.UUID{to}
+			for id != from {
+				id = prev[id]
+				path = append([]uuid.UUID{id}, path...)
+			}
+			return path
+		}
+		for _, child := range g.children[id] {
+			if !seen[child] {
+				seen[child] = true
+				prev[child] = id
+				queue = append(queue, child)
+			}
+		}
+	}
+	return nil
+}
+
+// ancestorChain returns the longest chain of parents above a probe, root
+// first and ending with the probe itself.
+func (g *dependencyGraph) ancestorChain(id uuid.UUID) []uuid.UUID {
+	chain := g.longestChain(id, g.parents, map[uuid.UUID][]uuid.UUID{}, map[uuid.UUID]bool{})
+	reversed := make([]uuid.UUID, len(chain))
+	for i, c := range chain {
+		reversed[len(chain)-1-i] = c
+	}
+	return reversed
+}
+
+// descendantChain returns the longest chain of dependents below a probe,
+// starting with the probe itself.
+func (g *dependencyGraph) descendantChain(id uuid.UUID) []uuid.UUID {
+	return g.longestChain(id, g.children, map[uuid.UUID][]uuid.UUID{}, map[uuid.UUID]bool{})
+}
+
+// longestChain returns the longest walk from id along next, skipping probes
+// already on the walk so that cycles terminate.
+func (g *dependencyGraph) longestChain(id uuid.UUID, next, memo map[uuid.UUID][]uuid.UUID, visiting map[uuid.UUID]bool) []uuid.UUID {
+	if chain, ok := memo[id]; ok {
+		return chain
+	}
+	visiting[id] = true
+	var best []uuid.UUID
+	for _, n := range next[id] {
+		if visiting[n] {
+			continue
+		}
+		if chain := g.longestChain(n, next, memo, visiting); len(chain) > len(best) {
+			best = chain
+		}
+	}
+	visiting[id] = false
+
+	chain := append([]uuid.UUID{id}, best...)
+	memo[id] = chain
+	return chain
+}
+
+// cycles returns each dependency cycle in the graph once, as the chain of
+// probes from its first probe by name back to the probe before it.
+// Self-loops are not included.
+func (g *dependencyGraph) cycles() [][]uuid.UUID {
+	ids := g.ids()
+	state := make(map[uuid.UUID]int) // 0 unvisited, 1 on stack, 2 done
+	seen := make(map[string]bool)
+	var stack []uuid.UUID
+	var result [][]uuid.UUID
+
+	var visit func(id uuid.UUID)
+	visit = func(id uuid.UUID) {
+		state[id] = 1
+		stack = append(stack, id)
+		for _, child := range g.children[id] {
+			switch {
+			case child == id:
+			case state[child] == 1:
+				start := slices.Index(stack, child)
+				cycle := g.rotateCycle(slices.Clone(stack[start:]))
+				key := fmt.Sprint(cycle)
+				if !seen[key] {
+					seen[key] = true
+					result = append(result, cycle)
+				}
+			case state[child] == 0:
+				visit(child)
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[id] = 2
+	}
+
+	for _, id := range ids {
+		if state[id] == 0 {
+			visit(id)
+		}
+	}
+	return result
+}
+
+// rotateCycle rotates a cycle so that it starts with its first probe by name.
+func (g *dependencyGraph) rotateCycle(cycle []uuid.UUID) []uuid.UUID {
+	first := 0
+	for i, id := range cycle {
+		if g.name(id) < g.name(cycle[first]) ||
+			(g.name(id) == g.name(cycle[first]) && id.String() < cycle[first].String()) {
+			first = i
+		}
+	}
+	return append(cycle[first:], cycle[:first]...)
+}
+
+// ids returns every probe in the graph, including probes that only appear in
+// edges, sorted by name.
+func (g *dependencyGraph) ids() []uuid.UUID {
+	seen := make(map[uuid.UUID]bool)
+	var ids []uuid.UUID
+	add := func(id uuid.UUID) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	for id := range g.nodes {
+		add(id)
+	}
+	for parent, children := range g.children {
+		add(parent)
+		for _, child := range children {
+			add(child)
+		}
+	}
+	g.sortByName(ids)
+	return ids
+}
+
+// formatChain formats a chain of probes as names joined by arrows.
+func (g *dependencyGraph) formatChain(chain []uuid.UUID) string {
+	names := make([]string, len(chain))
+	for i, id := range chain {
+		names[i] = g.name(id)
+	}
+	return strings.Join(names, " -> ")
+}
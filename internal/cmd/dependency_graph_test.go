@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+)
+
+// testDependencyGraph builds a dependency graph from nodes and parent -> child edges.
+func testDependencyGraph(nodes []client.DependencyTreeNode, edges ...[2]uuid.UUID) *dependencyGraph {
+	g := &dependencyGraph{
+		nodes:    make(map[uuid.UUID]*client.DependencyTreeNode),
+		parents:  make(map[uuid.UUID][]uuid.UUID),
+		children: make(map[uuid.UUID][]uuid.UUID),
+	}
+	for i := range nodes {
+		g.nodes[nodes[i].ProbeID] = &nodes[i]
+	}
+	for _, e := range edges {
+		g.children[e[0]] = append(g.children[e[0]], e[1])
+		g.parents[e[1]] = append(g.parents[e[1]], e[0])
+	}
+	return g
+}
+
+func TestDependencyGraph_NameAndStatus(t *testing.T) {
+	db := uuid.New()
+	api := uuid.New()
+	missing := uuid.New()
+	g := testDependencyGraph([]client.DependencyTreeNode{
+		{ProbeID: db, Name: "Database", Status: "down"},
+		{ProbeID: api, Name: "API", Status: "down", IsUnreachable: true},
+	}, [2]uuid.UUID{db, api})
+
+	if got := g.name(db); got != "Database" {
+		t.Errorf("name(db) = %q, want Database", got)
+	}
+	if got := g.name(missing); got != missing.String() {
+		t.Errorf("name(missing) = %q, want the ID", got)
+	}
+	if got := g.status(api); got != "unreachable" {
+		t.Errorf("status(api) = %q, want unreachable", got)
+	}
+	if got := g.status(missing); got != "" {
+		t.Errorf("status(missing) = %q, want empty", got)
+	}
+}
+
+func TestDependencyGraph_Descendants(t *testing.T) {
+	db := uuid.New()
+	api := uuid.New()
+	web := uuid.New()
+	worker := uuid.New()
+
+	// db -> api -> web, db -> worker, worker -> web (diamond)
+	g := testDependencyGraph([]client.DependencyTreeNode{
+		{ProbeID: db, Name: "Database"},
+		{ProbeID: api, Name: "API"},
+		{ProbeID: web, Name: "Web"},
+		{ProbeID: worker, Name: "Worker"},
+	},
+		[2]uuid.UUID{db, worker},
+		[2]uuid.UUID{db, api},
+		[2]uuid.UUID{api, web},
+		[2]uuid.UUID{worker, web},
+	)
+
+	got := g.descendants(db)
+	if len(got) != 3 {
+		t.Fatalf("descendants = %d, want 3 (web counted once)", len(got))
+	}
+	if got[0].id != api || got[1].id != worker || got[2].id != web {
+		t.Errorf("order = %s, %s, %s, want API, Worker, Web", g.name(got[0].id), g.name(got[1].id), g.name(got[2].id))
+	}
+	if got[2].depth != 2 {
+		t.Errorf("web depth = %d, want 2", got[2].depth)
+	}
+
+	if leaf := g.descendants(web); len(leaf) != 0 {
+		t.Errorf("descendants(web) = %v, want none", leaf)
+	}
+}
+
+func TestDependencyGraph_Path(t *testing.T) {
+	db := uuid.New()
+	api := uuid.New()
+	web := uuid.New()
+	docs := uuid.New()
+	g := testDependencyGraph([]client.DependencyTreeNode{
+		{ProbeID: db, Name: "Database"},
+		{ProbeID: api, Name: "API"},
+		{ProbeID: web, Name: "Web"},
+		{ProbeID: docs, Name: "Docs"},
+	},
+		[2]uuid.UUID{db, api},
+		[2]uuid.UUID{api, web},
+	)
+
+	if got := g.formatChain(g.path(db, web)); got != "Database -> API -> Web" {
+		t.Errorf("path(db, web) = %q, want Database -> API -> Web", got)
+	}
+	if got := g.path(web, db); got != nil {
+		t.Errorf("path(web, db) = %v, want nil", got)
+	}
+	if got := g.path(db, docs); got != nil {
+		t.Errorf("path(db, docs) = %v, want nil", got)
+	}
+}
+
+func TestDependencyGraph_Chains(t *testing.T) {
+	net := uuid.New()
+	db := uuid.New()
+	api := uuid.New()
+	cache := uuid.New()
+	web := uuid.New()
+	// Network -> Database -> API -> Web, plus a shortcut Cache -> Web
+	g := testDependencyGraph([]client.DependencyTreeNode{
+		{ProbeID: net, Name: "Network"},
+		{ProbeID: db, Name: "Database"},
+		{ProbeID: api, Name: "API"},
+		{ProbeID: cache, Name: "Cache"},
+		{ProbeID: web, Name: "Web"},
+	},
+		[2]uuid.UUID{net, db},
+		[2]uuid.UUID{db, api},
+		[2]uuid.UUID{cache, web},
+		[2]uuid.UUID{api, web},
+	)
+
+	if got := g.formatChain(g.ancestorChain(web)); got != "Network -> Database -> API -> Web" {
+		t.Errorf("ancestorChain(web) = %q", got)
+	}
+	if got := g.formatChain(g.descendantChain(net)); got != "Network -> Database -> API -> Web" {
+		t.Errorf("descendantChain(net) = %q", got)
+	}
+	if got := g.formatChain(g.descendantChain(web)); got != "Web" {
+		t.Errorf("descendantChain(web) = %q, want Web", got)
+	}
+}
+
+func TestDependencyGraph_Cycles(t *testing.T) {
+	a := uuid.New()
+	b := uuid.New()
+	c := uuid.New()
+	self := uuid.New()
+	g := testDependencyGraph([]client.DependencyTreeNode{
+		{ProbeID: a, Name: "A"},
+		{ProbeID: b, Name: "B"},
+		{ProbeID: c, Name: "C"},
+		{ProbeID: self, Name: "Self"},
+	},
+		[2]uuid.UUID{b, c},
+		[2]uuid.UUID{c, a},
+		[2]uuid.UUID{a, b},
+		[2]uuid.UUID{self, self},
+	)
+
+	cycles := g.cycles()
+	if len(cycles) != 1 {
+		t.Fatalf("got %d cycles, want 1 (self-loops excluded): %v", len(cycles), cycles)
+	}
+	if got := g.formatChain(cycles[0]); got != "A -> B -> C" {
+		t.Errorf("cycle = %q, want A -> B -> C", got)
+	}
+	// ancestorChain must terminate on cyclic graphs
+	if chain := g.ancestorChain(a); len(chain) != 3 {
+		t.Errorf("ancestorChain(a) = %q, want 3 probes", g.formatChain(chain))
+	}
+}
@@ -22,8 +22,9 @@ const deviceRegionTimeout = 30 * time.Second
 // Task stackeye-5859.
 func NewDeviceRegionAssignCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "assign <device-id> <region-id>",
-		Short: "Assign a device to a region",
+		Use:               "assign <device-id> <region-id>",
+		Short:             "Assign a device to a region",
+		ValidArgsFunction: PositionalCompletion(DeviceCompletion(), RegionCompletion()),
 		Long: `Assign a device to a monitoring region.
 
 The region must be a public region or one owned by your organization;
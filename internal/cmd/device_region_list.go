@@ -16,9 +16,10 @@ import (
 // Task stackeye-5859.
 func NewDeviceRegionListCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "list <device-id>",
-		Short:   "List a device's region assignments",
-		Aliases: []string{"ls"},
+		Use:               "list <device-id>",
+		Short:             "List a device's region assignments",
+		ValidArgsFunction: DeviceCompletion(),
+		Aliases:           []string{"ls"},
 		Long: `List every region a device is explicitly assigned to, ordered by
 region ID.
 
@@ -17,8 +17,9 @@ import (
 // Task stackeye-5859.
 func NewDeviceRegionUnassignCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "unassign <device-id> <region-id>",
-		Short: "Remove a device's region assignment",
+		Use:               "unassign <device-id> <region-id>",
+		Short:             "Remove a device's region assignment",
+		ValidArgsFunction: PositionalCompletion(DeviceCompletion(), RegionCompletion()),
 		Long: `Remove a device's assignment to a monitoring region.
 
 Idempotent: no error if the device isn't assigned to the region.
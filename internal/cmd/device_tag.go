@@ -23,8 +23,9 @@ const deviceTagTimeout = 30 * time.Second
 // Task stackeye-5859.
 func NewDeviceTagCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "tag <device-id> <labels...>",
-		Short: "Attach tags to a device",
+		Use:               "tag <device-id> <labels...>",
+		Short:             "Attach tags to a device",
+		ValidArgsFunction: LeadingArgCompletion(DeviceCompletion(), LabelCompletion()),
 		Long: `Attach one or more tags to a device.
 
 Attaching a tag replaces tag_value if the key is already present on the
@@ -16,9 +16,10 @@ import (
 // Task stackeye-5859.
 func NewDeviceTagListCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "list <device-id>",
-		Short:   "List a device's tags",
-		Aliases: []string{"ls"},
+		Use:               "list <device-id>",
+		Short:             "List a device's tags",
+		ValidArgsFunction: DeviceCompletion(),
+		Aliases:           []string{"ls"},
 		Long: `List every tag attached to a device, ordered by tag key.
 
 The device can be specified by UUID or by exact name match.
@@ -18,8 +18,9 @@ import (
 // Task stackeye-5859.
 func NewDeviceUntagCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "untag <device-id> <keys...>",
-		Short: "Remove tags from a device",
+		Use:               "untag <device-id> <keys...>",
+		Short:             "Remove tags from a device",
+		ValidArgsFunction: LeadingArgCompletion(DeviceCompletion(), LabelKeyCompletion()),
 		Long: `Remove one or more tags from a device by key name.
 
 Tag keys that don't exist on the device are silently ignored (no error).
@@ -0,0 +1,50 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+)
+
+// execHookTimeout is the maximum time an --exec hook may run before it is
+// killed.
+const execHookTimeout = 60 * time.Second
+
+// runExecHook runs a user-supplied --exec command through the platform shell
+// with payload marshaled as JSON on stdin and env appended to the CLI's own
+// environment. Hook output goes to stderr so it never mixes with the
+// command's structured stdout.
+func runExecHook(ctx context.Context, command string, payload any, env []string) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode hook input: %w", err)
+	}
+
+	hookCtx, cancel := context.WithTimeout(ctx, execHookTimeout)
+	defer cancel()
+
+	c := shellCommand(hookCtx, command)
+	c.Stdin = bytes.NewReader(append(data, '\n'))
+	c.Stdout = os.Stderr
+	c.Stderr = os.Stderr
+	c.Env = append(os.Environ(), env...)
+
+	if err := c.Run(); err != nil {
+		return fmt.Errorf("exec hook %q failed: %w", command, err)
+	}
+	return nil
+}
+
+// shellCommand builds a command that runs command through the platform shell.
+func shellCommand(ctx context.Context, command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.CommandContext(ctx, "cmd", "/C", command)
+	}
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
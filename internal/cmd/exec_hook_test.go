@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestRunExecHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hook test uses a POSIX shell")
+	}
+
+	out := filepath.Join(t.TempDir(), "hook.out")
+	command := `cat > "` + out + `"; echo "$HOOK_VALUE" >> "` + out + `"`
+
+	err := runExecHook(context.Background(), command, map[string]string{"id": "abc"}, []string{"HOOK_VALUE=hello"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("failed to read hook output: %v", err)
+	}
+	got := string(data)
+	if !strings.Contains(got, `{"id":"abc"}`) {
+		t.Errorf("expected JSON payload on stdin, got %q", got)
+	}
+	if !strings.Contains(got, "hello") {
+		t.Errorf("expected environment variable to be set, got %q", got)
+	}
+}
+
+func TestRunExecHook_Failure(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hook test uses a POSIX shell")
+	}
+
+	err := runExecHook(context.Background(), "exit 3", nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "exit 3") {
+		t.Errorf("expected hook failure error, got %v", err)
+	}
+}
@@ -0,0 +1,767 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// handoffTimeout is the maximum time to wait for all handoff API calls.
+const handoffTimeout = 2 * time.Minute
+
+// handoffPostTimeout is the maximum time to wait for the channel to accept the summary.
+const handoffPostTimeout = 30 * time.Second
+
+// handoffDiscordLimit is the maximum length of a Discord webhook message.
+const handoffDiscordLimit = 2000
+
+// handoffFlags holds the flag values for the handoff command.
+type handoffFlags struct {
+	since  string
+	ahead  time.Duration
+	title  string
+	postTo string
+}
+
+// Handoff is an on-call shift summary.
+// This struct is exported to allow JSON/YAML serialization with proper field tags.
+type Handoff struct {
+	Title               string            `json:"title" yaml:"title"`
+	From                time.Time         `json:"from" yaml:"from"`
+	To                  time.Time         `json:"to" yaml:"to"`
+	Summary             HandoffSummary    `json:"summary" yaml:"summary"`
+	OpenAlerts          []HandoffAlert    `json:"open_alerts" yaml:"open_alerts"`
+	TriggeredAlerts     []HandoffAlert    `json:"triggered_alerts" yaml:"triggered_alerts"`
+	Incidents           []HandoffIncident `json:"incidents" yaml:"incidents"`
+	DownProbes          []HandoffProbe    `json:"down_probes" yaml:"down_probes"`
+	PausedProbes        []HandoffProbe    `json:"paused_probes" yaml:"paused_probes"`
+	ActiveMutes         []HandoffMute     `json:"active_mutes" yaml:"active_mutes"`
+	UpcomingMaintenance []HandoffMute     `json:"upcoming_maintenance" yaml:"upcoming_maintenance"`
+}
+
+// HandoffSummary holds the headline counts of a handoff.
+type HandoffSummary struct {
+	Triggered           int `json:"triggered" yaml:"triggered"`
+	Critical            int `json:"critical" yaml:"critical"`
+	Acknowledged        int `json:"acknowledged" yaml:"acknowledged"`
+	Resolved            int `json:"resolved" yaml:"resolved"`
+	Open                int `json:"open" yaml:"open"`
+	Incidents           int `json:"incidents" yaml:"incidents"`
+	ProbesDown          int `json:"probes_down" yaml:"probes_down"`
+	ProbesPaused        int `json:"probes_paused" yaml:"probes_paused"`
+	ActiveMutes         int `json:"active_mutes" yaml:"active_mutes"`
+	UpcomingMaintenance int `json:"upcoming_maintenance" yaml:"upcoming_maintenance"`
+}
+
+// HandoffAlert is an alert in a handoff.
+type HandoffAlert struct {
+	ID             uuid.UUID  `json:"id" yaml:"id"`
+	ProbeID        uuid.UUID  `json:"probe_id" yaml:"probe_id"`
+	Probe          string     `json:"probe" yaml:"probe"`
+	Severity       string     `json:"severity" yaml:"severity"`
+	Status         string     `json:"status" yaml:"status"`
+	AlertType      string     `json:"alert_type" yaml:"alert_type"`
+	Message        string     `json:"message,omitempty" yaml:"message,omitempty"`
+	TriggeredAt    time.Time  `json:"triggered_at" yaml:"triggered_at"`
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty" yaml:"acknowledged_at,omitempty"`
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty" yaml:"resolved_at,omitempty"`
+}
+
+// HandoffIncident is a status page incident opened or updated during the shift.
+type HandoffIncident struct {
+	StatusPageID uint       `json:"status_page_id" yaml:"status_page_id"`
+	StatusPage   string     `json:"status_page" yaml:"status_page"`
+	ID           uint       `json:"id" yaml:"id"`
+	Title        string     `json:"title" yaml:"title"`
+	Status       string     `json:"status" yaml:"status"`
+	Impact       string     `json:"impact" yaml:"impact"`
+	Opened       bool       `json:"opened" yaml:"opened"`
+	CreatedAt    time.Time  `json:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at" yaml:"updated_at"`
+	ResolvedAt   *time.Time `json:"resolved_at,omitempty" yaml:"resolved_at,omitempty"`
+}
+
+// HandoffProbe is a probe that is down or paused at handoff time.
+type HandoffProbe struct {
+	ID            uuid.UUID  `json:"id" yaml:"id"`
+	Name          string     `json:"name" yaml:"name"`
+	URL           string     `json:"url" yaml:"url"`
+	Status        string     `json:"status" yaml:"status"`
+	LastCheckedAt *time.Time `json:"last_checked_at,omitempty" yaml:"last_checked_at,omitempty"`
+}
+
+// HandoffMute is an active mute or an upcoming maintenance window.
+type HandoffMute struct {
+	ID              uuid.UUID  `json:"id" yaml:"id"`
+	Name            string     `json:"name,omitempty" yaml:"name,omitempty"`
+	Scope           string     `json:"scope" yaml:"scope"`
+	Target          string     `json:"target" yaml:"target"`
+	Reason          string     `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Maintenance     bool       `json:"maintenance" yaml:"maintenance"`
+	StartsAt        time.Time  `json:"starts_at" yaml:"starts_at"`
+	ExpiresAt       *time.Time `json:"expires_at,omitempty" yaml:"expires_at,omitempty"`
+	DurationMinutes int        `json:"duration_minutes" yaml:"duration_minutes"`
+}
+
+// handoffStatusPage is a status page with its recent incidents.
+type handoffStatusPage struct {
+	page      client.StatusPage
+	incidents []client.Incident
+}
+
+// handoffData is everything fetched from the API for a handoff.
+type handoffData struct {
+	triggered   []client.Alert
+	open        []client.Alert
+	probes      []client.Probe
+	statusPages []handoffStatusPage
+	mutes       []client.AlertMute
+}
+
+// NewHandoffCmd creates and returns the handoff command.
+func NewHandoffCmd() *cobra.Command {
+	flags := &handoffFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "handoff",
+		Short: "Summarize the on-call shift as a markdown document",
+		Long: `Summarize the on-call shift as a markdown document for the next person on call.
+
+The summary covers:
+  - Alerts triggered during the shift, how many were acknowledged and resolved
+  - Alerts still open, including ones triggered before the shift
+  - Status page incidents opened or updated during the shift
+  - Probes currently down or paused
+  - Active mutes and maintenance windows starting within --ahead
+
+The markdown is written to stdout. Use -o json or -o yaml for the same data
+in structured form.
+
+Posting:
+  --post-to <channel-id> also posts the markdown to a slack, discord, teams
+  or webhook notification channel. The message is sent from this machine to
+  the channel's configured URL. Webhook channels receive a JSON body with the
+  markdown and the structured summary. Discord messages are cut at 2000
+  characters.
+
+Time formats accepted for --since:
+  Relative:  12h, 1d (hours or days ago from now)
+  Date:      2024-01-15 (midnight UTC)
+  Absolute:  RFC3339 format (e.g., 2024-01-15T10:30:00Z)
+
+Examples:
+  # Summarize the last 12 hours
+  stackeye handoff --since 12h
+
+  # Save the summary of a weekend shift
+  stackeye handoff --since 2026-10-16T18:00:00Z > handoff.md
+
+  # Post the summary to the team's Slack channel
+  stackeye handoff --since 12h --post-to 550e8400-e29b-41d4-a716-446655440000
+
+  # Include maintenance windows for the next three days
+  stackeye handoff --since 24h --ahead 72h`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runHandoff(cmd.Context(), flags)
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.since, "since", "12h", "start of the shift (e.g., 12h, 2024-01-15, or RFC3339)")
+	cmd.Flags().DurationVar(&flags.ahead, "ahead", 24*time.Hour, "list maintenance windows starting within this duration")
+	cmd.Flags().StringVar(&flags.title, "title", "On-call handoff", "document title")
+	cmd.Flags().StringVar(&flags.postTo, "post-to", "", "notification channel ID to post the summary to")
+	_ = cmd.RegisterFlagCompletionFunc("post-to", ChannelCompletion())
+
+	return cmd
+}
+
+// runHandoff executes the handoff command logic.
+func runHandoff(ctx context.Context, flags *handoffFlags) error {
+	now := time.Now().UTC()
+
+	sinceTime, err := parseTimeFlag(flags.since)
+	if err != nil {
+		return fmt.Errorf("invalid --since flag: %w", err)
+	}
+	if sinceTime == nil {
+		return fmt.Errorf("--since is required")
+	}
+	if !sinceTime.Before(now) {
+		return fmt.Errorf("--since must be in the past, got %s", sinceTime.Format(time.RFC3339))
+	}
+	if flags.ahead < 0 {
+		return fmt.Errorf("--ahead must not be negative, got %s", flags.ahead)
+	}
+
+	var channelID uuid.UUID
+	if flags.postTo != "" {
+		channelID, err = uuid.Parse(flags.postTo)
+		if err != nil {
+			return fmt.Errorf("invalid --post-to %q: must be a valid channel UUID", flags.postTo)
+		}
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, handoffTimeout)
+	defer cancel()
+
+	// Check the channel first so an unsupported channel fails before the
+	// summary is compiled.
+	var channel *client.Channel
+	if flags.postTo != "" {
+		channel, err = client.GetChannel(reqCtx, apiClient, channelID)
+		if err != nil {
+			return fmt.Errorf("failed to get channel: %w", err)
+		}
+		if channel == nil {
+			return fmt.Errorf("channel %s not found", channelID)
+		}
+		if !handoffChannelSupported(channel.Type) {
+			return fmt.Errorf("cannot post to %s channel %q: --post-to supports slack, discord, teams and webhook channels", channel.Type, channel.Name)
+		}
+	}
+
+	data, err := fetchHandoffData(reqCtx, apiClient, *sinceTime, now)
+	if err != nil {
+		return err
+	}
+
+	handoff := buildHandoff(data, flags.title, sinceTime.UTC(), now, flags.ahead)
+
+	var doc bytes.Buffer
+	if err := writeHandoffMarkdown(&doc, handoff); err != nil {
+		return err
+	}
+
+	outFormat := output.NewPrinter(GetConfig()).Format()
+	if outFormat == sdkoutput.FormatJSON || outFormat == sdkoutput.FormatYAML {
+		err = output.Print(handoff)
+	} else {
+		_, err = os.Stdout.Write(doc.Bytes())
+	}
+	if err != nil {
+		return err
+	}
+
+	if channel == nil {
+		return nil
+	}
+	if err := postHandoff(ctx, channel, doc.String(), handoff); err != nil {
+		return fmt.Errorf("failed to post handoff to channel %q: %w", channel.Name, err)
+	}
+	fmt.Fprintf(os.Stderr, "Posted handoff to channel %q\n", channel.Name)
+	return nil
+}
+
+// fetchHandoffData fetches the alerts, probes, incidents and mutes for a handoff.
+func fetchHandoffData(ctx context.Context, apiClient *client.Client, from, now time.Time) (*handoffData, error) {
+	data := &handoffData{}
+	var err error
+
+	data.triggered, err = fetchAlertsInPeriod(ctx, apiClient, from, now)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, status := range []client.AlertStatus{client.AlertStatusActive, client.AlertStatusAcknowledged} {
+		alerts, err := fetchAlertsByStatus(ctx, apiClient, status)
+		if err != nil {
+			return nil, err
+		}
+		data.open = append(data.open, alerts...)
+	}
+
+	data.probes, err = fetchAllProbesForExport(ctx, apiClient, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data.statusPages, err = fetchHandoffStatusPages(ctx, apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	mutes, err := client.ListMutes(ctx, apiClient, &client.ListMutesOptions{Limit: 100})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list mutes: %w", err)
+	}
+	if mutes.Total > int64(len(mutes.Data)) {
+		fmt.Fprintf(os.Stderr, "Warning: showing %d of %d mutes\n", len(mutes.Data), mutes.Total)
+	}
+	data.mutes = mutes.Data
+
+	return data, nil
+}
+
+// fetchAlertsByStatus lists every alert with the given status.
+func fetchAlertsByStatus(ctx context.Context, apiClient *client.Client, status client.AlertStatus) ([]client.Alert, error) {
+	var alerts []client.Alert
+	for offset := 0; ; offset += alertReportPageSize {
+		result, err := client.ListAlerts(ctx, apiClient, &client.ListAlertsOptions{
+			Limit:  alertReportPageSize,
+			Offset: offset,
+			Status: status,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s alerts: %w", status, err)
+		}
+		alerts = append(alerts, result.Alerts...)
+		if len(result.Alerts) < alertReportPageSize {
+			return alerts, nil
+		}
+	}
+}
+
+// fetchHandoffStatusPages lists every status page with its most recent incidents.
+func fetchHandoffStatusPages(ctx context.Context, apiClient *client.Client) ([]handoffStatusPage, error) {
+	pages, err := fetchAllStatusPages(ctx, apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]handoffStatusPage, 0, len(pages))
+	for _, page := range pages {
+		incidents, err := client.ListIncidents(ctx, apiClient, page.ID, &client.ListIncidentsOptions{Limit: 100})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list incidents for status page %q: %w", page.Name, err)
+		}
+		result = append(result, handoffStatusPage{page: page, incidents: incidents.Incidents})
+	}
+	return result, nil
+}
+
+// inWindow reports whether t is set and falls within [from, to].
+func inWindow(t *time.Time, from, to time.Time) bool {
+	return t != nil && !t.Before(from) && !t.After(to)
+}
+
+// buildHandoff compiles the handoff from the fetched data.
+func buildHandoff(data *handoffData, title string, from, now time.Time, ahead time.Duration) *Handoff {
+	h := &Handoff{
+		Title:               title,
+		From:                from,
+		To:                  now,
+		OpenAlerts:          []HandoffAlert{},
+		TriggeredAlerts:     []HandoffAlert{},
+		Incidents:           []HandoffIncident{},
+		DownProbes:          []HandoffProbe{},
+		PausedProbes:        []HandoffProbe{},
+		ActiveMutes:         []HandoffMute{},
+		UpcomingMaintenance: []HandoffMute{},
+	}
+
+	// Alerts acknowledged during the shift may have been triggered before it,
+	// so count acknowledgements across both lists.
+	acked := make(map[uuid.UUID]bool)
+	for _, a := range data.triggered {
+		h.TriggeredAlerts = append(h.TriggeredAlerts, newHandoffAlert(a))
+		if a.Severity == client.AlertSeverityCritical {
+			h.Summary.Critical++
+		}
+		if inWindow(a.ResolvedAt, from, now) {
+			h.Summary.Resolved++
+		}
+		if inWindow(a.AcknowledgedAt, from, now) {
+			acked[a.ID] = true
+		}
+	}
+	for _, a := range data.open {
+		h.OpenAlerts = append(h.OpenAlerts, newHandoffAlert(a))
+		if inWindow(a.AcknowledgedAt, from, now) {
+			acked[a.ID] = true
+		}
+	}
+	sort.Slice(h.TriggeredAlerts, func(i, j int) bool {
+		return h.TriggeredAlerts[i].TriggeredAt.After(h.TriggeredAlerts[j].TriggeredAt)
+	})
+	sort.Slice(h.OpenAlerts, func(i, j int) bool {
+		return h.OpenAlerts[i].TriggeredAt.Before(h.OpenAlerts[j].TriggeredAt)
+	})
+
+	probeNames := make(map[uuid.UUID]string, len(data.probes))
+	for _, p := range data.probes {
+		probeNames[p.ID] = p.Name
+		hp := HandoffProbe{ID: p.ID, Name: p.Name, URL: p.URL, Status: p.Status, LastCheckedAt: p.LastCheckedAt}
+		switch strings.ToLower(p.Status) {
+		case "down":
+			h.DownProbes = append(h.DownProbes, hp)
+		case "paused":
+			h.PausedProbes = append(h.PausedProbes, hp)
+		}
+	}
+
+	for _, sp := range data.statusPages {
+		for _, inc := range sp.incidents {
+			if inc.UpdatedAt.Before(from) && inc.CreatedAt.Before(from) {
+				continue
+			}
+			h.Incidents = append(h.Incidents, HandoffIncident{
+				StatusPageID: sp.page.ID,
+				StatusPage:   sp.page.Name,
+				ID:           inc.ID,
+				Title:        inc.Title,
+				Status:       inc.Status,
+				Impact:       inc.Impact,
+				Opened:       !inc.CreatedAt.Before(from),
+				CreatedAt:    inc.CreatedAt,
+				UpdatedAt:    inc.UpdatedAt,
+				ResolvedAt:   inc.ResolvedAt,
+			})
+		}
+	}
+	sort.Slice(h.Incidents, func(i, j int) bool {
+		return h.Incidents[i].UpdatedAt.After(h.Incidents[j].UpdatedAt)
+	})
+
+	horizon := now.Add(ahead)
+	for _, m := range data.mutes {
+		hm := newHandoffMute(m, probeNames)
+		switch {
+		case m.StartsAt.After(now):
+			if m.IsMaintenanceWindow && !m.StartsAt.After(horizon) {
+				h.UpcomingMaintenance = append(h.UpcomingMaintenance, hm)
+			}
+		case m.ExpiresAt == nil || m.ExpiresAt.After(now):
+			h.ActiveMutes = append(h.ActiveMutes, hm)
+		}
+	}
+	sort.Slice(h.UpcomingMaintenance, func(i, j int) bool {
+		return h.UpcomingMaintenance[i].StartsAt.Before(h.UpcomingMaintenance[j].StartsAt)
+	})
+
+	h.Summary.Triggered = len(h.TriggeredAlerts)
+	h.Summary.Acknowledged = len(acked)
+	h.Summary.Open = len(h.OpenAlerts)
+	h.Summary.Incidents = len(h.Incidents)
+	h.Summary.ProbesDown = len(h.DownProbes)
+	h.Summary.ProbesPaused = len(h.PausedProbes)
+	h.Summary.ActiveMutes = len(h.ActiveMutes)
+	h.Summary.UpcomingMaintenance = len(h.UpcomingMaintenance)
+
+	return h
+}
+
+// newHandoffAlert converts an SDK alert to a handoff alert.
+func newHandoffAlert(a client.Alert) HandoffAlert {
+	ha := HandoffAlert{
+		ID:             a.ID,
+		ProbeID:        a.ProbeID,
+		Probe:          alertReportProbeName(a),
+		Severity:       string(a.Severity),
+		Status:         string(a.Status),
+		AlertType:      string(a.AlertType),
+		TriggeredAt:    a.TriggeredAt,
+		AcknowledgedAt: a.AcknowledgedAt,
+		ResolvedAt:     a.ResolvedAt,
+	}
+	if a.Message != nil {
+		ha.Message = *a.Message
+	}
+	return ha
+}
+
+// newHandoffMute converts an SDK mute to a handoff mute, naming probe targets.
+func newHandoffMute(m client.AlertMute, probeNames map[uuid.UUID]string) HandoffMute {
+	hm := HandoffMute{
+		ID:              m.ID,
+		Scope:           string(m.ScopeType),
+		Target:          "-",
+		Maintenance:     m.IsMaintenanceWindow,
+		StartsAt:        m.StartsAt,
+		ExpiresAt:       m.ExpiresAt,
+		DurationMinutes: m.DurationMinutes,
+	}
+	if m.MaintenanceName != nil {
+		hm.Name = *m.MaintenanceName
+	}
+	if m.Reason != nil {
+		hm.Reason = *m.Reason
+	}
+
+	switch m.ScopeType {
+	case client.MuteScopeOrganization:
+		hm.Target = "all probes"
+	case client.MuteScopeProbe:
+		if m.ProbeID != nil {
+			hm.Target = m.ProbeID.String()
+			if name, ok := probeNames[*m.ProbeID]; ok {
+				hm.Target = name
+			}
+		}
+	case client.MuteScopeChannel:
+		if m.ChannelID != nil {
+			hm.Target = m.ChannelID.String()
+		}
+	case client.MuteScopeAlertType:
+		if m.AlertType != nil {
+			hm.Target = string(*m.AlertType)
+		}
+	}
+	return hm
+}
+
+// formatHandoffTime formats a timestamp for the handoff document.
+func formatHandoffTime(t time.Time) string {
+	return t.UTC().Format("Jan 2 15:04 MST")
+}
+
+// writeHandoffMarkdown writes the handoff as a markdown document.
+func writeHandoffMarkdown(w io.Writer, h *Handoff) error {
+	var b strings.Builder
+	s := h.Summary
+
+	fmt.Fprintf(&b, "# %s\n\n", h.Title)
+	fmt.Fprintf(&b, "**Shift:** %s to %s\n\n", formatHandoffTime(h.From), formatHandoffTime(h.To))
+
+	b.WriteString("## Summary\n\n")
+	fmt.Fprintf(&b, "- Alerts triggered: %d (%d critical), %d acknowledged, %d resolved\n", s.Triggered, s.Critical, s.Acknowledged, s.Resolved)
+	fmt.Fprintf(&b, "- Alerts still open: %d\n", s.Open)
+	fmt.Fprintf(&b, "- Status page incidents opened or updated: %d\n", s.Incidents)
+	fmt.Fprintf(&b, "- Probes down: %d, paused: %d\n", s.ProbesDown, s.ProbesPaused)
+	fmt.Fprintf(&b, "- Active mutes: %d, upcoming maintenance windows: %d\n\n", s.ActiveMutes, s.UpcomingMaintenance)
+
+	b.WriteString("## Open alerts\n\n")
+	if len(h.OpenAlerts) == 0 {
+		b.WriteString("None.\n\n")
+	} else {
+		b.WriteString("| Severity | Probe | Status | Triggered | Age | Message |\n")
+		b.WriteString("|---|---|---|---|---:|---|\n")
+		for _, a := range h.OpenAlerts {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %s |\n",
+				strings.ToUpper(a.Severity), markdownCell(a.Probe), a.Status, formatHandoffTime(a.TriggeredAt),
+				formatTriageAge(h.To.Sub(a.TriggeredAt)), markdownCell(a.Message))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Alerts triggered during the shift\n\n")
+	if len(h.TriggeredAlerts) == 0 {
+		b.WriteString("None.\n\n")
+	} else {
+		b.WriteString("| Severity | Probe | Type | Triggered | Status |\n")
+		b.WriteString("|---|---|---|---|---|\n")
+		for _, a := range h.TriggeredAlerts {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n",
+				strings.ToUpper(a.Severity), markdownCell(a.Probe), a.AlertType, formatHandoffTime(a.TriggeredAt), a.Status)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Status page incidents\n\n")
+	if len(h.Incidents) == 0 {
+		b.WriteString("None.\n\n")
+	} else {
+		b.WriteString("| Status page | Incident | Status | Impact | Opened | Updated |\n")
+		b.WriteString("|---|---|---|---|---|---|\n")
+		for _, inc := range h.Incidents {
+			opened := formatHandoffTime(inc.CreatedAt)
+			if !inc.Opened {
+				opened += " (before shift)"
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %s |\n",
+				markdownCell(inc.StatusPage), markdownCell(inc.Title), inc.Status, inc.Impact, opened, formatHandoffTime(inc.UpdatedAt))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Probes down\n\n")
+	if len(h.DownProbes) == 0 {
+		b.WriteString("None.\n\n")
+	} else {
+		b.WriteString("| Probe | URL | Last check |\n")
+		b.WriteString("|---|---|---|\n")
+		for _, p := range h.DownProbes {
+			lastCheck := "never"
+			if p.LastCheckedAt != nil {
+				lastCheck = formatHandoffTime(*p.LastCheckedAt)
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s |\n", markdownCell(p.Name), markdownCell(p.URL), lastCheck)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Paused probes\n\n")
+	if len(h.PausedProbes) == 0 {
+		b.WriteString("None.\n\n")
+	} else {
+		for _, p := range h.PausedProbes {
+			fmt.Fprintf(&b, "- %s (%s)\n", p.Name, p.URL)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Active mutes\n\n")
+	if len(h.ActiveMutes) == 0 {
+		b.WriteString("None.\n\n")
+	} else {
+		b.WriteString("| Scope | Target | Reason | Expires |\n")
+		b.WriteString("|---|---|---|---|\n")
+		for _, m := range h.ActiveMutes {
+			reason := m.Reason
+			if m.Name != "" {
+				reason = strings.TrimSpace(m.Name + " " + reason)
+			}
+			if reason == "" {
+				reason = "-"
+			}
+			expires := "never"
+			if m.ExpiresAt != nil {
+				expires = formatHandoffTime(*m.ExpiresAt)
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", m.Scope, markdownCell(m.Target), markdownCell(reason), expires)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Upcoming maintenance\n\n")
+	if len(h.UpcomingMaintenance) == 0 {
+		b.WriteString("None.\n")
+	} else {
+		b.WriteString("| Name | Scope | Target | Starts | Duration |\n")
+		b.WriteString("|---|---|---|---|---:|\n")
+		for _, m := range h.UpcomingMaintenance {
+			name := m.Name
+			if name == "" {
+				name = "Maintenance"
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n",
+				markdownCell(name), m.Scope, markdownCell(m.Target), formatHandoffTime(m.StartsAt), formatCalendarDuration(m.DurationMinutes))
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// handoffChannelSupported reports whether a handoff can be posted to a channel type.
+func handoffChannelSupported(t client.ChannelType) bool {
+	switch t {
+	case client.ChannelTypeSlack, client.ChannelTypeDiscord, client.ChannelTypeTeams, client.ChannelTypeWebhook:
+		return true
+	}
+	return false
+}
+
+// handoffRequest is the HTTP request that posts a handoff to a channel.
+type handoffRequest struct {
+	method  string
+	url     string
+	headers map[string]string
+	body    []byte
+}
+
+// buildHandoffRequest builds the request that posts the markdown to a channel's
+// configured URL, in the payload format the channel type expects.
+func buildHandoffRequest(channel *client.Channel, markdown string, h *Handoff) (*handoffRequest, error) {
+	req := &handoffRequest{method: http.MethodPost}
+
+	var payload any
+	switch channel.Type {
+	case client.ChannelTypeSlack:
+		var cfg client.SlackChannelConfig
+		if err := json.Unmarshal(channel.Config, &cfg); err != nil {
+			return nil, fmt.Errorf("invalid slack channel config: %w", err)
+		}
+		req.url = cfg.WebhookURL
+		payload = map[string]string{"text": markdown}
+	case client.ChannelTypeDiscord:
+		var cfg client.DiscordChannelConfig
+		if err := json.Unmarshal(channel.Config, &cfg); err != nil {
+			return nil, fmt.Errorf("invalid discord channel config: %w", err)
+		}
+		req.url = cfg.WebhookURL
+		payload = map[string]string{"content": truncateHandoff(markdown, handoffDiscordLimit)}
+	case client.ChannelTypeTeams:
+		var cfg client.TeamsChannelConfig
+		if err := json.Unmarshal(channel.Config, &cfg); err != nil {
+			return nil, fmt.Errorf("invalid teams channel config: %w", err)
+		}
+		req.url = cfg.WebhookURL
+		payload = map[string]string{"text": markdown}
+	case client.ChannelTypeWebhook:
+		var cfg client.WebhookChannelConfig
+		if err := json.Unmarshal(channel.Config, &cfg); err != nil {
+			return nil, fmt.Errorf("invalid webhook channel config: %w", err)
+		}
+		req.url = cfg.URL
+		if cfg.Method != "" {
+			req.method = strings.ToUpper(cfg.Method)
+		}
+		req.headers = cfg.Headers
+		payload = map[string]any{"event": "handoff", "markdown": markdown, "handoff": h}
+	default:
+		return nil, fmt.Errorf("unsupported channel type %s", channel.Type)
+	}
+
+	if req.url == "" {
+		return nil, fmt.Errorf("channel has no URL configured")
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode message: %w", err)
+	}
+	req.body = body
+	return req, nil
+}
+
+// truncateHandoff cuts markdown to at most limit characters, marking the cut.
+func truncateHandoff(markdown string, limit int) string {
+	if utf8.RuneCountInString(markdown) <= limit {
+		return markdown
+	}
+	const marker = "\n…(truncated)"
+	runes := []rune(markdown)
+	return string(runes[:limit-utf8.RuneCountInString(marker)]) + marker
+}
+
+// postHandoff sends the handoff to a notification channel.
+func postHandoff(ctx context.Context, channel *client.Channel, markdown string, h *Handoff) error {
+	hr, err := buildHandoffRequest(channel, markdown, h)
+	if err != nil {
+		return err
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, handoffPostTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(reqCtx, hr.method, hr.url, bytes.NewReader(hr.body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range hr.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,286 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+)
+
+func TestNewHandoffCmd(t *testing.T) {
+	cmd := NewHandoffCmd()
+
+	if cmd.Use != "handoff" {
+		t.Errorf("Use = %q, want %q", cmd.Use, "handoff")
+	}
+	if cmd.Short == "" {
+		t.Error("Short description should not be empty")
+	}
+
+	tests := []struct {
+		name     string
+		defValue string
+	}{
+		{"since", "12h"},
+		{"ahead", "24h0m0s"},
+		{"title", "On-call handoff"},
+		{"post-to", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flag := cmd.Flags().Lookup(tt.name)
+			if flag == nil {
+				t.Fatalf("flag --%s not found", tt.name)
+			}
+			if flag.DefValue != tt.defValue {
+				t.Errorf("--%s default = %q, want %q", tt.name, flag.DefValue, tt.defValue)
+			}
+		})
+	}
+}
+
+// handoffFixture returns handoff data for a shift from 00:00 to 12:00 UTC.
+func handoffFixture() (*handoffData, time.Time, time.Time) {
+	from := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	now := from.Add(12 * time.Hour)
+	at := func(h int) *time.Time {
+		t := from.Add(time.Duration(h) * time.Hour)
+		return &t
+	}
+
+	api := client.Probe{ID: uuid.New(), Name: "API", URL: "https://api.example.com", Status: "down", LastCheckedAt: at(11)}
+	web := client.Probe{ID: uuid.New(), Name: "Web", URL: "https://www.example.com", Status: "paused"}
+	docs := client.Probe{ID: uuid.New(), Name: "Docs", URL: "https://docs.example.com", Status: "up"}
+
+	message := "connection refused"
+	earlier := client.Alert{
+		ID: uuid.New(), ProbeID: api.ID, Status: client.AlertStatusAcknowledged, Severity: client.AlertSeverityWarning,
+		AlertType: client.AlertTypeStatusDown, TriggeredAt: from.Add(-3 * time.Hour), AcknowledgedAt: at(1),
+		Probe: &client.AlertProbe{ID: api.ID, Name: "API"},
+	}
+	active := client.Alert{
+		ID: uuid.New(), ProbeID: api.ID, Status: client.AlertStatusActive, Severity: client.AlertSeverityCritical,
+		AlertType: client.AlertTypeStatusDown, TriggeredAt: *at(10), Message: &message,
+		Probe: &client.AlertProbe{ID: api.ID, Name: "API"},
+	}
+	resolved := client.Alert{
+		ID: uuid.New(), ProbeID: docs.ID, Status: client.AlertStatusResolved, Severity: client.AlertSeverityWarning,
+		AlertType: client.AlertTypeStatusDown, TriggeredAt: *at(2), AcknowledgedAt: at(3), ResolvedAt: at(4),
+		Probe: &client.AlertProbe{ID: docs.ID, Name: "Docs"},
+	}
+
+	name := "DB upgrade"
+	reason := "noisy during deploy"
+	expires := from.Add(14 * time.Hour)
+	mutes := []client.AlertMute{
+		{ID: uuid.New(), ScopeType: client.MuteScopeProbe, ProbeID: &web.ID, StartsAt: *at(6), ExpiresAt: &expires, Reason: &reason, DurationMinutes: 480},
+		{ID: uuid.New(), ScopeType: client.MuteScopeOrganization, StartsAt: *at(14), IsMaintenanceWindow: true, MaintenanceName: &name, DurationMinutes: 90},
+		{ID: uuid.New(), ScopeType: client.MuteScopeOrganization, StartsAt: *at(60), IsMaintenanceWindow: true, DurationMinutes: 60},
+	}
+
+	pages := []handoffStatusPage{{
+		page: client.StatusPage{ID: 7, Name: "Public"},
+		incidents: []client.Incident{
+			{ID: 1, Title: "API outage", Status: "investigating", Impact: "major", CreatedAt: *at(10), UpdatedAt: *at(11)},
+			{ID: 2, Title: "Slow search", Status: "monitoring", Impact: "minor", CreatedAt: from.Add(-24 * time.Hour), UpdatedAt: *at(5)},
+			{ID: 3, Title: "Old incident", Status: "resolved", Impact: "minor", CreatedAt: from.Add(-48 * time.Hour), UpdatedAt: from.Add(-40 * time.Hour)},
+		},
+	}}
+
+	return &handoffData{
+		triggered:   []client.Alert{active, resolved},
+		open:        []client.Alert{earlier, active},
+		probes:      []client.Probe{api, web, docs},
+		statusPages: pages,
+		mutes:       mutes,
+	}, from, now
+}
+
+func TestBuildHandoff(t *testing.T) {
+	data, from, now := handoffFixture()
+
+	h := buildHandoff(data, "Handoff", from, now, 24*time.Hour)
+
+	want := HandoffSummary{
+		Triggered:           2,
+		Critical:            1,
+		Acknowledged:        2,
+		Resolved:            1,
+		Open:                2,
+		Incidents:           2,
+		ProbesDown:          1,
+		ProbesPaused:        1,
+		ActiveMutes:         1,
+		UpcomingMaintenance: 1,
+	}
+	if h.Summary != want {
+		t.Errorf("summary = %+v, want %+v", h.Summary, want)
+	}
+
+	if h.OpenAlerts[0].TriggeredAt.After(h.OpenAlerts[1].TriggeredAt) {
+		t.Error("open alerts should be oldest first")
+	}
+	if h.TriggeredAlerts[0].Message != "connection refused" {
+		t.Errorf("triggered alerts should be newest first, got %+v", h.TriggeredAlerts[0])
+	}
+	if !h.Incidents[0].Opened || h.Incidents[1].Opened {
+		t.Errorf("incident opened flags = %v, %v, want true, false", h.Incidents[0].Opened, h.Incidents[1].Opened)
+	}
+	if h.ActiveMutes[0].Target != "Web" {
+		t.Errorf("mute target = %q, want probe name", h.ActiveMutes[0].Target)
+	}
+	if h.UpcomingMaintenance[0].Name != "DB upgrade" {
+		t.Errorf("upcoming maintenance = %+v", h.UpcomingMaintenance)
+	}
+}
+
+func TestWriteHandoffMarkdown(t *testing.T) {
+	data, from, now := handoffFixture()
+	h := buildHandoff(data, "Handoff", from, now, 24*time.Hour)
+
+	var buf bytes.Buffer
+	if err := writeHandoffMarkdown(&buf, h); err != nil {
+		t.Fatalf("writeHandoffMarkdown() error: %v", err)
+	}
+	md := buf.String()
+
+	for _, want := range []string{
+		"# Handoff",
+		"**Shift:** Oct 18 00:00 UTC to Oct 18 12:00 UTC",
+		"- Alerts triggered: 2 (1 critical), 2 acknowledged, 1 resolved",
+		"| CRITICAL | API | active | Oct 18 10:00 UTC | 2h | connection refused |",
+		"| Public | Slow search | monitoring | minor | Oct 17 00:00 UTC (before shift) | Oct 18 05:00 UTC |",
+		"| API | https://api.example.com | Oct 18 11:00 UTC |",
+		"- Web (https://www.example.com)",
+		"| probe | Web | noisy during deploy | Oct 18 14:00 UTC |",
+		"| DB upgrade | organization | all probes | Oct 18 14:00 UTC | 1h30m |",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("markdown missing %q:\n%s", want, md)
+		}
+	}
+}
+
+func TestWriteHandoffMarkdown_Empty(t *testing.T) {
+	from := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	h := buildHandoff(&handoffData{}, "Handoff", from, from.Add(12*time.Hour), time.Hour)
+
+	var buf bytes.Buffer
+	if err := writeHandoffMarkdown(&buf, h); err != nil {
+		t.Fatalf("writeHandoffMarkdown() error: %v", err)
+	}
+	if got := strings.Count(buf.String(), "None."); got != 7 {
+		t.Errorf("expected every section to say None., got %d:\n%s", got, buf.String())
+	}
+}
+
+func TestBuildHandoffRequest(t *testing.T) {
+	h := &Handoff{Title: "Handoff"}
+
+	slackConfig, _ := json.Marshal(client.SlackChannelConfig{WebhookURL: "https://hooks.slack.com/services/T0/B0/x"})
+	webhookConfig, _ := json.Marshal(client.WebhookChannelConfig{URL: "https://ops.example.com/handoff", Method: "put", Headers: map[string]string{"X-Token": "abc"}})
+	discordConfig, _ := json.Marshal(client.DiscordChannelConfig{WebhookURL: "https://discord.com/api/webhooks/1/x"})
+	emailConfig, _ := json.Marshal(client.EmailChannelConfig{Address: "oncall@example.com"})
+
+	req, err := buildHandoffRequest(&client.Channel{Type: client.ChannelTypeSlack, Config: slackConfig}, "# Handoff", h)
+	if err != nil {
+		t.Fatalf("slack: unexpected error: %v", err)
+	}
+	if req.method != http.MethodPost || req.url != "https://hooks.slack.com/services/T0/B0/x" || string(req.body) != `{"text":"# Handoff"}` {
+		t.Errorf("slack request = %s %s %s", req.method, req.url, req.body)
+	}
+
+	req, err = buildHandoffRequest(&client.Channel{Type: client.ChannelTypeWebhook, Config: webhookConfig}, "# Handoff", h)
+	if err != nil {
+		t.Fatalf("webhook: unexpected error: %v", err)
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(req.body, &payload); err != nil {
+		t.Fatalf("webhook body is not JSON: %v", err)
+	}
+	if req.method != http.MethodPut || req.headers["X-Token"] != "abc" || payload["event"] != "handoff" || payload["handoff"] == nil {
+		t.Errorf("webhook request = %s %v %s", req.method, req.headers, req.body)
+	}
+
+	long := strings.Repeat("é", handoffDiscordLimit+10)
+	req, err = buildHandoffRequest(&client.Channel{Type: client.ChannelTypeDiscord, Config: discordConfig}, long, h)
+	if err != nil {
+		t.Fatalf("discord: unexpected error: %v", err)
+	}
+	var discord map[string]string
+	if err := json.Unmarshal(req.body, &discord); err != nil {
+		t.Fatalf("discord body is not JSON: %v", err)
+	}
+	if n := utf8.RuneCountInString(discord["content"]); n != handoffDiscordLimit || !strings.HasSuffix(discord["content"], "(truncated)") {
+		t.Errorf("discord content length = %d, want %d with truncation marker", n, handoffDiscordLimit)
+	}
+
+	if _, err := buildHandoffRequest(&client.Channel{Type: client.ChannelTypeEmail, Config: emailConfig}, "# Handoff", h); err == nil {
+		t.Error("expected error for email channel")
+	}
+}
+
+func TestPostHandoff(t *testing.T) {
+	var gotBody, gotContentType string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		gotContentType = r.Header.Get("Content-Type")
+		if strings.Contains(gotBody, "fail") {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	cfg, _ := json.Marshal(client.TeamsChannelConfig{WebhookURL: srv.URL})
+	channel := &client.Channel{Name: "ops", Type: client.ChannelTypeTeams, Config: cfg}
+
+	if err := postHandoff(context.Background(), channel, "# Handoff", &Handoff{}); err != nil {
+		t.Fatalf("postHandoff() error: %v", err)
+	}
+	if gotBody != `{"text":"# Handoff"}` || gotContentType != "application/json" {
+		t.Errorf("request = %q (%s)", gotBody, gotContentType)
+	}
+
+	err := postHandoff(context.Background(), channel, "fail", &Handoff{})
+	if err == nil || !strings.Contains(err.Error(), "HTTP 400") {
+		t.Errorf("error = %v, want HTTP 400", err)
+	}
+}
+
+func TestRunHandoff_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   handoffFlags
+		wantErr string
+	}{
+		{"invalid since", handoffFlags{since: "yesterday", ahead: time.Hour}, "invalid --since"},
+		{"since in the future", handoffFlags{since: "2999-01-01", ahead: time.Hour}, "must be in the past"},
+		{"negative ahead", handoffFlags{since: "12h", ahead: -time.Hour}, "--ahead"},
+		{"invalid channel", handoffFlags{since: "12h", ahead: time.Hour, postTo: "slack"}, "--post-to"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runHandoff(context.Background(), &tt.flags)
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", err.Error(), tt.wantErr)
+			}
+			if strings.Contains(err.Error(), "API client") {
+				t.Errorf("validation should not need the API client, got %q", err.Error())
+			}
+		})
+	}
+}
@@ -129,6 +129,9 @@ YAML File Format:
 	// Mark required flags
 	_ = cmd.MarkFlagRequired("status-page-id")
 
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("status-page-id", StatusPageCompletion())
+
 	return cmd
 }
 
@@ -85,6 +85,10 @@ Examples:
 	_ = cmd.MarkFlagRequired("status-page-id")
 	_ = cmd.MarkFlagRequired("incident-id")
 
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("status-page-id", StatusPageCompletion())
+	_ = cmd.RegisterFlagCompletionFunc("incident-id", IncidentCompletion())
+
 	return cmd
 }
 
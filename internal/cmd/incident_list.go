@@ -89,6 +89,9 @@ Examples:
 	// Mark required flags
 	_ = cmd.MarkFlagRequired("status-page-id")
 
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("status-page-id", StatusPageCompletion())
+
 	return cmd
 }
 
@@ -80,6 +80,10 @@ Note: Resolved incidents remain visible on the status page history. Use
 	_ = cmd.MarkFlagRequired("status-page-id")
 	_ = cmd.MarkFlagRequired("incident-id")
 
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("status-page-id", StatusPageCompletion())
+	_ = cmd.RegisterFlagCompletionFunc("incident-id", IncidentCompletion())
+
 	return cmd
 }
 
@@ -117,6 +117,10 @@ Note: All fields in the YAML file are optional. Only specified fields will be up
 	_ = cmd.MarkFlagRequired("status-page-id")
 	_ = cmd.MarkFlagRequired("incident-id")
 
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("status-page-id", StatusPageCompletion())
+	_ = cmd.RegisterFlagCompletionFunc("incident-id", IncidentCompletion())
+
 	return cmd
 }
 
@@ -28,8 +28,9 @@ func NewLabelDeleteCmd() *cobra.Command {
 	flags := &labelDeleteFlags{}
 
 	cmd := &cobra.Command{
-		Use:   "delete <key>",
-		Short: "Delete a label key",
+		Use:               "delete <key>",
+		Short:             "Delete a label key",
+		ValidArgsFunction: LabelKeyCompletion(),
 		Long: `Delete a probe label key from your organization.
 
 This permanently removes the label key and cascades to remove the label from
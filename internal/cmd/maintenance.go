@@ -20,6 +20,7 @@ Key Features:
   - Scheduled start times for planned maintenance
   - Scope to specific probes or organization-wide
   - Automatic expiration after specified duration
+  - Wrap a command in a window that ends when the command exits
 
 Examples:
   # Schedule a 2-hour maintenance window for a specific probe
@@ -31,6 +32,9 @@ Examples:
     --organization-wide --duration 60 \
     --starts-at 2024-01-15T02:00:00Z
 
+  # Run a deployment inside a maintenance window
+  stackeye maintenance run --name deploy -l app=api -- ./deploy.sh
+
 For more information about a specific command:
   stackeye maintenance [command] --help`,
 		Aliases: []string{"maint", "mw"},
@@ -41,6 +45,7 @@ For more information about a specific command:
 	cmd.AddCommand(NewMaintenanceListCmd())
 	cmd.AddCommand(NewMaintenanceCalendarCmd())
 	cmd.AddCommand(NewMaintenanceDeleteCmd())
+	cmd.AddCommand(NewMaintenanceRunCmd())
 
 	return cmd
 }
@@ -27,8 +27,9 @@ func NewMaintenanceDeleteCmd() *cobra.Command {
 	flags := &maintenanceDeleteFlags{}
 
 	cmd := &cobra.Command{
-		Use:   "delete <id>",
-		Short: "Delete a scheduled maintenance window",
+		Use:               "delete <id>",
+		Short:             "Delete a scheduled maintenance window",
+		ValidArgsFunction: MuteCompletion(),
 		Long: `Delete a scheduled maintenance window by its ID.
 
 This permanently removes the maintenance window configuration. Alerts that were
@@ -0,0 +1,353 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	"github.com/StackEye-IO/stackeye-cli/internal/dryrun"
+	clierrors "github.com/StackEye-IO/stackeye-cli/internal/errors"
+	clisignal "github.com/StackEye-IO/stackeye-cli/internal/signal"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// maintenanceRunTimeout is the maximum time to wait for a single API response.
+const maintenanceRunTimeout = 30 * time.Second
+
+// maintenanceRunFlags holds the flag values for the maintenance run command.
+type maintenanceRunFlags struct {
+	name             string
+	probeIDs         []string
+	labels           string
+	organizationWide bool
+	duration         int
+	reason           string
+}
+
+// maintenanceRunWindow is a maintenance mute created for one scope.
+type maintenanceRunWindow struct {
+	muteID uuid.UUID
+	scope  string // probe name, or "organization"
+}
+
+// NewMaintenanceRunCmd creates and returns the maintenance run subcommand.
+func NewMaintenanceRunCmd() *cobra.Command {
+	flags := &maintenanceRunFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "run --name <name> (--probe-id <id> | -l <selector> | --organization-wide) -- <command> [args...]",
+		Short: "Run a command inside a maintenance window",
+		Long: `Run a command inside a maintenance window that ends when the command exits.
+
+Creates a maintenance window for the selected probes, runs the command after
+"--", and expires the window as soon as the command exits, whether it succeeds,
+fails or is interrupted. SIGINT and SIGTERM are forwarded to the command so it
+can shut down cleanly before the window is closed. A Ctrl+C typed in the
+terminal already reaches the command and is not sent a second time.
+
+The command inherits stdin, stdout and stderr; status messages are written to
+stderr. stackeye exits with the command's exit code, also when it was
+interrupted.
+
+Scope (choose probes or organization-wide):
+  --probe-id           Probe name or UUID (repeatable)
+  -l, --labels         Every probe matching a label selector
+  --organization-wide  The entire organization
+
+--duration is a safety limit: if stackeye is killed before it can expire the
+window, the window still ends on its own after this many minutes. Choose a
+value longer than the command is expected to run.
+
+Examples:
+  # Suppress alerts for the API probes while deploying
+  stackeye maintenance run --name deploy -l app=api -- ./deploy.sh
+
+  # A single probe, with arguments passed to the command
+  stackeye maintenance run --name "DB migration" --probe-id "Production DB" \
+    -- make migrate ENV=production
+
+  # Organization-wide, for up to 3 hours
+  stackeye maintenance run --name "Network cutover" --organization-wide \
+    --duration 180 -- ./cutover.sh`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if cmd.ArgsLenAtDash() != 0 || len(args) == 0 {
+				return fmt.Errorf("requires a command after \"--\", e.g. stackeye maintenance run --name deploy -l app=api -- ./deploy.sh")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMaintenanceRun(cmd.Context(), args, flags)
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.name, "name", "", "maintenance window name (required)")
+	cmd.Flags().StringSliceVar(&flags.probeIDs, "probe-id", nil, "probe name or UUID to put in maintenance (repeatable)")
+	cmd.Flags().StringVarP(&flags.labels, "labels", "l", "", "put probes matching labels in maintenance: key=value,key!=value,key (AND logic)")
+	cmd.Flags().BoolVar(&flags.organizationWide, "organization-wide", false, "put the entire organization in maintenance")
+	cmd.Flags().IntVar(&flags.duration, "duration", 60, "safety limit in minutes after which the window ends on its own")
+	cmd.Flags().StringVar(&flags.reason, "reason", "", "reason for the maintenance window")
+
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.RegisterFlagCompletionFunc("probe-id", ProbeCompletion())
+	_ = cmd.RegisterFlagCompletionFunc("labels", LabelFilterCompletion())
+
+	return cmd
+}
+
+// runMaintenanceRun executes the maintenance run command logic.
+func runMaintenanceRun(ctx context.Context, command []string, flags *maintenanceRunFlags) error {
+	if err := validateMaintenanceRunFlags(flags); err != nil {
+		return err
+	}
+
+	if GetDryRun() {
+		scope := "organization"
+		if !flags.organizationWide {
+			scope = maintenanceRunScopeSummary(flags)
+		}
+		dryrun.PrintAction("create", "maintenance window",
+			"Name", flags.name,
+			"Scope", scope,
+			"Duration", fmt.Sprintf("up to %d minutes", flags.duration),
+			"Command", strings.Join(command, " "),
+		)
+		return nil
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	requests, scopes, err := buildMaintenanceRunRequests(ctx, apiClient, flags)
+	if err != nil {
+		return err
+	}
+
+	windows, err := startMaintenanceWindows(ctx, apiClient, requests, scopes)
+	// Expire whatever was created, even if the API call was interrupted
+	defer endMaintenanceWindows(context.WithoutCancel(ctx), apiClient, flags.name, windows)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Started maintenance window %q for %s\n", flags.name, maintenanceRunScopeList(windows))
+
+	exitCode, err := runMaintenanceCommand(command)
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		// The command has already reported its own failure
+		return clierrors.WithExitCode(exitCode, nil)
+	}
+	return nil
+}
+
+// validateMaintenanceRunFlags checks the flags before any API call.
+func validateMaintenanceRunFlags(flags *maintenanceRunFlags) error {
+	if flags.name == "" {
+		return fmt.Errorf("--name is required")
+	}
+	if flags.duration <= 0 {
+		return fmt.Errorf("--duration must be a positive number of minutes")
+	}
+
+	hasProbes := len(flags.probeIDs) > 0 || flags.labels != ""
+	if !hasProbes && !flags.organizationWide {
+		return fmt.Errorf("must specify --probe-id, --labels or --organization-wide")
+	}
+	if hasProbes && flags.organizationWide {
+		return fmt.Errorf("cannot combine --organization-wide with --probe-id or --labels")
+	}
+
+	if flags.labels != "" {
+		if _, err := parseLabelSelector(flags.labels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maintenanceRunScopeSummary describes the probe flags for dry-run output.
+func maintenanceRunScopeSummary(flags *maintenanceRunFlags) string {
+	var parts []string
+	if len(flags.probeIDs) > 0 {
+		parts = append(parts, "probes "+strings.Join(flags.probeIDs, ", "))
+	}
+	if flags.labels != "" {
+		parts = append(parts, fmt.Sprintf("probes matching %q", flags.labels))
+	}
+	return strings.Join(parts, " and ")
+}
+
+// buildMaintenanceRunRequests resolves the scope into one mute request per
+// probe, or a single organization-wide request.
+func buildMaintenanceRunRequests(ctx context.Context, apiClient *client.Client, flags *maintenanceRunFlags) ([]*client.CreateMuteRequest, []string, error) {
+	base := client.CreateMuteRequest{
+		DurationMinutes:     flags.duration,
+		IsMaintenanceWindow: true,
+		MaintenanceName:     &flags.name,
+	}
+	if flags.reason != "" {
+		base.Reason = &flags.reason
+	}
+
+	if flags.organizationWide {
+		req := base
+		req.ScopeType = client.MuteScopeOrganization
+		return []*client.CreateMuteRequest{&req}, []string{"organization"}, nil
+	}
+
+	type target struct {
+		id   uuid.UUID
+		name string
+	}
+	var targets []target
+	seen := make(map[uuid.UUID]bool)
+
+	for _, idArg := range flags.probeIDs {
+		id, err := ResolveProbeID(ctx, apiClient, idArg)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !seen[id] {
+			seen[id] = true
+			targets = append(targets, target{id: id, name: idArg})
+		}
+	}
+
+	if flags.labels != "" {
+		probes, err := selectProbesByLabels(ctx, apiClient, flags.labels)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, p := range probes {
+			if !seen[p.ID] {
+				seen[p.ID] = true
+				targets = append(targets, target{id: p.ID, name: p.Name})
+			}
+		}
+	}
+
+	if len(targets) == 0 {
+		return nil, nil, fmt.Errorf("no probes match selector %q", flags.labels)
+	}
+
+	requests := make([]*client.CreateMuteRequest, 0, len(targets))
+	scopes := make([]string, 0, len(targets))
+	for _, t := range targets {
+		req := base
+		req.ScopeType = client.MuteScopeProbe
+		probeID := t.id
+		req.ProbeID = &probeID
+		requests = append(requests, &req)
+		scopes = append(scopes, t.name)
+	}
+	return requests, scopes, nil
+}
+
+// startMaintenanceWindows creates the maintenance mutes. On error it returns
+// the windows created so far so they can be expired; the command must not run
+// with only part of its scope in maintenance.
+func startMaintenanceWindows(ctx context.Context, apiClient *client.Client, requests []*client.CreateMuteRequest, scopes []string) ([]maintenanceRunWindow, error) {
+	windows := make([]maintenanceRunWindow, 0, len(requests))
+	for i, req := range requests {
+		reqCtx, cancel := context.WithTimeout(ctx, maintenanceRunTimeout)
+		mute, err := client.CreateMute(reqCtx, apiClient, req)
+		cancel()
+		if err != nil {
+			return windows, fmt.Errorf("failed to create maintenance window for %s: %w", scopes[i], err)
+		}
+		if mute != nil {
+			windows = append(windows, maintenanceRunWindow{muteID: mute.ID, scope: scopes[i]})
+		}
+	}
+	return windows, nil
+}
+
+// endMaintenanceWindows expires every created window. Failures are reported
+// with the mute ID so the window can be expired by hand.
+func endMaintenanceWindows(ctx context.Context, apiClient *client.Client, name string, windows []maintenanceRunWindow) {
+	if len(windows) == 0 {
+		return
+	}
+
+	failed := 0
+	for _, w := range windows {
+		reqCtx, cancel := context.WithTimeout(ctx, maintenanceRunTimeout)
+		_, err := client.ExpireMute(reqCtx, apiClient, w.muteID)
+		cancel()
+		if err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "Warning: failed to end maintenance window for %s: %v\n", w.scope, err)
+			fmt.Fprintf(os.Stderr, "  Expire it with: stackeye mute expire %s\n", w.muteID)
+		}
+	}
+	if failed == 0 {
+		fmt.Fprintf(os.Stderr, "Ended maintenance window %q\n", name)
+	}
+}
+
+// maintenanceRunScopeList describes the created windows for status output.
+func maintenanceRunScopeList(windows []maintenanceRunWindow) string {
+	if len(windows) == 1 && windows[0].scope == "organization" {
+		return "the organization"
+	}
+	names := make([]string, 0, len(windows))
+	for _, w := range windows {
+		names = append(names, w.scope)
+	}
+	if len(names) == 1 {
+		return "probe " + names[0]
+	}
+	return fmt.Sprintf("%d probes (%s)", len(names), strings.Join(names, ", "))
+}
+
+// runMaintenanceCommand runs the command with inherited standard streams,
+// forwarding SIGINT and SIGTERM to it, and returns its exit code. An error is
+// returned only if the command could not be started.
+func runMaintenanceCommand(command []string) (int, error) {
+	child := exec.Command(command[0], command[1:]...)
+	child.Stdin = os.Stdin
+	child.Stdout = os.Stdout
+	child.Stderr = os.Stderr
+
+	if err := child.Start(); err != nil {
+		return 0, fmt.Errorf("failed to start command %q: %w", command[0], err)
+	}
+
+	stop := clisignal.Forward(child.Process)
+	defer stop()
+
+	return commandExitCode(child.Wait()), nil
+}
+
+// commandExitCode converts the result of exec.Cmd.Wait into an exit code,
+// using the shell convention of 128 plus the signal number for commands
+// killed by a signal.
+func commandExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return clierrors.ExitError
+	}
+	if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return 128 + int(status.Signal())
+	}
+	if code := exitErr.ExitCode(); code > 0 {
+		return code
+	}
+	return clierrors.ExitError
+}
@@ -0,0 +1,241 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+
+	clierrors "github.com/StackEye-IO/stackeye-cli/internal/errors"
+	"github.com/google/uuid"
+)
+
+func TestNewMaintenanceRunCmd(t *testing.T) {
+	cmd := NewMaintenanceRunCmd()
+
+	if !strings.HasPrefix(cmd.Use, "run ") {
+		t.Errorf("Use = %q, want prefix %q", cmd.Use, "run ")
+	}
+	if cmd.Short == "" {
+		t.Error("Short description should not be empty")
+	}
+	if cmd.Long == "" {
+		t.Error("Long description should not be empty")
+	}
+}
+
+func TestMaintenanceRunCmd_Flags(t *testing.T) {
+	cmd := NewMaintenanceRunCmd()
+
+	tests := []struct {
+		name      string
+		shorthand string
+		defValue  string
+	}{
+		{"name", "", ""},
+		{"probe-id", "", "[]"},
+		{"labels", "l", ""},
+		{"organization-wide", "", "false"},
+		{"duration", "", "60"},
+		{"reason", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flag := cmd.Flags().Lookup(tt.name)
+			if flag == nil {
+				t.Fatalf("flag --%s not found", tt.name)
+			}
+			if flag.Shorthand != tt.shorthand {
+				t.Errorf("--%s shorthand = %q, want %q", tt.name, flag.Shorthand, tt.shorthand)
+			}
+			if flag.DefValue != tt.defValue {
+				t.Errorf("--%s default = %q, want %q", tt.name, flag.DefValue, tt.defValue)
+			}
+		})
+	}
+}
+
+func TestMaintenanceRunCmd_RequiresCommandAfterDash(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"no command", []string{"--name", "deploy", "--organization-wide"}},
+		{"empty after dash", []string{"--name", "deploy", "--organization-wide", "--"}},
+		{"command before dash", []string{"--name", "deploy", "--organization-wide", "./deploy.sh"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewMaintenanceRunCmd()
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			err := cmd.Execute()
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), "requires a command after") {
+				t.Errorf("error = %q, want it to mention the missing command", err.Error())
+			}
+		})
+	}
+}
+
+func TestValidateMaintenanceRunFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   maintenanceRunFlags
+		wantErr string
+	}{
+		{
+			name:  "probe ids",
+			flags: maintenanceRunFlags{name: "deploy", probeIDs: []string{"api"}, duration: 60},
+		},
+		{
+			name:  "labels",
+			flags: maintenanceRunFlags{name: "deploy", labels: "app=api", duration: 60},
+		},
+		{
+			name:  "organization wide",
+			flags: maintenanceRunFlags{name: "deploy", organizationWide: true, duration: 60},
+		},
+		{
+			name:    "missing name",
+			flags:   maintenanceRunFlags{organizationWide: true, duration: 60},
+			wantErr: "--name is required",
+		},
+		{
+			name:    "no scope",
+			flags:   maintenanceRunFlags{name: "deploy", duration: 60},
+			wantErr: "must specify",
+		},
+		{
+			name:    "conflicting scope",
+			flags:   maintenanceRunFlags{name: "deploy", labels: "app=api", organizationWide: true, duration: 60},
+			wantErr: "cannot combine",
+		},
+		{
+			name:    "zero duration",
+			flags:   maintenanceRunFlags{name: "deploy", organizationWide: true},
+			wantErr: "--duration must be",
+		},
+		{
+			name:    "invalid selector",
+			flags:   maintenanceRunFlags{name: "deploy", labels: ",", duration: 60},
+			wantErr: "is empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateMaintenanceRunFlags(&tt.flags)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRunMaintenanceRun_ValidationBeforeAPIClient(t *testing.T) {
+	flags := &maintenanceRunFlags{name: "deploy", duration: 60}
+
+	err := runMaintenanceRun(t.Context(), []string{"true"}, flags)
+	if err == nil {
+		t.Fatal("expected validation error, got nil")
+	}
+	if strings.Contains(err.Error(), "API client") {
+		t.Errorf("validation should run before the API client is created, got %q", err.Error())
+	}
+}
+
+func TestMaintenanceRunScopeList(t *testing.T) {
+	tests := []struct {
+		name    string
+		windows []maintenanceRunWindow
+		want    string
+	}{
+		{
+			name:    "organization",
+			windows: []maintenanceRunWindow{{muteID: uuid.New(), scope: "organization"}},
+			want:    "the organization",
+		},
+		{
+			name:    "single probe",
+			windows: []maintenanceRunWindow{{muteID: uuid.New(), scope: "api"}},
+			want:    "probe api",
+		},
+		{
+			name: "several probes",
+			windows: []maintenanceRunWindow{
+				{muteID: uuid.New(), scope: "api"},
+				{muteID: uuid.New(), scope: "web"},
+			},
+			want: "2 probes (api, web)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := maintenanceRunScopeList(tt.windows); got != tt.want {
+				t.Errorf("maintenanceRunScopeList() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunMaintenanceCommand_ExitCode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	tests := []struct {
+		name string
+		cmd  []string
+		want int
+	}{
+		{"success", []string{"sh", "-c", "exit 0"}, 0},
+		{"failure", []string{"sh", "-c", "exit 3"}, 3},
+		{"killed by signal", []string{"sh", "-c", "kill -TERM $$"}, 143},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runMaintenanceCommand(tt.cmd)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("exit code = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunMaintenanceCommand_NotFound(t *testing.T) {
+	_, err := runMaintenanceCommand([]string{"stackeye-no-such-command-for-test"})
+	if err == nil {
+		t.Fatal("expected error for missing command, got nil")
+	}
+	if !strings.Contains(err.Error(), "failed to start command") {
+		t.Errorf("error = %q, want it to mention start failure", err.Error())
+	}
+}
+
+func TestCommandExitCode_NonExitError(t *testing.T) {
+	if got := commandExitCode(errors.New("boom")); got != clierrors.ExitError {
+		t.Errorf("commandExitCode() = %d, want %d", got, clierrors.ExitError)
+	}
+	if got := commandExitCode(nil); got != 0 {
+		t.Errorf("commandExitCode(nil) = %d, want 0", got)
+	}
+}
@@ -124,6 +124,10 @@ Examples:
 	_ = cmd.MarkFlagRequired("scope")
 	_ = cmd.MarkFlagRequired("duration")
 
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("channel-id", ChannelCompletion())
+	_ = cmd.RegisterFlagCompletionFunc("alert-type", AlertTypeCompletion())
+
 	return cmd
 }
 
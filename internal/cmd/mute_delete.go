@@ -27,8 +27,9 @@ func NewMuteDeleteCmd() *cobra.Command {
 	flags := &muteDeleteFlags{}
 
 	cmd := &cobra.Command{
-		Use:   "delete <id>",
-		Short: "Delete an alert mute period",
+		Use:               "delete <id>",
+		Short:             "Delete an alert mute period",
+		ValidArgsFunction: MuteCompletion(),
 		Long: `Delete an alert mute period by its ID.
 
 This permanently removes the mute configuration. Alerts that were silenced by
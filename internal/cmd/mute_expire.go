@@ -28,9 +28,10 @@ func NewMuteExpireCmd() *cobra.Command {
 	flags := &muteExpireFlags{}
 
 	cmd := &cobra.Command{
-		Use:   "expire <id>",
-		Short: "Immediately expire an active mute period",
-		Long: `Immediately expire an active alert mute period by its ID.
+		Use:               "expire <id> [id...]",
+		Short:             "Immediately expire an active mute period",
+		ValidArgsFunction: RepeatedCompletion(MuteCompletion()),
+		Long: `Immediately expire one or more active alert mute periods by ID.
 
 This sets the mute's expiration time to now, ending its effect immediately while
 preserving the mute record in history for audit purposes.
@@ -42,6 +43,11 @@ normal operations, prefer using expire over delete.
 By default, the command will prompt for confirmation before expiring. Use --yes
 to skip the confirmation prompt for scripting or automation.
 
+Pass - instead of IDs to read them from stdin, one per line or as a JSON array
+of IDs or of objects with an "id" field (such as list output with -o json).
+The confirmation prompt then reads from the terminal; without one, --yes is
+required.
+
 Examples:
   # Expire a mute (with confirmation)
   stackeye mute expire 550e8400-e29b-41d4-a716-446655440000
@@ -50,10 +56,26 @@ Examples:
   stackeye mute expire 550e8400-e29b-41d4-a716-446655440000 --yes
 
   # Short form
-  stackeye mute expire 550e8400-e29b-41d4-a716-446655440000 -y`,
-		Args: cobra.ExactArgs(1),
+  stackeye mute expire 550e8400-e29b-41d4-a716-446655440000 -y
+
+  # Expire multiple mutes at once
+  stackeye mute expire abc123... def456... --yes
+
+  # Expire every maintenance mute listed by another command
+  stackeye mute list --maintenance-only -o json | stackeye mute expire -`,
+		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runMuteExpire(cmd.Context(), args[0], flags)
+			ids, fromStdin, err := readIDArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+			if err := promptFromTTYForStdinIDs(fromStdin, flags.yes); err != nil {
+				return err
+			}
+			if len(ids) == 1 {
+				return runMuteExpire(cmd.Context(), ids[0], flags)
+			}
+			return runMuteExpireBatch(cmd.Context(), ids, flags)
 		},
 	}
 
@@ -131,3 +153,75 @@ func runMuteExpire(ctx context.Context, idArg string, flags *muteExpireFlags) er
 
 	return nil
 }
+
+// runMuteExpireBatch expires multiple mutes after a single confirmation.
+// Mutes that are already expired are reported as failures.
+func runMuteExpireBatch(ctx context.Context, idArgs []string, flags *muteExpireFlags) error {
+	// Parse and validate all UUIDs before making any API calls
+	muteIDs := make([]uuid.UUID, 0, len(idArgs))
+	for _, idArg := range idArgs {
+		muteID, err := uuid.Parse(idArg)
+		if err != nil {
+			return fmt.Errorf("invalid mute ID %q: must be a valid UUID", idArg)
+		}
+		muteIDs = append(muteIDs, muteID)
+	}
+
+	// Dry-run check: after validation, before API calls
+	if GetDryRun() {
+		ids := make([]string, len(muteIDs))
+		for i, id := range muteIDs {
+			ids[i] = id.String()
+		}
+		dryrun.PrintBatchAction("expire", "mute", ids)
+		return nil
+	}
+
+	// Get authenticated API client
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	message := fmt.Sprintf("Are you sure you want to expire %d mutes?", len(muteIDs))
+	confirmed, err := cliinteractive.Confirm(message, cliinteractive.WithYesFlag(flags.yes))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Expire cancelled.")
+		return nil
+	}
+
+	var expireErrors []error
+	expiredCount := 0
+
+	for _, muteID := range muteIDs {
+		reqCtx, cancel := context.WithTimeout(ctx, muteExpireTimeout)
+		mute, err := client.ExpireMute(reqCtx, apiClient, muteID)
+		cancel()
+
+		if err != nil {
+			expireErrors = append(expireErrors, fmt.Errorf("failed to expire mute %s: %w", muteID, err))
+			continue
+		}
+
+		expiredCount++
+		if mute != nil {
+			fmt.Printf("Expired mute %s (%s scope)\n", muteID, mute.ScopeType)
+		} else {
+			fmt.Printf("Expired mute %s\n", muteID)
+		}
+	}
+
+	if len(expireErrors) > 0 {
+		fmt.Printf("\nExpired %d of %d mutes.\n", expiredCount, len(muteIDs))
+		for _, err := range expireErrors {
+			fmt.Printf("Error: %v\n", err)
+		}
+		return fmt.Errorf("failed to expire %d mute(s)", len(expireErrors))
+	}
+
+	fmt.Printf("\nSuccessfully expired %d mutes.\n", expiredCount)
+	return nil
+}
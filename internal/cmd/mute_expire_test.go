@@ -9,8 +9,8 @@ import (
 func TestNewMuteExpireCmd(t *testing.T) {
 	cmd := NewMuteExpireCmd()
 
-	if cmd.Use != "expire <id>" {
-		t.Errorf("Use = %q, want %q", cmd.Use, "expire <id>")
+	if cmd.Use != "expire <id> [id...]" {
+		t.Errorf("Use = %q, want %q", cmd.Use, "expire <id> [id...]")
 	}
 
 	if cmd.Short == "" {
@@ -49,27 +49,27 @@ func TestMuteExpireCmd_NoArgs(t *testing.T) {
 		t.Error("Expected error when no arguments provided, got nil")
 	}
 
-	// Cobra's ExactArgs(1) produces a specific error message
-	expectedMsg := "accepts 1 arg"
+	// Cobra's MinimumNArgs(1) produces a specific error message
+	expectedMsg := "requires at least 1 arg"
 	if err != nil && !strings.Contains(err.Error(), expectedMsg) {
 		t.Errorf("Error = %q, want to contain %q", err.Error(), expectedMsg)
 	}
 }
 
-func TestMuteExpireCmd_TooManyArgs(t *testing.T) {
+func TestMuteExpireCmd_MultipleArgsInvalidUUID(t *testing.T) {
 	cmd := NewMuteExpireCmd()
 	cmd.SetArgs([]string{
 		"550e8400-e29b-41d4-a716-446655440000",
-		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"not-a-uuid",
+		"--yes",
 	})
 
 	err := cmd.Execute()
 	if err == nil {
-		t.Error("Expected error when too many arguments provided, got nil")
+		t.Error("Expected error for invalid UUID in batch, got nil")
 	}
 
-	// Cobra's ExactArgs(1) produces a specific error message
-	expectedMsg := "accepts 1 arg"
+	expectedMsg := "invalid mute ID"
 	if err != nil && !strings.Contains(err.Error(), expectedMsg) {
 		t.Errorf("Error = %q, want to contain %q", err.Error(), expectedMsg)
 	}
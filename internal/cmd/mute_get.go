@@ -19,8 +19,9 @@ const muteGetTimeout = 30 * time.Second
 // NewMuteGetCmd creates and returns the mute get subcommand.
 func NewMuteGetCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get <id>",
-		Short: "Get details of an alert mute period",
+		Use:               "get <id>",
+		Short:             "Get details of an alert mute period",
+		ValidArgsFunction: MuteCompletion(),
 		Long: `Get detailed information about a specific alert mute period.
 
 Displays the full mute information including scope, target, duration,
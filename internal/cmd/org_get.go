@@ -40,8 +40,9 @@ type OrgDetail struct {
 // NewOrgGetCmd creates and returns the org get subcommand.
 func NewOrgGetCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get [id|slug]",
-		Short: "Get organization details",
+		Use:               "get [id|slug]",
+		Short:             "Get organization details",
+		ValidArgsFunction: OrgCompletion(),
 		Long: `Get detailed information about an organization.
 
 Shows organization settings, plan limits, current usage, and team member count.
@@ -27,8 +27,9 @@ type SwitchResult struct {
 // NewOrgSwitchCmd creates and returns the org switch subcommand.
 func NewOrgSwitchCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "switch <id|slug>",
-		Short: "Switch to a different organization",
+		Use:               "switch <id|slug>",
+		Short:             "Switch to a different organization",
+		ValidArgsFunction: OrgCompletion(),
 		Long: `Switch your active organization context.
 
 This command changes which organization is used for subsequent CLI commands.
@@ -25,9 +25,13 @@ Probe Operations:
   pause         Temporarily pause monitoring
   resume        Resume a paused probe
   test          Run an immediate test check
+  check         Run a check from this machine without creating a probe
+  lint          Check probe configurations for common mistakes
   history       View probe check history
   logs          View recent check logs with follow mode
   stats         View uptime and response time statistics
+  uptime-calendar  Show daily uptime as a calendar heatmap
+  regions       Compare latency and availability across regions
   watch         Watch probe status with live updates
   export        Export probe configurations for backup
   import        Import probe configurations from file
@@ -91,6 +95,10 @@ For more information about a specific command:
 	cmd.AddCommand(NewProbeExportCmd())  // Task #7110
 	cmd.AddCommand(NewProbeImportCmd())  // Task #7111
 	cmd.AddCommand(NewProbeLogsCmd())    // Task #7112
+	cmd.AddCommand(NewProbeUptimeCalendarCmd())
+	cmd.AddCommand(NewProbeRegionsCmd())
+	cmd.AddCommand(NewProbeCheckCmd())
+	cmd.AddCommand(NewProbeLintCmd())
 
 	return cmd
 }
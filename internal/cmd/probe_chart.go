@@ -0,0 +1,370 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+)
+
+// probeChartWidth and probeChartHeight are the size, in characters, of the
+// braille response time chart.
+const (
+	probeChartWidth  = 60
+	probeChartHeight = 8
+)
+
+// probeChartMaxRegionResults caps how many raw results are fetched per region
+// for the per-region sparklines.
+const probeChartMaxRegionResults = 1000
+
+// probeChartPageSize is the page size used when fetching raw results.
+const probeChartPageSize = 100
+
+// ProbeChartPoint is the average response time of one time bucket.
+type ProbeChartPoint struct {
+	Time          time.Time `json:"time" yaml:"time"`
+	AvgResponseMs float64   `json:"avg_response_time_ms" yaml:"avg_response_time_ms"`
+}
+
+// ProbeChartSeries is a response time series with summary annotations.
+type ProbeChartSeries struct {
+	Region    string            `json:"region,omitempty" yaml:"region,omitempty"`
+	MinMs     float64           `json:"min_response_time_ms" yaml:"min_response_time_ms"`
+	AvgMs     float64           `json:"avg_response_time_ms" yaml:"avg_response_time_ms"`
+	P95Ms     float64           `json:"p95_response_time_ms" yaml:"p95_response_time_ms"`
+	MaxMs     float64           `json:"max_response_time_ms" yaml:"max_response_time_ms"`
+	Truncated bool              `json:"truncated,omitempty" yaml:"truncated,omitempty"`
+	Points    []ProbeChartPoint `json:"points" yaml:"points"`
+	values    []float64         // per-bucket averages, NaN for empty buckets
+}
+
+// ProbeChartOutput is the data behind a --chart rendering.
+type ProbeChartOutput struct {
+	ProbeID   uuid.UUID          `json:"probe_id" yaml:"probe_id"`
+	ProbeName string             `json:"probe_name" yaml:"probe_name"`
+	Period    string             `json:"period" yaml:"period"`
+	Aggregate string             `json:"aggregate" yaml:"aggregate"`
+	From      time.Time          `json:"from" yaml:"from"`
+	To        time.Time          `json:"to" yaml:"to"`
+	Overall   ProbeChartSeries   `json:"overall" yaml:"overall"`
+	Regions   []ProbeChartSeries `json:"regions" yaml:"regions"`
+}
+
+// probeChartRequest describes the chart to build.
+type probeChartRequest struct {
+	probeID   uuid.UUID
+	period    string // label shown in the title, e.g. "24h"
+	aggregate string // bucket size passed to the API: "1h" or "1d"
+	from, to  time.Time
+	region    string // only chart this region when set
+}
+
+// runProbeChart fetches and prints a response time chart. JSON and YAML
+// output print the chart data instead of the rendering.
+func runProbeChart(ctx context.Context, apiClient *client.Client, req probeChartRequest) error {
+	chart, err := buildProbeChart(ctx, apiClient, req)
+	if err != nil {
+		return err
+	}
+
+	format := output.NewPrinter(GetConfig()).Format()
+	if format == sdkoutput.FormatJSON || format == sdkoutput.FormatYAML {
+		return output.Print(chart)
+	}
+
+	if len(chart.Overall.Points) == 0 && len(chart.Regions) == 0 {
+		return output.PrintEmpty("No response time data available for this probe in the specified period")
+	}
+
+	renderProbeChart(os.Stdout, chart)
+	return nil
+}
+
+// buildProbeChart fetches aggregated buckets for the overall chart and raw
+// results for the per-region sparklines.
+func buildProbeChart(ctx context.Context, apiClient *client.Client, req probeChartRequest) (*ProbeChartOutput, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, probeStatsTimeout)
+	defer cancel()
+
+	probe, err := client.GetProbe(reqCtx, apiClient, req.probeID, "24h")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get probe: %w", err)
+	}
+
+	aggregated, err := client.GetAggregatedProbeResults(reqCtx, apiClient, req.probeID, req.aggregate, req.from, req.to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get probe statistics: %w", err)
+	}
+
+	bucket := aggregateBucketSize(req.aggregate)
+	buckets := bucketCount(req.from, req.to, bucket)
+
+	chart := &ProbeChartOutput{
+		ProbeID:   req.probeID,
+		ProbeName: probe.Name,
+		Period:    req.period,
+		Aggregate: req.aggregate,
+		From:      req.from,
+		To:        req.to,
+		Overall:   seriesFromAggregated(aggregated.Results, req.from, bucket, buckets),
+	}
+
+	regions := probe.Regions
+	if req.region != "" {
+		regions = []string{req.region}
+	}
+
+	series := make([]ProbeChartSeries, len(regions))
+	errs := make([]error, len(regions))
+	var wg sync.WaitGroup
+	for i, region := range regions {
+		wg.Add(1)
+		go func(i int, region string) {
+			defer wg.Done()
+			results, truncated, err := fetchRegionResults(ctx, apiClient, req.probeID, region, req.from, req.to)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			series[i] = seriesFromResults(region, results, req.from, bucket, buckets)
+			series[i].Truncated = truncated
+		}(i, region)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: region %s: %v\n", regions[i], err)
+			continue
+		}
+		if len(series[i].Points) > 0 {
+			chart.Regions = append(chart.Regions, series[i])
+		}
+	}
+	sort.Slice(chart.Regions, func(i, j int) bool {
+		return chart.Regions[i].Region < chart.Regions[j].Region
+	})
+
+	return chart, nil
+}
+
+// fetchRegionResults fetches raw results for one region, newest first, up to
+// probeChartMaxRegionResults. It reports whether the cap was reached.
+func fetchRegionResults(ctx context.Context, apiClient *client.Client, probeID uuid.UUID, region string, from, to time.Time) ([]client.ProbeResult, bool, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, probeStatsTimeout)
+	defer cancel()
+
+	var all []client.ProbeResult
+	for page := 1; len(all) < probeChartMaxRegionResults; page++ {
+		results, err := client.GetProbeResults(reqCtx, apiClient, probeID, &client.ListProbeResultsOptions{
+			Page:   page,
+			Limit:  probeChartPageSize,
+			Region: region,
+			From:   from,
+			To:     to,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get probe results: %w", err)
+		}
+		all = append(all, results.Results...)
+		if len(results.Results) < probeChartPageSize {
+			return all, false, nil
+		}
+	}
+	return all, true, nil
+}
+
+// aggregateBucketSize converts an aggregate interval to a duration.
+func aggregateBucketSize(aggregate string) time.Duration {
+	if aggregate == "1d" {
+		return 24 * time.Hour
+	}
+	return time.Hour
+}
+
+// bucketCount returns the number of buckets covering from..to.
+func bucketCount(from, to time.Time, bucket time.Duration) int {
+	n := int(math.Ceil(float64(to.Sub(from)) / float64(bucket)))
+	return max(n, 1)
+}
+
+// bucketIndex returns the bucket a timestamp falls into, clamped to the range.
+func bucketIndex(t, from time.Time, bucket time.Duration, buckets int) int {
+	idx := int(t.Sub(from) / bucket)
+	return max(0, min(buckets-1, idx))
+}
+
+// nanSeries returns n NaN values.
+func nanSeries(n int) []float64 {
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = math.NaN()
+	}
+	return values
+}
+
+// seriesFromAggregated builds the overall series from API buckets. Min and
+// max come from the buckets' extremes; avg and p95 are weighted by checks.
+func seriesFromAggregated(results []client.AggregatedResult, from time.Time, bucket time.Duration, buckets int) ProbeChartSeries {
+	s := ProbeChartSeries{values: nanSeries(buckets)}
+	sums := make([]float64, buckets)
+	counts := make([]int64, buckets)
+
+	var (
+		total    int64
+		sum      float64
+		weighted []weightedValue
+	)
+	s.MinMs = math.Inf(1)
+
+	for _, r := range results {
+		if r.TotalChecks == 0 {
+			continue
+		}
+		idx := bucketIndex(r.TimeBucket, from, bucket, buckets)
+		sums[idx] += r.AvgResponseMs * float64(r.TotalChecks)
+		counts[idx] += r.TotalChecks
+
+		total += r.TotalChecks
+		sum += r.AvgResponseMs * float64(r.TotalChecks)
+		weighted = append(weighted, weightedValue{value: r.AvgResponseMs, weight: r.TotalChecks})
+		s.MinMs = math.Min(s.MinMs, float64(r.MinResponseMs))
+		s.MaxMs = math.Max(s.MaxMs, float64(r.MaxResponseMs))
+	}
+
+	if total == 0 {
+		s.MinMs = 0
+		return s
+	}
+
+	for i := range sums {
+		if counts[i] > 0 {
+			s.values[i] = sums[i] / float64(counts[i])
+			s.Points = append(s.Points, ProbeChartPoint{Time: from.Add(time.Duration(i) * bucket), AvgResponseMs: s.values[i]})
+		}
+	}
+	s.AvgMs = sum / float64(total)
+	s.P95Ms, _ = calculateWeightedPercentiles(weighted, total)
+	return s
+}
+
+// seriesFromResults builds a region series by bucketing raw results.
+func seriesFromResults(region string, results []client.ProbeResult, from time.Time, bucket time.Duration, buckets int) ProbeChartSeries {
+	s := ProbeChartSeries{Region: region, values: nanSeries(buckets)}
+	if len(results) == 0 {
+		return s
+	}
+
+	sums := make([]float64, buckets)
+	counts := make([]int, buckets)
+	times := make([]float64, 0, len(results))
+
+	for _, r := range results {
+		ms := float64(r.ResponseTimeMs)
+		idx := bucketIndex(r.CheckedAt, from, bucket, buckets)
+		sums[idx] += ms
+		counts[idx]++
+		times = append(times, ms)
+	}
+
+	for i := range sums {
+		if counts[i] > 0 {
+			s.values[i] = sums[i] / float64(counts[i])
+			s.Points = append(s.Points, ProbeChartPoint{Time: from.Add(time.Duration(i) * bucket), AvgResponseMs: s.values[i]})
+		}
+	}
+
+	sort.Float64s(times)
+	total := 0.0
+	for _, t := range times {
+		total += t
+	}
+	s.MinMs = times[0]
+	s.MaxMs = times[len(times)-1]
+	s.AvgMs = total / float64(len(times))
+	s.P95Ms, _ = calculatePercentiles(times)
+	return s
+}
+
+// renderProbeChart writes the braille chart of the overall series followed by
+// one sparkline per region.
+func renderProbeChart(w io.Writer, chart *ProbeChartOutput) {
+	fmt.Fprintf(w, "Response time: %s, last %s (%s buckets)\n\n", chart.ProbeName, chart.Period, chart.Aggregate)
+
+	overall := chart.Overall
+	if len(overall.Points) > 0 {
+		lo, hi, _ := output.SeriesRange(overall.values)
+		hiLabel, loLabel := formatChartMs(hi), formatChartMs(lo)
+		labelWidth := max(len(hiLabel), len(loLabel))
+
+		for i, line := range output.BrailleChart(overall.values, probeChartWidth, probeChartHeight, lo, hi) {
+			label := ""
+			switch i {
+			case 0:
+				label = hiLabel
+			case probeChartHeight - 1:
+				label = loLabel
+			}
+			fmt.Fprintf(w, "%*s ┤%s\n", labelWidth, label, line)
+		}
+		fmt.Fprintf(w, "%*s └%s\n", labelWidth, "", strings.Repeat("─", probeChartWidth))
+
+		fromLabel := chart.From.Local().Format("Jan 02 15:04")
+		toLabel := chart.To.Local().Format("Jan 02 15:04")
+		gap := max(1, probeChartWidth-len(fromLabel)-len(toLabel))
+		fmt.Fprintf(w, "%*s  %s%s%s\n\n", labelWidth, "", fromLabel, strings.Repeat(" ", gap), toLabel)
+
+		fmt.Fprintf(w, "All regions  %s\n", formatChartSummary(overall))
+	}
+
+	if len(chart.Regions) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w)
+	regionWidth := len("REGION")
+	for _, r := range chart.Regions {
+		regionWidth = max(regionWidth, len(r.Region))
+	}
+	sparkWidth := min(probeChartWidth, len(chart.Overall.values))
+	if sparkWidth == 0 {
+		sparkWidth = probeChartWidth
+	}
+
+	fmt.Fprintf(w, "%-*s  %-*s  %s\n", regionWidth, "REGION", sparkWidth, "TREND", "SUMMARY")
+	for _, r := range chart.Regions {
+		spark := output.Sparkline(output.ResampleSeries(r.values, sparkWidth))
+		summary := formatChartSummary(r)
+		if r.Truncated {
+			summary += fmt.Sprintf("  (latest %d checks)", probeChartMaxRegionResults)
+		}
+		fmt.Fprintf(w, "%-*s  %s  %s\n", regionWidth, r.Region, spark, summary)
+	}
+}
+
+// formatChartSummary formats the min/avg/p95/max annotation for a series.
+func formatChartSummary(s ProbeChartSeries) string {
+	return fmt.Sprintf("min %s  avg %s  p95 %s  max %s",
+		formatChartMs(s.MinMs), formatChartMs(s.AvgMs), formatChartMs(s.P95Ms), formatChartMs(s.MaxMs))
+}
+
+// formatChartMs formats a response time in milliseconds, switching to
+// seconds above 10s.
+func formatChartMs(ms float64) string {
+	if ms >= 10000 {
+		return fmt.Sprintf("%.1fs", ms/1000)
+	}
+	return fmt.Sprintf("%.0fms", ms)
+}
@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+func TestProbeChartFlag(t *testing.T) {
+	for _, cmd := range []*cobra.Command{NewProbeStatsCmd(), NewProbeHistoryCmd()} {
+		flag := cmd.Flags().Lookup("chart")
+		if flag == nil {
+			t.Errorf("%s: expected 'chart' flag to be defined", cmd.Name())
+			continue
+		}
+		if flag.DefValue != "false" {
+			t.Errorf("%s: expected chart default false, got %q", cmd.Name(), flag.DefValue)
+		}
+	}
+}
+
+func TestProbeHistoryChartRequest(t *testing.T) {
+	now := time.Date(2026, 5, 10, 12, 0, 0, 0, time.UTC)
+	id := uuid.New()
+
+	tests := []struct {
+		since         string
+		wantPeriod    string
+		wantAggregate string
+		wantWindow    time.Duration
+	}{
+		{"", "24h", "1h", 24 * time.Hour},
+		{"3d", "3d", "1h", 72 * time.Hour},
+		{"7d", "7d", "1h", 7 * 24 * time.Hour},
+		{"30d", "30d", "1d", 30 * 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		req := probeHistoryChartRequest(id, &probeHistoryFlags{since: tt.since, region: "nyc3"}, now)
+		if req.period != tt.wantPeriod || req.aggregate != tt.wantAggregate {
+			t.Errorf("since %q: expected %s/%s, got %s/%s", tt.since, tt.wantPeriod, tt.wantAggregate, req.period, req.aggregate)
+		}
+		if got := req.to.Sub(req.from); got != tt.wantWindow {
+			t.Errorf("since %q: expected window %v, got %v", tt.since, tt.wantWindow, got)
+		}
+		if req.region != "nyc3" || req.probeID != id {
+			t.Errorf("since %q: expected probe and region to be carried over, got %+v", tt.since, req)
+		}
+	}
+}
+
+func TestBucketIndex(t *testing.T) {
+	from := time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		at   time.Time
+		want int
+	}{
+		{from, 0},
+		{from.Add(90 * time.Minute), 1},
+		{from.Add(-time.Hour), 0},
+		{from.Add(48 * time.Hour), 23},
+	}
+	for _, tt := range tests {
+		if got := bucketIndex(tt.at, from, time.Hour, 24); got != tt.want {
+			t.Errorf("bucketIndex(%v): expected %d, got %d", tt.at, tt.want, got)
+		}
+	}
+
+	if got := bucketCount(from, from.Add(90*time.Minute), time.Hour); got != 2 {
+		t.Errorf("expected 2 buckets for 90m, got %d", got)
+	}
+}
+
+func TestSeriesFromAggregated(t *testing.T) {
+	from := time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)
+	results := []client.AggregatedResult{
+		{TimeBucket: from, TotalChecks: 10, AvgResponseMs: 100, MinResponseMs: 80, MaxResponseMs: 150},
+		{TimeBucket: from.Add(2 * time.Hour), TotalChecks: 30, AvgResponseMs: 200, MinResponseMs: 120, MaxResponseMs: 400},
+		{TimeBucket: from.Add(3 * time.Hour), TotalChecks: 0},
+	}
+
+	s := seriesFromAggregated(results, from, time.Hour, 4)
+
+	if len(s.Points) != 2 {
+		t.Fatalf("expected 2 points, got %d", len(s.Points))
+	}
+	if s.MinMs != 80 || s.MaxMs != 400 {
+		t.Errorf("expected min 80 / max 400, got %v / %v", s.MinMs, s.MaxMs)
+	}
+	if s.AvgMs != 175 {
+		t.Errorf("expected weighted avg 175, got %v", s.AvgMs)
+	}
+	if s.P95Ms != 200 {
+		t.Errorf("expected p95 200, got %v", s.P95Ms)
+	}
+	if !math.IsNaN(s.values[1]) || !math.IsNaN(s.values[3]) {
+		t.Errorf("expected empty buckets to be NaN, got %v", s.values)
+	}
+}
+
+func TestSeriesFromResults(t *testing.T) {
+	from := time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)
+	var results []client.ProbeResult
+	for i := 1; i <= 20; i++ {
+		results = append(results, client.ProbeResult{
+			CheckedAt:      from.Add(time.Duration(i) * 5 * time.Minute),
+			ResponseTimeMs: i * 10,
+		})
+	}
+
+	s := seriesFromResults("nyc3", results, from, time.Hour, 2)
+
+	if s.Region != "nyc3" {
+		t.Errorf("expected region nyc3, got %q", s.Region)
+	}
+	if s.MinMs != 10 || s.MaxMs != 200 {
+		t.Errorf("expected min 10 / max 200, got %v / %v", s.MinMs, s.MaxMs)
+	}
+	if s.AvgMs != 105 {
+		t.Errorf("expected avg 105, got %v", s.AvgMs)
+	}
+	if len(s.Points) != 2 {
+		t.Errorf("expected 2 points, got %d", len(s.Points))
+	}
+}
+
+func TestSeriesFromResults_Empty(t *testing.T) {
+	s := seriesFromResults("nyc3", nil, time.Now(), time.Hour, 24)
+	if len(s.Points) != 0 || s.AvgMs != 0 {
+		t.Errorf("expected empty series, got %+v", s)
+	}
+}
+
+func TestRenderProbeChart(t *testing.T) {
+	from := time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)
+	overall := seriesFromAggregated([]client.AggregatedResult{
+		{TimeBucket: from, TotalChecks: 10, AvgResponseMs: 100, MinResponseMs: 80, MaxResponseMs: 150},
+		{TimeBucket: from.Add(time.Hour), TotalChecks: 10, AvgResponseMs: 300, MinResponseMs: 200, MaxResponseMs: 450},
+	}, from, time.Hour, 2)
+	region := seriesFromResults("fra1", []client.ProbeResult{
+		{CheckedAt: from, ResponseTimeMs: 90},
+		{CheckedAt: from.Add(time.Hour), ResponseTimeMs: 310},
+	}, from, time.Hour, 2)
+	region.Truncated = true
+
+	var buf bytes.Buffer
+	renderProbeChart(&buf, &ProbeChartOutput{
+		ProbeName: "Production API",
+		Period:    "24h",
+		Aggregate: "1h",
+		From:      from,
+		To:        from.Add(2 * time.Hour),
+		Overall:   overall,
+		Regions:   []ProbeChartSeries{region},
+	})
+	out := buf.String()
+
+	for _, want := range []string{
+		"Response time: Production API, last 24h (1h buckets)",
+		"300ms ┤",
+		"100ms ┤",
+		"All regions  min 80ms  avg 200ms",
+		"REGION",
+		"fra1",
+		"(latest 1000 checks)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output:\n%s", want, out)
+		}
+	}
+
+	if lines := strings.Count(out, "┤"); lines != probeChartHeight {
+		t.Errorf("expected %d chart lines, got %d", probeChartHeight, lines)
+	}
+}
+
+func TestFormatChartMs(t *testing.T) {
+	tests := []struct {
+		ms   float64
+		want string
+	}{
+		{0, "0ms"},
+		{123.4, "123ms"},
+		{9999, "9999ms"},
+		{12345, "12.3s"},
+	}
+	for _, tt := range tests {
+		if got := formatChartMs(tt.ms); got != tt.want {
+			t.Errorf("formatChartMs(%v): expected %q, got %q", tt.ms, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	clierrors "github.com/StackEye-IO/stackeye-cli/internal/errors"
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-cli/internal/probecheck"
+	"github.com/spf13/cobra"
+)
+
+// probeCheckFlags holds the flag values for the probe check command.
+type probeCheckFlags struct {
+	file string
+
+	name           string
+	url            string
+	checkType      string
+	method         string
+	timeoutSeconds int
+
+	headers             string
+	body                string
+	expectedStatusCodes string
+	followRedirects     bool
+	maxRedirects        int
+
+	keywordCheck     string
+	keywordCheckType string
+	jsonPathCheck    string
+	jsonPathExpected string
+
+	sslCheckEnabled        bool
+	sslExpiryThresholdDays int
+}
+
+// NewProbeCheckCmd creates and returns the probe check subcommand.
+func NewProbeCheckCmd() *cobra.Command {
+	flags := &probeCheckFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Run a check from this machine without creating a probe",
+		Long: `Run a probe check from this machine without creating a probe.
+
+The check runs locally, not from StackEye's monitoring regions, so it can reach
+internal and local services. It applies the same rules as a probe: expected
+status codes, keyword contains/not_contains, JSONPath expected value, redirect
+limits and the SSL expiry threshold. No API key is needed.
+
+Define the check with the same flags as "probe create", or check every probe
+definition in a file produced by "probe export" with --file.
+
+Check Types:
+  http         HTTP/HTTPS endpoint (default)
+  tcp          TCP port connectivity (--url host:port)
+  dns_resolve  DNS resolution check (--url host)
+  ping         ICMP ping using the system ping command (--url host)
+
+Exit Codes:
+  0  All checks passed (status up or degraded)
+  1  At least one check failed (status down)
+
+Examples:
+  # Check a local health endpoint
+  stackeye probe check --url http://localhost:8080/health
+
+  # Validate content before creating the probe
+  stackeye probe check --url https://api.internal/status \
+    --keyword-check '"status":"healthy"' --json-path-check '$.version' \
+    --json-path-expected 1.2.0
+
+  # Check a TCP port
+  stackeye probe check --check-type tcp --url db.internal:5432
+
+  # Check every definition in a file before importing it
+  stackeye probe check -f probes.yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runProbeCheck(cmd.Context(), flags)
+		},
+	}
+
+	cmd.Flags().StringVarP(&flags.file, "file", "f", "", "check every probe definition in a YAML or JSON file")
+
+	cmd.Flags().StringVar(&flags.name, "name", "", "name to show in the result")
+	cmd.Flags().StringVar(&flags.url, "url", "", "target URL or host to check")
+	cmd.Flags().StringVar(&flags.checkType, "check-type", "http", "check type: http, ping, tcp, dns_resolve")
+	cmd.Flags().StringVar(&flags.method, "method", "GET", "HTTP method: GET, POST, PUT, DELETE, PATCH, HEAD, OPTIONS")
+	cmd.Flags().IntVar(&flags.timeoutSeconds, "timeout", 10, "request timeout in seconds (1-60)")
+
+	cmd.Flags().StringVar(&flags.headers, "headers", "", "custom headers as JSON object")
+	cmd.Flags().StringVar(&flags.body, "body", "", "request body for POST/PUT methods")
+	cmd.Flags().StringVar(&flags.expectedStatusCodes, "expected-status-codes", "200", "expected HTTP status codes (comma-separated)")
+	cmd.Flags().BoolVar(&flags.followRedirects, "follow-redirects", true, "follow HTTP redirects")
+	cmd.Flags().IntVar(&flags.maxRedirects, "max-redirects", 10, "maximum redirects to follow")
+
+	cmd.Flags().StringVar(&flags.keywordCheck, "keyword-check", "", "keyword to search for in response")
+	cmd.Flags().StringVar(&flags.keywordCheckType, "keyword-check-type", "contains", "keyword check type: contains, not_contains")
+	cmd.Flags().StringVar(&flags.jsonPathCheck, "json-path-check", "", "JSONPath expression to evaluate")
+	cmd.Flags().StringVar(&flags.jsonPathExpected, "json-path-expected", "", "expected value from JSONPath")
+
+	cmd.Flags().BoolVar(&flags.sslCheckEnabled, "ssl-check-enabled", true, "check the SSL certificate expiry")
+	cmd.Flags().IntVar(&flags.sslExpiryThresholdDays, "ssl-expiry-threshold-days", 14, "report degraded when SSL expires within N days")
+
+	cmd.MarkFlagsMutuallyExclusive("file", "url")
+
+	return cmd
+}
+
+// runProbeCheck executes the probe check command logic.
+func runProbeCheck(ctx context.Context, flags *probeCheckFlags) error {
+	var configs []probeExportConfig
+	if flags.file != "" {
+		format, err := resolveImportFormat(flags.file, "")
+		if err != nil {
+			return err
+		}
+		configs, err = readProbeConfigs(flags.file, format)
+		if err != nil {
+			return err
+		}
+		if len(configs) == 0 {
+			return fmt.Errorf("file %q contains no probe definitions", flags.file)
+		}
+		if err := validateProbeConfigs(configs); err != nil {
+			return fmt.Errorf("validation failed: %w", err)
+		}
+	} else {
+		cfg, err := buildProbeCheckConfig(flags)
+		if err != nil {
+			return err
+		}
+		configs = []probeExportConfig{*cfg}
+	}
+
+	results := make([]ProbeTestResult, 0, len(configs))
+	failed := 0
+	for i := range configs {
+		result := runLocalProbeCheck(ctx, &configs[i])
+		if result.Status == probecheck.StatusDown {
+			failed++
+		}
+		results = append(results, result)
+	}
+
+	var err error
+	if len(results) == 1 {
+		err = output.Print(&results[0])
+	} else {
+		err = output.Print(results)
+	}
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return clierrors.WithExitCode(clierrors.ExitError, nil)
+	}
+	return nil
+}
+
+// buildProbeCheckConfig validates the check flags and converts them to the
+// probe definition format shared with "probe export".
+func buildProbeCheckConfig(flags *probeCheckFlags) (*probeExportConfig, error) {
+	if flags.url == "" {
+		return nil, fmt.Errorf("either --url or --file is required")
+	}
+	if err := validateCheckType(flags.checkType); err != nil {
+		return nil, err
+	}
+	if flags.checkType == "http" {
+		if err := validateProbeURL(flags.url); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateHTTPMethod(flags.method); err != nil {
+		return nil, err
+	}
+	if flags.timeoutSeconds < 1 || flags.timeoutSeconds > 60 {
+		return nil, fmt.Errorf("--timeout must be between 1 and 60 seconds, got %d", flags.timeoutSeconds)
+	}
+	if flags.keywordCheck != "" {
+		if err := validateKeywordCheckType(flags.keywordCheckType); err != nil {
+			return nil, err
+		}
+	}
+	if flags.jsonPathCheck != "" {
+		if _, err := probecheck.ParseJSONPath(flags.jsonPathCheck); err != nil {
+			return nil, fmt.Errorf("invalid --json-path-check: %w", err)
+		}
+	}
+
+	expectedCodes, err := parseStatusCodes(flags.expectedStatusCodes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --expected-status-codes: %w", err)
+	}
+
+	cfg := &probeExportConfig{
+		Name:                   flags.name,
+		URL:                    flags.url,
+		CheckType:              flags.checkType,
+		Method:                 strings.ToUpper(flags.method),
+		TimeoutMs:              flags.timeoutSeconds * 1000,
+		ExpectedStatusCodes:    expectedCodes,
+		FollowRedirects:        flags.followRedirects,
+		MaxRedirects:           flags.maxRedirects,
+		SSLCheckEnabled:        flags.sslCheckEnabled,
+		SSLExpiryThresholdDays: flags.sslExpiryThresholdDays,
+	}
+	if cfg.Name == "" {
+		cfg.Name = flags.url
+	}
+
+	if flags.headers != "" {
+		if err := json.Unmarshal([]byte(flags.headers), &cfg.Headers); err != nil {
+			return nil, fmt.Errorf("invalid --headers: must be a JSON object of strings: %w", err)
+		}
+	}
+	if flags.body != "" {
+		cfg.Body = &flags.body
+	}
+	if flags.keywordCheck != "" {
+		cfg.KeywordCheck = &flags.keywordCheck
+		cfg.KeywordCheckType = &flags.keywordCheckType
+	}
+	if flags.jsonPathCheck != "" {
+		cfg.JSONPathCheck = &flags.jsonPathCheck
+		if flags.jsonPathExpected != "" {
+			cfg.JSONPathExpected = &flags.jsonPathExpected
+		}
+	}
+
+	return cfg, nil
+}
+
+// localCheckConfig converts a probe definition to a local check configuration.
+func localCheckConfig(cfg *probeExportConfig) probecheck.Config {
+	check := probecheck.Config{
+		CheckType:              cfg.CheckType,
+		URL:                    cfg.URL,
+		Method:                 cfg.Method,
+		Headers:                cfg.Headers,
+		Timeout:                time.Duration(cfg.TimeoutMs) * time.Millisecond,
+		ExpectedStatusCodes:    cfg.ExpectedStatusCodes,
+		JSONPathExpected:       cfg.JSONPathExpected,
+		FollowRedirects:        cfg.FollowRedirects,
+		MaxRedirects:           cfg.MaxRedirects,
+		SSLCheckEnabled:        cfg.SSLCheckEnabled,
+		SSLExpiryThresholdDays: cfg.SSLExpiryThresholdDays,
+	}
+	if cfg.Body != nil {
+		check.Body = *cfg.Body
+	}
+	if cfg.KeywordCheck != nil {
+		check.KeywordCheck = *cfg.KeywordCheck
+	}
+	if cfg.KeywordCheckType != nil {
+		check.KeywordCheckType = *cfg.KeywordCheckType
+	}
+	if cfg.JSONPathCheck != nil {
+		check.JSONPathCheck = *cfg.JSONPathCheck
+	}
+	return check
+}
+
+// runLocalProbeCheck runs a probe definition from this machine and returns
+// the result in the same shape as "probe test".
+func runLocalProbeCheck(ctx context.Context, cfg *probeExportConfig) ProbeTestResult {
+	result := probecheck.Run(ctx, localCheckConfig(cfg))
+	return ProbeTestResult{
+		ProbeName:      cfg.Name,
+		ProbeURL:       cfg.URL,
+		Status:         result.Status,
+		ResponseTimeMs: result.ResponseTimeMs,
+		StatusCode:     result.StatusCode,
+		ErrorMessage:   result.ErrorMessage,
+		SSLExpiryDays:  result.SSLExpiryDays,
+		CheckedAt:      result.CheckedAt,
+	}
+}
@@ -0,0 +1,274 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewProbeCheckCmd(t *testing.T) {
+	cmd := NewProbeCheckCmd()
+
+	if cmd.Use != "check" {
+		t.Errorf("Use = %q, want %q", cmd.Use, "check")
+	}
+	if cmd.Short == "" {
+		t.Error("Short description should not be empty")
+	}
+
+	tests := []struct {
+		name      string
+		shorthand string
+		defValue  string
+	}{
+		{"file", "f", ""},
+		{"url", "", ""},
+		{"check-type", "", "http"},
+		{"method", "", "GET"},
+		{"timeout", "", "10"},
+		{"expected-status-codes", "", "200"},
+		{"follow-redirects", "", "true"},
+		{"max-redirects", "", "10"},
+		{"keyword-check-type", "", "contains"},
+		{"ssl-check-enabled", "", "true"},
+		{"ssl-expiry-threshold-days", "", "14"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flag := cmd.Flags().Lookup(tt.name)
+			if flag == nil {
+				t.Fatalf("flag --%s not found", tt.name)
+			}
+			if flag.Shorthand != tt.shorthand {
+				t.Errorf("--%s shorthand = %q, want %q", tt.name, flag.Shorthand, tt.shorthand)
+			}
+			if flag.DefValue != tt.defValue {
+				t.Errorf("--%s default = %q, want %q", tt.name, flag.DefValue, tt.defValue)
+			}
+		})
+	}
+}
+
+func TestProbeTestCmd_LocalFlag(t *testing.T) {
+	cmd := NewProbeTestCmd()
+
+	flag := cmd.Flags().Lookup("local")
+	if flag == nil {
+		t.Fatal("expected --local flag to exist")
+	}
+	if flag.DefValue != "false" {
+		t.Errorf("--local default = %q, want %q", flag.DefValue, "false")
+	}
+}
+
+func defaultProbeCheckFlags() *probeCheckFlags {
+	return &probeCheckFlags{
+		url:                    "https://api.example.com/health",
+		checkType:              "http",
+		method:                 "GET",
+		timeoutSeconds:         10,
+		expectedStatusCodes:    "200",
+		followRedirects:        true,
+		maxRedirects:           10,
+		keywordCheckType:       "contains",
+		sslCheckEnabled:        true,
+		sslExpiryThresholdDays: 14,
+	}
+}
+
+func TestBuildProbeCheckConfig(t *testing.T) {
+	flags := defaultProbeCheckFlags()
+	flags.method = "post"
+	flags.headers = `{"Authorization":"Bearer x"}`
+	flags.body = `{"ping":true}`
+	flags.expectedStatusCodes = "200,204"
+	flags.keywordCheck = "ok"
+	flags.jsonPathCheck = "$.status"
+	flags.jsonPathExpected = "healthy"
+
+	cfg, err := buildProbeCheckConfig(flags)
+	if err != nil {
+		t.Fatalf("buildProbeCheckConfig() error: %v", err)
+	}
+
+	if cfg.Name != flags.url {
+		t.Errorf("Name = %q, want URL when --name is not set", cfg.Name)
+	}
+	if cfg.Method != "POST" {
+		t.Errorf("Method = %q, want POST", cfg.Method)
+	}
+	if cfg.TimeoutMs != 10000 {
+		t.Errorf("TimeoutMs = %d, want 10000", cfg.TimeoutMs)
+	}
+	if len(cfg.ExpectedStatusCodes) != 2 || cfg.ExpectedStatusCodes[1] != 204 {
+		t.Errorf("ExpectedStatusCodes = %v, want [200 204]", cfg.ExpectedStatusCodes)
+	}
+	if cfg.Headers["Authorization"] != "Bearer x" {
+		t.Errorf("Headers = %v", cfg.Headers)
+	}
+	if cfg.Body == nil || *cfg.Body != `{"ping":true}` {
+		t.Errorf("Body = %v", cfg.Body)
+	}
+	if cfg.KeywordCheck == nil || *cfg.KeywordCheckType != "contains" {
+		t.Errorf("keyword check not set: %v %v", cfg.KeywordCheck, cfg.KeywordCheckType)
+	}
+	if cfg.JSONPathExpected == nil || *cfg.JSONPathExpected != "healthy" {
+		t.Errorf("JSONPathExpected = %v", cfg.JSONPathExpected)
+	}
+}
+
+func TestBuildProbeCheckConfig_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(f *probeCheckFlags)
+		wantErr string
+	}{
+		{"missing url", func(f *probeCheckFlags) { f.url = "" }, "--url or --file"},
+		{"invalid check type", func(f *probeCheckFlags) { f.checkType = "smtp" }, "check-type"},
+		{"http url without scheme", func(f *probeCheckFlags) { f.url = "localhost:8080" }, "scheme"},
+		{"invalid method", func(f *probeCheckFlags) { f.method = "FETCH" }, "method"},
+		{"timeout out of range", func(f *probeCheckFlags) { f.timeoutSeconds = 0 }, "--timeout"},
+		{"invalid keyword type", func(f *probeCheckFlags) { f.keywordCheck = "ok"; f.keywordCheckType = "equals" }, "keyword-check-type"},
+		{"invalid json path", func(f *probeCheckFlags) { f.jsonPathCheck = "status" }, "--json-path-check"},
+		{"invalid status codes", func(f *probeCheckFlags) { f.expectedStatusCodes = "abc" }, "--expected-status-codes"},
+		{"invalid headers", func(f *probeCheckFlags) { f.headers = "Authorization: x" }, "--headers"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := defaultProbeCheckFlags()
+			tt.modify(flags)
+
+			_, err := buildProbeCheckConfig(flags)
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBuildProbeCheckConfig_TCPAllowsHostPort(t *testing.T) {
+	flags := defaultProbeCheckFlags()
+	flags.checkType = "tcp"
+	flags.url = "db.internal:5432"
+
+	if _, err := buildProbeCheckConfig(flags); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestLocalCheckConfig(t *testing.T) {
+	keyword := "ok"
+	keywordType := "not_contains"
+	jsonPath := "$.status"
+	body := "{}"
+	cfg := &probeExportConfig{
+		URL:                    "https://example.com",
+		CheckType:              "http",
+		Method:                 "POST",
+		Body:                   &body,
+		TimeoutMs:              5000,
+		KeywordCheck:           &keyword,
+		KeywordCheckType:       &keywordType,
+		JSONPathCheck:          &jsonPath,
+		FollowRedirects:        true,
+		MaxRedirects:           3,
+		SSLCheckEnabled:        true,
+		SSLExpiryThresholdDays: 30,
+	}
+
+	check := localCheckConfig(cfg)
+
+	if check.Timeout != 5*time.Second {
+		t.Errorf("Timeout = %v, want 5s", check.Timeout)
+	}
+	if check.Body != "{}" || check.KeywordCheck != "ok" || check.KeywordCheckType != "not_contains" || check.JSONPathCheck != "$.status" {
+		t.Errorf("optional fields not copied: %+v", check)
+	}
+	if check.JSONPathExpected != nil {
+		t.Errorf("JSONPathExpected = %v, want nil", check.JSONPathExpected)
+	}
+	if !check.FollowRedirects || check.MaxRedirects != 3 || check.SSLExpiryThresholdDays != 30 {
+		t.Errorf("redirect/SSL fields not copied: %+v", check)
+	}
+}
+
+func TestRunLocalProbeCheck(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"healthy"}`))
+	}))
+	defer srv.Close()
+
+	expected := "healthy"
+	path := "$.status"
+	cfg := &probeExportConfig{
+		Name:             "Local API",
+		URL:              srv.URL,
+		CheckType:        "http",
+		JSONPathCheck:    &path,
+		JSONPathExpected: &expected,
+	}
+
+	result := runLocalProbeCheck(context.Background(), cfg)
+
+	if result.ProbeName != "Local API" || result.ProbeURL != srv.URL {
+		t.Errorf("probe metadata = %q %q", result.ProbeName, result.ProbeURL)
+	}
+	if result.Status != "up" {
+		t.Errorf("Status = %q, want up (error: %v)", result.Status, result.ErrorMessage)
+	}
+	if result.StatusCode == nil || *result.StatusCode != 200 {
+		t.Errorf("StatusCode = %v, want 200", result.StatusCode)
+	}
+
+	expected = "degraded"
+	result = runLocalProbeCheck(context.Background(), cfg)
+	if result.Status != "down" || result.ErrorMessage == nil {
+		t.Errorf("Status = %q, want down with an error message", result.Status)
+	}
+}
+
+func TestRunProbeCheck_FileErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	empty := filepath.Join(dir, "empty.yaml")
+	if err := os.WriteFile(empty, []byte("[]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	invalid := filepath.Join(dir, "invalid.yaml")
+	if err := os.WriteFile(invalid, []byte("- name: api\n  url: https://example.com\n  check_type: smtp\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		wantErr string
+	}{
+		{"unknown extension", filepath.Join(dir, "probes.txt"), "cannot detect format"},
+		{"missing file", filepath.Join(dir, "missing.yaml"), "failed to read file"},
+		{"no definitions", empty, "contains no probe definitions"},
+		{"invalid definition", invalid, "validation failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runProbeCheck(context.Background(), &probeCheckFlags{file: tt.file})
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
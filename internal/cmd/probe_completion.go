@@ -4,20 +4,13 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"strings"
-	"time"
 
-	"github.com/StackEye-IO/stackeye-cli/internal/api"
 	"github.com/StackEye-IO/stackeye-go-sdk/client"
-	"github.com/spf13/cobra"
 )
 
-// probeCompletionTimeout is the maximum time to wait for the API when fetching completions.
-// Keep this short to avoid slowing down tab completion.
-const probeCompletionTimeout = 5 * time.Second
-
 // ProbeCompletion returns a cobra.ValidArgsFunction that provides probe name/ID completions.
-// It fetches the probe list from the API and filters by the user's input prefix.
+// Probes are served from the shared completion cache, fetching every page from
+// the API when the cache is stale, and filtered by the user's input prefix.
 // On error (including offline), it returns an empty list to allow graceful degradation.
 //
 // Example usage:
@@ -26,61 +19,26 @@ const probeCompletionTimeout = 5 * time.Second
 //	    Use:               "get <id>",
 //	    ValidArgsFunction: ProbeCompletion(),
 //	}
-func ProbeCompletion() func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-		// Only complete the first positional argument (probe ID)
-		if len(args) >= 1 {
-			return nil, cobra.ShellCompDirectiveNoFileComp
-		}
-
-		// Try to get the API client
-		apiClient, err := api.GetClient()
-		if err != nil {
-			// Not authenticated or config error - return empty completions silently
-			return nil, cobra.ShellCompDirectiveNoFileComp
-		}
-
-		// Create a context with timeout for the API call
-		// Handle nil context (can happen during shell completion)
-		parentCtx := cmd.Context()
-		if parentCtx == nil {
-			parentCtx = context.Background()
-		}
-		ctx, cancel := context.WithTimeout(parentCtx, probeCompletionTimeout)
-		defer cancel()
-
-		// Fetch probes from the API
-		opts := &client.ListProbesOptions{
-			Limit: 100, // Reasonable limit for completions
-		}
-
-		// If user has typed something, use it as a search filter
-		if toComplete != "" {
-			opts.Search = toComplete
-		}
-
-		resp, err := client.ListProbes(ctx, apiClient, opts)
-		if err != nil {
-			// API error (offline, network issues, etc.) - return empty completions silently
-			return nil, cobra.ShellCompDirectiveNoFileComp
-		}
-
-		// Build completion list
-		var completions []string
-		for _, probe := range resp.Probes {
-			// Filter by prefix (case-insensitive)
-			loweredName := strings.ToLower(probe.Name)
-			loweredComplete := strings.ToLower(toComplete)
+func ProbeCompletion() completionFunc {
+	return resourceCompletion("probes", fetchProbeCandidates)
+}
 
-			if toComplete == "" || strings.HasPrefix(loweredName, loweredComplete) {
-				// Add probe name as completion with description
-				completion := fmt.Sprintf("%s\t%s (%s)", probe.Name, probe.CheckType, probe.Status)
-				completions = append(completions, completion)
-			}
-		}
+// fetchProbeCandidates lists every probe for completion, described by check
+// type and status.
+func fetchProbeCandidates(ctx context.Context, c *client.Client) ([]completionCandidate, error) {
+	probes, err := fetchAllProbesForExport(ctx, c, "", nil)
+	if err != nil {
+		return nil, err
+	}
 
-		return completions, cobra.ShellCompDirectiveNoFileComp
+	candidates := make([]completionCandidate, 0, len(probes))
+	for _, p := range probes {
+		candidates = append(candidates, completionCandidate{
+			Value:       p.Name,
+			Description: fmt.Sprintf("%s (%s)", p.CheckType, p.Status),
+		})
 	}
+	return candidates, nil
 }
 
 // ProbeIDCompletion is an alias for ProbeCompletion for clarity in command definitions.
@@ -39,6 +39,7 @@ func TestProbeCompletion_Success(t *testing.T) {
 
 	// Setup config with test server
 	setupTestConfigWithURL(t, server.URL)
+	setupTestCompletionCache(t)
 
 	// Create completion function
 	completionFunc := ProbeCompletion()
@@ -75,6 +76,7 @@ func TestProbeCompletion_FilterByPrefix(t *testing.T) {
 
 	// Setup config with test server
 	setupTestConfigWithURL(t, server.URL)
+	setupTestCompletionCache(t)
 
 	// Create completion function
 	completionFunc := ProbeCompletion()
@@ -118,6 +120,7 @@ func TestProbeCompletion_APIError_GracefulDegradation(t *testing.T) {
 
 	// Setup config with test server
 	setupTestConfigWithURL(t, server.URL)
+	setupTestCompletionCache(t)
 
 	// Create completion function
 	completionFunc := ProbeCompletion()
@@ -163,6 +166,7 @@ func TestProbeCompletion_EmptyResponse(t *testing.T) {
 
 	// Setup config with test server
 	setupTestConfigWithURL(t, server.URL)
+	setupTestCompletionCache(t)
 
 	// Create completion function
 	completionFunc := ProbeCompletion()
@@ -216,6 +220,7 @@ func TestProbeCompletion_CompletionFormat(t *testing.T) {
 	defer server.Close()
 
 	setupTestConfigWithURL(t, server.URL)
+	setupTestCompletionCache(t)
 
 	completionFunc := ProbeCompletion()
 	cmd := &cobra.Command{}
@@ -230,6 +235,28 @@ func TestProbeCompletion_CompletionFormat(t *testing.T) {
 	assert.Contains(t, completions[0], "up")
 }
 
+func TestProbeCompletion_ServesFromCache(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected API request %s with a fresh cache", r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	setupTestConfigWithURL(t, server.URL)
+	setupTestCompletionCache(t)
+	seedCompletionCache(t, "probes", []completionCandidate{
+		{Value: "Production API", Description: "http (up)"},
+		{Value: "Staging DB", Description: "tcp (up)"},
+	})
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+
+	completions, directive := ProbeCompletion()(cmd, []string{}, "prod")
+	assert.Equal(t, []string{"Production API\thttp (up)"}, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+}
+
 func TestProbeIDCompletion_IsAlias(t *testing.T) {
 	// Verify ProbeIDCompletion is the same as ProbeCompletion
 	assert.NotNil(t, ProbeIDCompletion)
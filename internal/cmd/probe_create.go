@@ -144,6 +144,9 @@ Examples:
 	cmd.Flags().BoolVar(&flags.sslCheckEnabled, "ssl-check-enabled", true, "enable SSL certificate monitoring")
 	cmd.Flags().IntVar(&flags.sslExpiryThresholdDays, "ssl-expiry-threshold-days", 14, "alert when SSL expires within N days")
 
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("regions", RegionListCompletion())
+
 	return cmd
 }
 
@@ -18,7 +18,8 @@ const probeDeleteTimeout = 30 * time.Second
 
 // probeDeleteFlags holds the flag values for the probe delete command.
 type probeDeleteFlags struct {
-	yes bool // Skip confirmation prompt
+	yes    bool   // Skip confirmation prompt
+	labels string // Label selector used instead of explicit IDs
 }
 
 // NewProbeDeleteCmd creates and returns the probe delete subcommand.
@@ -28,18 +29,27 @@ func NewProbeDeleteCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:               "delete <id> [id...]",
 		Short:             "Delete one or more monitoring probes",
-		ValidArgsFunction: ProbeCompletion(),
+		ValidArgsFunction: RepeatedCompletion(ProbeCompletion()),
 		Long: `Delete one or more monitoring probes.
 
 Probes can be specified by UUID or by name. If a name matches multiple probes,
 you'll be prompted to use the UUID instead.
 
+Instead of listing probes, use --labels to select every probe matching a label
+selector (key=value, key!=value or key). The matched probes are listed before
+the confirmation prompt, which shows how many probes will be affected.
+
 This permanently removes the probe(s) and all associated data including check history
 and alert records. This action cannot be undone.
 
 By default, the command will prompt for confirmation before deleting. Use --yes
 to skip the confirmation prompt for scripting or automation.
 
+Pass - instead of IDs to read them from stdin, one per line or as a JSON array
+of IDs or of objects with an "id" field (such as list output with -o json).
+The confirmation prompt then reads from the terminal; without one, --yes is
+required.
+
 Examples:
   # Delete a single probe by name
   stackeye probe delete "Production API"
@@ -54,22 +64,40 @@ Examples:
   stackeye probe delete "Production API" "Staging DB" 6ba7b810-9dad-11d1-80b4-00c04fd430c8
 
   # Delete multiple probes without confirmation (for scripting)
-  stackeye probe delete --yes "Production API" "Staging DB"`,
-		Args: cobra.MinimumNArgs(1),
+  stackeye probe delete --yes "Production API" "Staging DB"
+
+  # Delete all probes labelled for a retired release
+  stackeye probe delete -l release=v1
+
+  # Read probe IDs from another command's JSON output
+  cat probe-ids.txt | stackeye probe delete -`,
+		Args: probeSelectorArgs(noProbeArgsWithSelector, cobra.MinimumNArgs(1)),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runProbeDelete(cmd.Context(), args, flags)
+			ids, fromStdin, err := readIDArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+			if err := promptFromTTYForStdinIDs(fromStdin, flags.yes); err != nil {
+				return err
+			}
+			return runProbeDelete(cmd.Context(), ids, flags)
 		},
 	}
 
 	cmd.Flags().BoolVarP(&flags.yes, "yes", "y", false, "skip confirmation prompt")
+	cmd.Flags().StringVarP(&flags.labels, "labels", "l", "", probeSelectorUsage)
+
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("labels", LabelFilterCompletion())
 
 	return cmd
 }
 
 // runProbeDelete executes the probe delete command logic.
 func runProbeDelete(ctx context.Context, idArgs []string, flags *probeDeleteFlags) error {
-	// Dry-run check: print what would happen and exit without making API calls
-	if GetDryRun() {
+	// Dry-run check: print what would happen and exit without making API calls.
+	// Label selectors must be resolved via the API before they can be previewed.
+	if GetDryRun() && flags.labels == "" {
 		dryrun.PrintBatchAction("delete", "probe", idArgs)
 		return nil
 	}
@@ -80,11 +108,20 @@ func runProbeDelete(ctx context.Context, idArgs []string, flags *probeDeleteFlag
 		return fmt.Errorf("failed to initialize API client: %w", err)
 	}
 
-	// Resolve all probe identifiers (UUIDs or names) before prompting for confirmation
-	probeIDs, err := ResolveProbeIDs(ctx, apiClient, idArgs)
+	// Resolve all probe identifiers (UUIDs, names or label selector) before
+	// prompting for confirmation
+	probeIDs, err := resolveProbeTargets(ctx, apiClient, idArgs, flags.labels)
 	if err != nil {
 		return err
 	}
+	if len(probeIDs) == 0 {
+		return nil
+	}
+
+	if GetDryRun() {
+		dryrun.PrintBatchAction("delete", "probe", probeIDStrings(probeIDs))
+		return nil
+	}
 
 	// Prompt for confirmation unless --yes flag is set or --no-input is enabled
 	message := "Are you sure you want to delete this probe?"
@@ -26,6 +26,10 @@ Dependency Operations:
   remove    Remove a parent dependency from a probe
   clear     Remove all dependencies from a probe
   tree      Display organization-wide dependency tree
+  impact    Show everything affected if a probe goes down
+  validate  Check the dependency graph for cycles and stale edges
+  apply     Make dependencies match a YAML or JSON file
+  suggest   Suggest dependencies from the hosts probes check
   wizard    Interactive guided dependency setup
 
 Examples:
@@ -44,6 +48,18 @@ Examples:
   # View ASCII tree of all dependencies
   stackeye probe deps tree
 
+  # See which probes, status pages and channels depend on a probe
+  stackeye probe deps impact <probe-id>
+
+  # Check the dependency graph for cycles and deleted or paused probes
+  stackeye probe deps validate
+
+  # Apply dependencies from a file, previewing the plan first
+  stackeye probe deps apply -f deps.yaml --dry-run
+
+  # Review dependencies suggested from probe URLs and hosts
+  stackeye probe deps suggest
+
   # Run interactive dependency wizard
   stackeye probe deps wizard
 
@@ -68,6 +84,10 @@ For more information about a specific command:
 	cmd.AddCommand(NewProbeDepsRemoveCmd()) // Task #8025
 	cmd.AddCommand(NewProbeDepsClearCmd())  // Task #8026
 	cmd.AddCommand(NewProbeDepsTreeCmd())   // Task #8027
+	cmd.AddCommand(NewProbeDepsImpactCmd())
+	cmd.AddCommand(NewProbeDepsValidateCmd())
+	cmd.AddCommand(NewProbeDepsApplyCmd())
+	cmd.AddCommand(NewProbeDepsSuggestCmd())
 	cmd.AddCommand(NewProbeDepsWizardCmd()) // Task #8028
 
 	return cmd
@@ -5,22 +5,29 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/StackEye-IO/stackeye-cli/internal/api"
 	"github.com/StackEye-IO/stackeye-cli/internal/dryrun"
 	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 )
 
 // probeDepsAddTimeout is the maximum time to wait for the API response.
 const probeDepsAddTimeout = 30 * time.Second
 
+// defaultMaxDependencyDepth is the default limit on how many levels a
+// dependency chain may have below its root probe.
+const defaultMaxDependencyDepth = 10
+
 // NewProbeDepsAddCmd creates and returns the probe deps add subcommand.
 func NewProbeDepsAddCmd() *cobra.Command {
 	var parentID string
 	var force bool
+	var maxDepth int
 
 	cmd := &cobra.Command{
 		Use:               "add <probe-id> --parent <parent-probe-id>",
@@ -36,6 +43,11 @@ This is useful for reducing alert noise when a dependent service is down.
 For example, if your database goes down, you don't want separate alerts
 for every web server that depends on it.
 
+Before adding the dependency, the organization's dependency tree is checked.
+The command refuses dependencies that would create a cycle or make a chain
+deeper than --max-depth levels below its root probe, and prints the chain of
+probes that caused the refusal.
+
 Examples:
   # Add a dependency by name: web-server depends on database
   stackeye probe deps add "web-server" --parent "database"
@@ -46,18 +58,22 @@ Examples:
   # Force add even if parent is currently DOWN
   stackeye probe deps add "web-server" --parent "database" --force
 
+  # Allow deeper dependency chains than the default of 10 levels
+  stackeye probe deps add "web-server" --parent "database" --max-depth 20
+
 Common dependency patterns:
   Database -> Application Servers
   Load Balancer -> Backend Servers
   Core Router -> All Downstream Devices`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runProbeDepsAddCmd(cmd.Context(), args[0], parentID, force)
+			return runProbeDepsAddCmd(cmd.Context(), args[0], parentID, force, maxDepth)
 		},
 	}
 
 	cmd.Flags().StringVarP(&parentID, "parent", "p", "", "Parent probe ID (required)")
 	cmd.Flags().BoolVarP(&force, "force", "f", false, "Skip confirmation when parent is DOWN")
+	cmd.Flags().IntVar(&maxDepth, "max-depth", defaultMaxDependencyDepth, "Maximum dependency chain depth below the root probe (0 disables the check)")
 	if err := cmd.MarkFlagRequired("parent"); err != nil {
 		panic(fmt.Sprintf("failed to mark parent flag as required: %v", err))
 	}
@@ -66,7 +82,11 @@ Common dependency patterns:
 }
 
 // runProbeDepsAddCmd executes the probe deps add command logic.
-func runProbeDepsAddCmd(ctx context.Context, probeIDArg, parentIDArg string, force bool) error {
+func runProbeDepsAddCmd(ctx context.Context, probeIDArg, parentIDArg string, force bool, maxDepth int) error {
+	if maxDepth < 0 {
+		return fmt.Errorf("--max-depth must be 0 or greater, got %d", maxDepth)
+	}
+
 	// Dry-run check: print what would happen and exit without making API calls
 	if GetDryRun() {
 		dryrun.PrintAction("add dependency to", "probe",
@@ -127,6 +147,11 @@ func runProbeDepsAddCmd(ctx context.Context, probeIDArg, parentIDArg string, for
 	}
 	parentName = parent.Name
 
+	// Check the new edge against the current graph before sending it
+	if err := checkDependencyGraph(ctx, apiClient, probeID, parentID, maxDepth); err != nil {
+		return err
+	}
+
 	// Warn if parent is currently DOWN (child would immediately become unreachable)
 	if strings.ToLower(parent.Status) == "down" && !force {
 		fmt.Printf("Warning: Parent probe %q is currently DOWN.\n", parentName)
@@ -149,6 +174,49 @@ func runProbeDepsAddCmd(ctx context.Context, probeIDArg, parentIDArg string, for
 	return nil
 }
 
+// checkDependencyGraph fetches the organization's dependency tree and checks
+// that making probeID depend on parentID keeps it a valid graph.
+func checkDependencyGraph(ctx context.Context, apiClient *client.Client, probeID, parentID uuid.UUID, maxDepth int) error {
+	orgID, err := currentOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, probeDepsAddTimeout)
+	defer cancel()
+
+	tree, err := client.GetOrganizationDependencyTree(reqCtx, apiClient, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to get dependency tree: %w", err)
+	}
+	return checkNewDependency(newDependencyGraph(tree), probeID, parentID, maxDepth)
+}
+
+// checkNewDependency returns an error explaining why making probeID depend on
+// parentID would create a duplicate, a cycle or a chain deeper than maxDepth
+// levels. A maxDepth of 0 disables the depth check.
+func checkNewDependency(g *dependencyGraph, probeID, parentID uuid.UUID, maxDepth int) error {
+	if slices.Contains(g.parents[probeID], parentID) {
+		return fmt.Errorf("dependency already exists: %q already depends on %q", g.name(probeID), g.name(parentID))
+	}
+
+	// A path from the child down to the parent means the parent already
+	// depends on the child
+	if path := g.path(probeID, parentID); path != nil {
+		return fmt.Errorf("circular dependency: %q already depends on %q (%s), so %q cannot depend on %q",
+			g.name(parentID), g.name(probeID), g.formatChain(path), g.name(probeID), g.name(parentID))
+	}
+
+	if maxDepth > 0 {
+		chain := append(g.ancestorChain(parentID), g.descendantChain(probeID)...)
+		if depth := len(chain) - 1; depth > maxDepth {
+			return fmt.Errorf("dependency too deep: the chain %s would be %d levels deep (max %d, see --max-depth)",
+				g.formatChain(chain), depth, maxDepth)
+		}
+	}
+	return nil
+}
+
 // handleAddDependencyError maps API errors to user-friendly error messages.
 func handleAddDependencyError(err error, probeName, parentName string) error {
 	errMsg := err.Error()
@@ -3,6 +3,7 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -403,3 +404,59 @@ func TestProbeDepsAddIntegration_MockServer(t *testing.T) {
 		t.Errorf("expected parent_probe_id %s, got %s", parentID, result.ParentProbeID)
 	}
 }
+
+func TestCheckNewDependency(t *testing.T) {
+	db := uuid.New()
+	api := uuid.New()
+	web := uuid.New()
+	cdn := uuid.New()
+
+	g := testDependencyGraph([]client.DependencyTreeNode{
+		{ProbeID: db, Name: "Database"},
+		{ProbeID: api, Name: "API"},
+		{ProbeID: web, Name: "Web"},
+		{ProbeID: cdn, Name: "CDN"},
+	},
+		[2]uuid.UUID{db, api},
+		[2]uuid.UUID{api, web},
+	)
+
+	tests := []struct {
+		name     string
+		probe    uuid.UUID
+		parent   uuid.UUID
+		maxDepth int
+		wantErr  string
+	}{
+		{name: "valid", probe: cdn, parent: web, maxDepth: 10},
+		{name: "existing", probe: api, parent: db, maxDepth: 10, wantErr: `"API" already depends on "Database"`},
+		{name: "cycle", probe: db, parent: web, maxDepth: 10, wantErr: `"Web" already depends on "Database" (Database -> API -> Web)`},
+		{name: "too deep", probe: cdn, parent: web, maxDepth: 2, wantErr: "the chain Database -> API -> Web -> CDN would be 3 levels deep (max 2"},
+		{name: "depth check disabled", probe: cdn, parent: web, maxDepth: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkNewDependency(g, tt.probe, tt.parent, tt.maxDepth)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRunProbeDepsAddCmd_NegativeMaxDepth(t *testing.T) {
+	err := runProbeDepsAddCmd(context.Background(), "web", "db", false, -1)
+	if err == nil {
+		t.Fatal("expected error for negative --max-depth")
+	}
+	if strings.Contains(err.Error(), "API client") {
+		t.Errorf("expected validation before API client init, got: %v", err)
+	}
+}
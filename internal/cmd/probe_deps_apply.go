@@ -0,0 +1,465 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	cliinteractive "github.com/StackEye-IO/stackeye-cli/internal/interactive"
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// probeDepsApplyTimeout is the maximum time to wait for the dependency tree.
+const probeDepsApplyTimeout = 60 * time.Second
+
+// probeDepsApplyChangeTimeout is the maximum time to wait for each edge change.
+const probeDepsApplyChangeTimeout = 30 * time.Second
+
+// Dependency change actions.
+const (
+	depsActionAdd    = "add"
+	depsActionRemove = "remove"
+)
+
+// Dependency change statuses.
+const (
+	depsStatusPlanned = "planned"
+	depsStatusApplied = "applied"
+	depsStatusFailed  = "failed"
+)
+
+// probeDepsApplyFlags holds the flag values for the probe deps apply command.
+type probeDepsApplyFlags struct {
+	file     string
+	format   string
+	prune    bool
+	maxDepth int
+	yes      bool
+}
+
+// ProbeDepsApplyChange is a single dependency added or removed by probe deps apply.
+// This struct is exported to allow JSON/YAML serialization with proper field tags.
+type ProbeDepsApplyChange struct {
+	Action   string    `json:"action" yaml:"action"`
+	ProbeID  uuid.UUID `json:"probe_id" yaml:"probe_id"`
+	Probe    string    `json:"probe" yaml:"probe"`
+	ParentID uuid.UUID `json:"parent_id" yaml:"parent_id"`
+	Parent   string    `json:"parent" yaml:"parent"`
+	Status   string    `json:"status" yaml:"status"`
+	Error    string    `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// NewProbeDepsApplyCmd creates and returns the probe deps apply subcommand.
+func NewProbeDepsApplyCmd() *cobra.Command {
+	flags := &probeDepsApplyFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Apply dependencies from a file",
+		Long: `Make the dependency graph match a YAML or JSON file.
+
+The file maps each probe to the list of parent probes it depends on. Probes
+are referenced by name (or by ID when names are not unique):
+
+  web-server: [database, load-balancer]
+  worker: [database, queue]
+  queue: []
+
+The command compares the file with the current dependency tree and prints a
+plan of the dependencies to add and remove, then applies it. Each probe in the
+file ends up with exactly the listed parents; an empty list removes all of its
+parents. Probes not in the file are left alone unless --prune is set, which
+removes their parents too.
+
+The resulting graph is checked before any change is made: the command refuses
+plans that would create a cycle or a chain deeper than --max-depth.
+
+Plans that remove dependencies ask for confirmation first. Use --yes to skip
+the prompt, or --dry-run to print the plan without changing anything.
+
+Examples:
+  # Preview the changes
+  stackeye probe deps apply -f deps.yaml --dry-run
+
+  # Apply the file
+  stackeye probe deps apply -f deps.yaml
+
+  # Make the file the complete dependency graph
+  stackeye probe deps apply -f deps.yaml --prune
+
+  # Apply without confirmation (for scripting)
+  stackeye probe deps apply -f deps.yaml --prune --yes
+
+  # Output the plan and results as JSON
+  stackeye probe deps apply -f deps.json -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runProbeDepsApplyCmd(cmd.Context(), flags)
+		},
+	}
+
+	cmd.Flags().StringVarP(&flags.file, "file", "f", "", "dependency file path (required)")
+	cmd.Flags().StringVar(&flags.format, "format", "", "input format: yaml, json (auto-detected from extension if omitted)")
+	cmd.Flags().BoolVar(&flags.prune, "prune", false, "remove the dependencies of probes not listed in the file")
+	cmd.Flags().IntVar(&flags.maxDepth, "max-depth", defaultMaxDependencyDepth, "Maximum dependency chain depth below the root probe (0 disables the check)")
+	cmd.Flags().BoolVarP(&flags.yes, "yes", "y", false, "Skip confirmation prompt")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+// runProbeDepsApplyCmd executes the probe deps apply command logic.
+func runProbeDepsApplyCmd(ctx context.Context, flags *probeDepsApplyFlags) error {
+	if flags.maxDepth < 0 {
+		return fmt.Errorf("--max-depth must be 0 or greater, got %d", flags.maxDepth)
+	}
+
+	format, err := resolveImportFormat(flags.file, flags.format)
+	if err != nil {
+		return err
+	}
+	spec, err := readDependencyFile(flags.file, format)
+	if err != nil {
+		return err
+	}
+	if len(spec) == 0 && !flags.prune {
+		return fmt.Errorf("no dependencies found in %q", flags.file)
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	orgID, err := currentOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, probeDepsApplyTimeout)
+	defer cancel()
+
+	tree, err := client.GetOrganizationDependencyTree(reqCtx, apiClient, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to get dependency tree: %w", err)
+	}
+
+	g := newDependencyGraph(tree)
+	desired, err := resolveDependencyFile(g, spec)
+	if err != nil {
+		return fmt.Errorf("invalid dependency file %q: %w", flags.file, err)
+	}
+
+	changes := planDependencyChanges(g, desired, flags.prune)
+	if err := checkDependencyChanges(g, changes, flags.maxDepth); err != nil {
+		return err
+	}
+
+	outFormat := output.NewPrinter(GetConfig()).Format()
+	structured := outFormat == sdkoutput.FormatJSON || outFormat == sdkoutput.FormatYAML
+
+	if GetDryRun() || len(changes) == 0 {
+		if structured {
+			return output.Print(changes)
+		}
+		printDependencyPlan(os.Stdout, changes)
+		if len(changes) > 0 {
+			fmt.Println("\nNo changes were made (dry run).")
+		}
+		return nil
+	}
+
+	// Keep stdout clean for structured output
+	planOut := os.Stdout
+	if structured {
+		planOut = os.Stderr
+	}
+
+	removals := countDependencyRemovals(changes)
+	if !structured || (removals > 0 && !flags.yes && !GetNoInput()) {
+		printDependencyPlan(planOut, changes)
+		fmt.Fprintln(planOut)
+	}
+
+	if removals > 0 {
+		confirmed, err := cliinteractive.Confirm(
+			fmt.Sprintf("Remove %d dependencies?", removals),
+			cliinteractive.WithYesFlag(flags.yes),
+		)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(planOut, "Operation cancelled.")
+			return nil
+		}
+	}
+
+	failed := applyDependencyChanges(ctx, apiClient, changes)
+
+	if structured {
+		if err := output.Print(changes); err != nil {
+			return err
+		}
+	} else {
+		for _, c := range changes {
+			if c.Status == depsStatusFailed {
+				fmt.Fprintf(os.Stderr, "Failed: %s\n", c.Error)
+			}
+		}
+		fmt.Printf("Applied %d of %d change(s).\n", len(changes)-failed, len(changes))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to apply %d change(s)", failed)
+	}
+	return nil
+}
+
+// readDependencyFile reads a map of probe to parent probes from a file.
+func readDependencyFile(filePath, format string) (map[string][]string, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %q: %w", filePath, err)
+	}
+
+	spec := make(map[string][]string)
+	switch format {
+	case "json":
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON from %q: %w", filePath, err)
+		}
+	case "yaml":
+		if err := yaml.Unmarshal(data, &spec); err != nil {
+			return nil, fmt.Errorf("failed to parse YAML from %q: %w", filePath, err)
+		}
+	}
+	return spec, nil
+}
+
+// resolveDependencyFile resolves the probe names in a dependency file to IDs
+// using the probes in the graph. Probes can also be referenced by ID.
+func resolveDependencyFile(g *dependencyGraph, spec map[string][]string) (map[uuid.UUID][]uuid.UUID, error) {
+	byName := make(map[string][]uuid.UUID)
+	for id, node := range g.nodes {
+		byName[node.Name] = append(byName[node.Name], id)
+	}
+	resolve := func(ref string) (uuid.UUID, error) {
+		if id, err := uuid.Parse(ref); err == nil {
+			if _, ok := g.nodes[id]; ok {
+				return id, nil
+			}
+		}
+		switch ids := byName[ref]; len(ids) {
+		case 0:
+			return uuid.Nil, fmt.Errorf("probe %q not found", ref)
+		case 1:
+			return ids[0], nil
+		default:
+			return uuid.Nil, fmt.Errorf("probe name %q matches %d probes; use the probe ID instead", ref, len(ids))
+		}
+	}
+
+	children := make([]string, 0, len(spec))
+	for child := range spec {
+		children = append(children, child)
+	}
+	sort.Strings(children)
+
+	desired := make(map[uuid.UUID][]uuid.UUID, len(spec))
+	for _, childRef := range children {
+		childID, err := resolve(childRef)
+		if err != nil {
+			return nil, err
+		}
+		if _, dup := desired[childID]; dup {
+			return nil, fmt.Errorf("probe %q is listed more than once", childRef)
+		}
+		parents := []uuid.UUID{}
+		for _, parentRef := range spec[childRef] {
+			parentID, err := resolve(parentRef)
+			if err != nil {
+				return nil, err
+			}
+			if parentID == childID {
+				return nil, fmt.Errorf("probe %q cannot depend on itself", childRef)
+			}
+			parents = append(parents, parentID)
+		}
+		desired[childID] = parents
+	}
+	return desired, nil
+}
+
+// planDependencyChanges returns the dependencies to remove and add so that
+// each probe in desired has exactly the listed parents. With prune, probes not
+// in desired lose all their parents. Removals come first, each group sorted by
+// probe and then parent name.
+func planDependencyChanges(g *dependencyGraph, desired map[uuid.UUID][]uuid.UUID, prune bool) []ProbeDepsApplyChange {
+	var removes, adds []ProbeDepsApplyChange
+	change := func(action string, probeID, parentID uuid.UUID) ProbeDepsApplyChange {
+		return ProbeDepsApplyChange{
+			Action:   action,
+			ProbeID:  probeID,
+			Probe:    g.name(probeID),
+			ParentID: parentID,
+			Parent:   g.name(parentID),
+			Status:   depsStatusPlanned,
+		}
+	}
+
+	for _, id := range g.ids() {
+		want, listed := desired[id]
+		if !listed && !prune {
+			continue
+		}
+		wanted := make(map[uuid.UUID]bool, len(want))
+		for _, p := range want {
+			wanted[p] = true
+		}
+		current := make(map[uuid.UUID]bool, len(g.parents[id]))
+		for _, p := range g.parents[id] {
+			current[p] = true
+			if !wanted[p] {
+				removes = append(removes, change(depsActionRemove, id, p))
+			}
+		}
+		for _, p := range want {
+			if !current[p] {
+				current[p] = true
+				adds = append(adds, change(depsActionAdd, id, p))
+			}
+		}
+	}
+
+	for _, changes := range [][]ProbeDepsApplyChange{removes, adds} {
+		sort.SliceStable(changes, func(i, j int) bool {
+			if changes[i].Probe != changes[j].Probe {
+				return changes[i].Probe < changes[j].Probe
+			}
+			return changes[i].Parent < changes[j].Parent
+		})
+	}
+	return append(removes, adds...)
+}
+
+// checkDependencyChanges returns an error if the graph after the changes would
+// contain a cycle or a chain deeper than maxDepth levels.
+func checkDependencyChanges(g *dependencyGraph, changes []ProbeDepsApplyChange, maxDepth int) error {
+	next := &dependencyGraph{
+		nodes:    g.nodes,
+		parents:  make(map[uuid.UUID][]uuid.UUID),
+		children: make(map[uuid.UUID][]uuid.UUID),
+	}
+	removed := make(map[[2]uuid.UUID]bool)
+	var added [][2]uuid.UUID
+	for _, c := range changes {
+		edge := [2]uuid.UUID{c.ParentID, c.ProbeID}
+		if c.Action == depsActionRemove {
+			removed[edge] = true
+		} else {
+			added = append(added, edge)
+		}
+	}
+	addEdge := func(parent, child uuid.UUID) {
+		next.children[parent] = append(next.children[parent], child)
+		next.parents[child] = append(next.parents[child], parent)
+	}
+	for parent, children := range g.children {
+		for _, child := range children {
+			if !removed[[2]uuid.UUID{parent, child}] {
+				addEdge(parent, child)
+			}
+		}
+	}
+	for _, edge := range added {
+		addEdge(edge[0], edge[1])
+	}
+
+	if cycles := next.cycles(); len(cycles) > 0 {
+		cycle := cycles[0]
+		return fmt.Errorf("circular dependency: applying the file would create the cycle %s",
+			next.formatChain(append(cycle, cycle[0])))
+	}
+	if maxDepth > 0 {
+		for _, id := range next.ids() {
+			if chain := next.ancestorChain(id); len(chain)-1 > maxDepth {
+				return fmt.Errorf("dependency too deep: applying the file would make the chain %s %d levels deep (max %d, see --max-depth)",
+					next.formatChain(chain), len(chain)-1, maxDepth)
+			}
+		}
+	}
+	return nil
+}
+
+// countDependencyRemovals returns how many planned changes remove a dependency.
+func countDependencyRemovals(changes []ProbeDepsApplyChange) int {
+	removals := 0
+	for _, c := range changes {
+		if c.Action == depsActionRemove {
+			removals++
+		}
+	}
+	return removals
+}
+
+// printDependencyPlan writes the planned changes, one per line.
+func printDependencyPlan(w io.Writer, changes []ProbeDepsApplyChange) {
+	if len(changes) == 0 {
+		fmt.Fprintln(w, "No changes. The dependency graph already matches the file.")
+		return
+	}
+
+	removals := countDependencyRemovals(changes)
+	fmt.Fprintf(w, "Plan: %d to add, %d to remove\n\n", len(changes)-removals, removals)
+	for _, c := range changes {
+		sign := "+"
+		if c.Action == depsActionRemove {
+			sign = "-"
+		}
+		fmt.Fprintf(w, "  %s %q depends on %q\n", sign, c.Probe, c.Parent)
+	}
+}
+
+// applyDependencyChanges makes each change in order, recording its status,
+// and returns the number of changes that failed.
+func applyDependencyChanges(ctx context.Context, apiClient *client.Client, changes []ProbeDepsApplyChange) int {
+	failed := 0
+	for i := range changes {
+		c := &changes[i]
+		reqCtx, cancel := context.WithTimeout(ctx, probeDepsApplyChangeTimeout)
+		var err error
+		if c.Action == depsActionRemove {
+			_, err = client.RemoveProbeDependency(reqCtx, apiClient, c.ProbeID, c.ParentID)
+			if err != nil {
+				err = handleRemoveDependencyError(err, c.Probe, c.Parent)
+			}
+		} else {
+			_, err = client.AddProbeDependency(reqCtx, apiClient, c.ProbeID, c.ParentID)
+			if err != nil {
+				err = handleAddDependencyError(err, c.Probe, c.Parent)
+			}
+		}
+		cancel()
+
+		if err != nil {
+			c.Status = depsStatusFailed
+			c.Error = err.Error()
+			failed++
+			continue
+		}
+		c.Status = depsStatusApplied
+	}
+	return failed
+}
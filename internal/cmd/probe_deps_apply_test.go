@@ -0,0 +1,246 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+)
+
+func TestNewProbeDepsApplyCmd(t *testing.T) {
+	cmd := NewProbeDepsApplyCmd()
+
+	if cmd.Use != "apply" {
+		t.Errorf("Use = %q, want %q", cmd.Use, "apply")
+	}
+	if cmd.Short == "" {
+		t.Error("Short description should not be empty")
+	}
+	for _, name := range []string{"file", "format", "prune", "max-depth", "yes"} {
+		if cmd.Flags().Lookup(name) == nil {
+			t.Errorf("expected --%s flag to be defined", name)
+		}
+	}
+	if f := cmd.Flags().Lookup("file"); f != nil && f.Shorthand != "f" {
+		t.Errorf("--file shorthand = %q, want f", f.Shorthand)
+	}
+}
+
+func TestRunProbeDepsApplyCmd_Validation(t *testing.T) {
+	dir := t.TempDir()
+	empty := filepath.Join(dir, "empty.yaml")
+	if err := os.WriteFile(empty, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	bad := filepath.Join(dir, "bad.yaml")
+	if err := os.WriteFile(bad, []byte("web: {parents: db}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		flags   probeDepsApplyFlags
+		wantErr string
+	}{
+		{"negative max depth", probeDepsApplyFlags{file: empty, maxDepth: -1}, "--max-depth"},
+		{"unknown extension", probeDepsApplyFlags{file: "deps.txt"}, "cannot detect format"},
+		{"missing file", probeDepsApplyFlags{file: filepath.Join(dir, "missing.yaml")}, "failed to read file"},
+		{"empty file", probeDepsApplyFlags{file: empty}, "no dependencies found"},
+		{"wrong shape", probeDepsApplyFlags{file: bad}, "failed to parse YAML"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runProbeDepsApplyCmd(context.Background(), &tt.flags)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReadDependencyFile(t *testing.T) {
+	dir := t.TempDir()
+	yamlFile := filepath.Join(dir, "deps.yaml")
+	content := "web-server: [database, load-balancer]\nqueue: []\n"
+	if err := os.WriteFile(yamlFile, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	jsonFile := filepath.Join(dir, "deps.json")
+	if err := os.WriteFile(jsonFile, []byte(`{"web-server": ["database"]}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	spec, err := readDependencyFile(yamlFile, "yaml")
+	if err != nil {
+		t.Fatalf("readDependencyFile(yaml) error = %v", err)
+	}
+	if got := spec["web-server"]; len(got) != 2 || got[0] != "database" || got[1] != "load-balancer" {
+		t.Errorf("web-server parents = %v", got)
+	}
+	if got, ok := spec["queue"]; !ok || len(got) != 0 {
+		t.Errorf("queue parents = %v, %v; want an empty list", got, ok)
+	}
+
+	spec, err = readDependencyFile(jsonFile, "json")
+	if err != nil {
+		t.Fatalf("readDependencyFile(json) error = %v", err)
+	}
+	if got := spec["web-server"]; len(got) != 1 || got[0] != "database" {
+		t.Errorf("web-server parents = %v", got)
+	}
+}
+
+// applyTestGraph returns a graph where API and Worker depend on Database and
+// Web depends on API. Two probes are named "Cache".
+func applyTestGraph() (*dependencyGraph, map[string]uuid.UUID) {
+	ids := map[string]uuid.UUID{
+		"db":     uuid.New(),
+		"api":    uuid.New(),
+		"web":    uuid.New(),
+		"worker": uuid.New(),
+		"queue":  uuid.New(),
+		"cache1": uuid.New(),
+		"cache2": uuid.New(),
+	}
+	g := testDependencyGraph([]client.DependencyTreeNode{
+		{ProbeID: ids["db"], Name: "Database"},
+		{ProbeID: ids["api"], Name: "API"},
+		{ProbeID: ids["web"], Name: "Web"},
+		{ProbeID: ids["worker"], Name: "Worker"},
+		{ProbeID: ids["queue"], Name: "Queue"},
+		{ProbeID: ids["cache1"], Name: "Cache"},
+		{ProbeID: ids["cache2"], Name: "Cache"},
+	},
+		[2]uuid.UUID{ids["db"], ids["api"]},
+		[2]uuid.UUID{ids["db"], ids["worker"]},
+		[2]uuid.UUID{ids["api"], ids["web"]},
+	)
+	return g, ids
+}
+
+func TestResolveDependencyFile(t *testing.T) {
+	g, ids := applyTestGraph()
+
+	desired, err := resolveDependencyFile(g, map[string][]string{
+		"Web":   {"API", ids["cache1"].String()},
+		"Queue": {},
+	})
+	if err != nil {
+		t.Fatalf("resolveDependencyFile() error = %v", err)
+	}
+	if got := desired[ids["web"]]; len(got) != 2 || got[0] != ids["api"] || got[1] != ids["cache1"] {
+		t.Errorf("Web parents = %v", got)
+	}
+	if got, ok := desired[ids["queue"]]; !ok || len(got) != 0 {
+		t.Errorf("Queue parents = %v, %v; want an empty list", got, ok)
+	}
+
+	errTests := []struct {
+		name    string
+		spec    map[string][]string
+		wantErr string
+	}{
+		{"unknown probe", map[string][]string{"Web": {"Missing"}}, `probe "Missing" not found`},
+		{"ambiguous name", map[string][]string{"Web": {"Cache"}}, `"Cache" matches 2 probes`},
+		{"self dependency", map[string][]string{"Web": {"Web"}}, "cannot depend on itself"},
+		{"listed twice", map[string][]string{"Web": {}, ids["web"].String(): {}}, "listed more than once"},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := resolveDependencyFile(g, tt.spec)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPlanDependencyChanges(t *testing.T) {
+	g, ids := applyTestGraph()
+
+	desired := map[uuid.UUID][]uuid.UUID{
+		ids["web"]:    {ids["api"], ids["queue"]}, // keep API, add Queue
+		ids["worker"]: {ids["queue"]},             // replace Database with Queue
+	}
+
+	changes := planDependencyChanges(g, desired, false)
+	var got []string
+	for _, c := range changes {
+		got = append(got, c.Action+" "+c.Probe+"<-"+c.Parent)
+	}
+	want := []string{"remove Worker<-Database", "add Web<-Queue", "add Worker<-Queue"}
+	if strings.Join(got, ", ") != strings.Join(want, ", ") {
+		t.Errorf("changes = %v, want %v", got, want)
+	}
+	for _, c := range changes {
+		if c.Status != depsStatusPlanned {
+			t.Errorf("status = %q, want planned", c.Status)
+		}
+	}
+
+	// With prune, API loses its unlisted parent too
+	changes = planDependencyChanges(g, desired, true)
+	if len(changes) != 4 || changes[0].Probe != "API" || changes[0].Action != depsActionRemove {
+		t.Errorf("pruned changes = %+v, want API's dependency removed first", changes)
+	}
+
+	// A file matching the current graph produces no changes
+	current := map[uuid.UUID][]uuid.UUID{ids["web"]: {ids["api"]}}
+	if changes := planDependencyChanges(g, current, false); len(changes) != 0 {
+		t.Errorf("expected no changes, got %+v", changes)
+	}
+}
+
+func TestPrintDependencyPlan(t *testing.T) {
+	changes := []ProbeDepsApplyChange{
+		{Action: depsActionRemove, Probe: "Worker", Parent: "Database"},
+		{Action: depsActionAdd, Probe: "Web", Parent: "Queue"},
+		{Action: depsActionRemove, Probe: "API", Parent: "Database"},
+	}
+	if got := countDependencyRemovals(changes); got != 2 {
+		t.Errorf("countDependencyRemovals = %d, want 2", got)
+	}
+
+	var buf bytes.Buffer
+	printDependencyPlan(&buf, changes)
+	out := buf.String()
+	for _, want := range []string{"Plan: 1 to add, 2 to remove", `- "Worker" depends on "Database"`, `+ "Web" depends on "Queue"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("plan output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestCheckDependencyChanges(t *testing.T) {
+	g, ids := applyTestGraph()
+
+	// Database depending on Web closes the loop Database -> API -> Web
+	cyclic := planDependencyChanges(g, map[uuid.UUID][]uuid.UUID{ids["db"]: {ids["web"]}}, false)
+	err := checkDependencyChanges(g, cyclic, 10)
+	if err == nil || !strings.Contains(err.Error(), "API -> Web -> Database -> API") {
+		t.Errorf("error = %v, want the cycle", err)
+	}
+
+	// Removing API -> Web in the same plan breaks the loop
+	fixed := planDependencyChanges(g, map[uuid.UUID][]uuid.UUID{
+		ids["db"]:  {ids["web"]},
+		ids["web"]: {},
+	}, false)
+	if err := checkDependencyChanges(g, fixed, 10); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	deep := planDependencyChanges(g, map[uuid.UUID][]uuid.UUID{ids["queue"]: {ids["web"]}}, false)
+	err = checkDependencyChanges(g, deep, 2)
+	if err == nil || !strings.Contains(err.Error(), "Database -> API -> Web -> Queue 3 levels deep") {
+		t.Errorf("error = %v, want the too-deep chain", err)
+	}
+	if err := checkDependencyChanges(g, deep, 0); err != nil {
+		t.Errorf("unexpected error with depth check disabled: %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Graph output formats accepted by probe deps tree in addition to the global
+// --output formats.
+const (
+	dependencyGraphFormatDOT     = "dot"
+	dependencyGraphFormatMermaid = "mermaid"
+)
+
+// dependencyGraphColors maps a status class to its fill and border colors.
+var dependencyGraphColors = map[string][2]string{
+	"up":          {"#4caf50", "#2e7d32"},
+	"down":        {"#f44336", "#c62828"},
+	"unreachable": {"#ff9800", "#e65100"},
+	"degraded":    {"#ffc107", "#ff8f00"},
+	"paused":      {"#9e9e9e", "#616161"},
+	"unknown":     {"#bdbdbd", "#757575"},
+}
+
+// dependencyGraphClasses lists the status classes in a stable order.
+var dependencyGraphClasses = []string{"up", "down", "unreachable", "degraded", "paused", "unknown"}
+
+// dependencyStatusClass returns the color class for a probe status.
+func dependencyStatusClass(status string) string {
+	status = strings.ToLower(status)
+	if _, ok := dependencyGraphColors[status]; ok {
+		return status
+	}
+	return "unknown"
+}
+
+// graphSelection returns the probes and edges to render, sorted by name. With
+// a start probe only that probe and everything that depends on it are
+// included. Every probe appears once, however many parents it has.
+func (g *dependencyGraph) graphSelection(start uuid.UUID) ([]uuid.UUID, [][2]uuid.UUID, error) {
+	included := make(map[uuid.UUID]bool)
+	if start != uuid.Nil {
+		if _, ok := g.nodes[start]; !ok {
+			return nil, nil, fmt.Errorf("probe %s not found in organization", start)
+		}
+		included[start] = true
+		for _, d := range g.descendants(start) {
+			included[d.id] = true
+		}
+	} else {
+		for id := range g.nodes {
+			included[id] = true
+		}
+	}
+
+	ids := make([]uuid.UUID, 0, len(included))
+	for id := range included {
+		ids = append(ids, id)
+	}
+	g.sortByName(ids)
+
+	var edges [][2]uuid.UUID
+	for _, id := range ids {
+		children := make([]uuid.UUID, 0, len(g.children[id]))
+		seen := make(map[uuid.UUID]bool)
+		for _, child := range g.children[id] {
+			if included[child] && !seen[child] {
+				seen[child] = true
+				children = append(children, child)
+			}
+		}
+		g.sortByName(children)
+		for _, child := range children {
+			edges = append(edges, [2]uuid.UUID{id, child})
+		}
+	}
+	return ids, edges, nil
+}
+
+// renderDependencyDOT writes the dependency graph in Graphviz DOT format.
+// Edges point from a parent to the probes that depend on it.
+func renderDependencyDOT(w io.Writer, g *dependencyGraph, start uuid.UUID) error {
+	ids, edges, err := g.graphSelection(start)
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	b.WriteString("digraph dependencies {\n")
+	b.WriteString("  rankdir=TB;\n")
+	b.WriteString("  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\", fontcolor=\"white\"];\n")
+	for _, id := range ids {
+		status := g.status(id)
+		colors := dependencyGraphColors[dependencyStatusClass(status)]
+		label := g.name(id)
+		if status != "" {
+			label += "\n" + strings.ToUpper(status)
+		}
+		fmt.Fprintf(&b, "  %s [label=%s, fillcolor=%q, color=%q];\n",
+			dotQuote(id.String()), dotQuote(label), colors[0], colors[1])
+	}
+	for _, e := range edges {
+		fmt.Fprintf(&b, "  %s -> %s;\n", dotQuote(e[0].String()), dotQuote(e[1].String()))
+	}
+	b.WriteString("}\n")
+
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// dotQuote returns s as a quoted DOT identifier.
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+// renderDependencyMermaid writes the dependency graph as a Mermaid flowchart.
+// Edges point from a parent to the probes that depend on it.
+func renderDependencyMermaid(w io.Writer, g *dependencyGraph, start uuid.UUID) error {
+	ids, edges, err := g.graphSelection(start)
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	b.WriteString("graph TD\n")
+	for _, id := range ids {
+		status := g.status(id)
+		label := mermaidEscape(g.name(id))
+		if status != "" {
+			label += "<br/>" + mermaidEscape(strings.ToUpper(status))
+		}
+		fmt.Fprintf(&b, "  %s[\"%s\"]:::%s\n", mermaidNodeID(id), label, dependencyStatusClass(status))
+	}
+	for _, e := range edges {
+		fmt.Fprintf(&b, "  %s --> %s\n", mermaidNodeID(e[0]), mermaidNodeID(e[1]))
+	}
+	for _, class := range dependencyGraphClasses {
+		colors := dependencyGraphColors[class]
+		fmt.Fprintf(&b, "  classDef %s fill:%s,stroke:%s,color:#fff\n", class, colors[0], colors[1])
+	}
+
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// mermaidNodeID returns a Mermaid-safe node identifier for a probe.
+func mermaidNodeID(id uuid.UUID) string {
+	return "p" + strings.ReplaceAll(id.String(), "-", "")
+}
+
+// mermaidEscape replaces characters that would break a quoted Mermaid label
+// with their entity codes.
+func mermaidEscape(s string) string {
+	return strings.NewReplacer(
+		"&", "#amp;",
+		`"`, "#quot;",
+		"<", "#lt;",
+		">", "#gt;",
+	).Replace(s)
+}
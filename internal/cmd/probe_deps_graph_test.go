@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+)
+
+// diamondDependencyGraph returns a graph where API depends on both the
+// database and the cache, and both depend on the network.
+func diamondDependencyGraph() (*dependencyGraph, map[string]uuid.UUID) {
+	ids := map[string]uuid.UUID{
+		"net":   uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		"db":    uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		"cache": uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		"api":   uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+		"docs":  uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+	}
+	g := testDependencyGraph([]client.DependencyTreeNode{
+		{ProbeID: ids["net"], Name: "Network", Status: "up"},
+		{ProbeID: ids["db"], Name: "Database", Status: "down"},
+		{ProbeID: ids["cache"], Name: "Cache", Status: "up"},
+		{ProbeID: ids["api"], Name: `API "v2"`, Status: "down", IsUnreachable: true},
+		{ProbeID: ids["docs"], Name: "Docs", Status: "paused"},
+	},
+		[2]uuid.UUID{ids["net"], ids["db"]},
+		[2]uuid.UUID{ids["net"], ids["cache"]},
+		[2]uuid.UUID{ids["db"], ids["api"]},
+		[2]uuid.UUID{ids["cache"], ids["api"]},
+	)
+	return g, ids
+}
+
+func TestDependencyGraph_GraphSelection(t *testing.T) {
+	g, ids := diamondDependencyGraph()
+
+	nodes, edges, err := g.graphSelection(uuid.Nil)
+	if err != nil {
+		t.Fatalf("graphSelection() error = %v", err)
+	}
+	if len(nodes) != 5 {
+		t.Errorf("got %d nodes, want 5 (each probe once)", len(nodes))
+	}
+	if len(edges) != 4 {
+		t.Errorf("got %d edges, want 4", len(edges))
+	}
+	if nodes[0] != ids["api"] {
+		t.Errorf("first node = %s, want API (sorted by name)", g.name(nodes[0]))
+	}
+
+	nodes, edges, err = g.graphSelection(ids["db"])
+	if err != nil {
+		t.Fatalf("graphSelection(db) error = %v", err)
+	}
+	if len(nodes) != 2 || len(edges) != 1 {
+		t.Errorf("subgraph from db = %d nodes, %d edges; want 2 nodes, 1 edge", len(nodes), len(edges))
+	}
+
+	if _, _, err := g.graphSelection(uuid.New()); err == nil {
+		t.Error("expected error for probe not in the graph")
+	}
+}
+
+func TestRenderDependencyDOT(t *testing.T) {
+	g, ids := diamondDependencyGraph()
+
+	var buf bytes.Buffer
+	if err := renderDependencyDOT(&buf, g, uuid.Nil); err != nil {
+		t.Fatalf("renderDependencyDOT() error = %v", err)
+	}
+	out := buf.String()
+
+	if !strings.HasPrefix(out, "digraph dependencies {\n") || !strings.HasSuffix(out, "}\n") {
+		t.Errorf("output is not a digraph:\n%s", out)
+	}
+	apiNode := `"` + ids["api"].String() + `" [label="API \"v2\"\nUNREACHABLE", fillcolor="#ff9800"`
+	if !strings.Contains(out, apiNode) {
+		t.Errorf("expected escaped, unreachable-colored API node, got:\n%s", out)
+	}
+	if strings.Count(out, `"`+ids["api"].String()+`" [`) != 1 {
+		t.Error("expected API node to be declared once despite two parents")
+	}
+	for _, parent := range []string{"db", "cache"} {
+		edge := `"` + ids[parent].String() + `" -> "` + ids["api"].String() + `";`
+		if !strings.Contains(out, edge) {
+			t.Errorf("missing edge %s -> api", parent)
+		}
+	}
+	if !strings.Contains(out, `label="Docs\nPAUSED", fillcolor="#9e9e9e"`) {
+		t.Error("expected orphan probe to be rendered with paused color")
+	}
+}
+
+func TestRenderDependencyMermaid(t *testing.T) {
+	g, ids := diamondDependencyGraph()
+
+	var buf bytes.Buffer
+	if err := renderDependencyMermaid(&buf, g, ids["net"]); err != nil {
+		t.Fatalf("renderDependencyMermaid() error = %v", err)
+	}
+	out := buf.String()
+
+	if !strings.HasPrefix(out, "graph TD\n") {
+		t.Errorf("expected flowchart header, got:\n%s", out)
+	}
+	api := mermaidNodeID(ids["api"])
+	if !strings.Contains(out, api+`["API #quot;v2#quot;<br/>UNREACHABLE"]:::unreachable`) {
+		t.Errorf("expected escaped API node with unreachable class, got:\n%s", out)
+	}
+	if !strings.Contains(out, mermaidNodeID(ids["db"])+" --> "+api) ||
+		!strings.Contains(out, mermaidNodeID(ids["cache"])+" --> "+api) {
+		t.Error("expected edges from both parents of API")
+	}
+	if strings.Contains(out, mermaidNodeID(ids["docs"])) {
+		t.Error("expected probes outside the subgraph to be omitted")
+	}
+	if !strings.Contains(out, "classDef down fill:#f44336") {
+		t.Error("expected status class definitions")
+	}
+}
+
+func TestDependencyStatusClass(t *testing.T) {
+	tests := map[string]string{
+		"up":          "up",
+		"DOWN":        "down",
+		"unreachable": "unreachable",
+		"pending":     "unknown",
+		"":            "unknown",
+	}
+	for status, want := range tests {
+		if got := dependencyStatusClass(status); got != want {
+			t.Errorf("dependencyStatusClass(%q) = %q, want %q", status, got, want)
+		}
+	}
+}
+
+func TestNewProbeDepsTreeCmd_GraphFormats(t *testing.T) {
+	cmd := NewProbeDepsTreeCmd()
+	formats := commandOutputFormats(cmd)
+	if len(formats) != 2 || formats[0] != "dot" || formats[1] != "mermaid" {
+		t.Errorf("expected dot and mermaid output formats, got %v", formats)
+	}
+}
@@ -0,0 +1,405 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// probeDepsImpactTimeout is the maximum time to wait for all impact API calls.
+const probeDepsImpactTimeout = 2 * time.Minute
+
+// statusPagesPageSize is the page size used when listing every status page.
+const statusPagesPageSize = 100
+
+// ProbeDepsImpact is the blast radius of a probe: everything that depends on it.
+// This struct is exported to allow JSON/YAML serialization with proper field tags.
+type ProbeDepsImpact struct {
+	ProbeID         uuid.UUID                `json:"probe_id" yaml:"probe_id"`
+	Probe           string                   `json:"probe" yaml:"probe"`
+	Status          string                   `json:"status" yaml:"status"`
+	DependentProbes []ProbeDepsImpactProbe   `json:"dependent_probes" yaml:"dependent_probes"`
+	StatusPages     []ProbeDepsImpactPage    `json:"status_pages" yaml:"status_pages"`
+	Channels        []ProbeDepsImpactChannel `json:"channels" yaml:"channels"`
+	Totals          ProbeDepsImpactTotals    `json:"totals" yaml:"totals"`
+}
+
+// ProbeDepsImpactProbe is a probe that depends on the analyzed probe.
+type ProbeDepsImpactProbe struct {
+	ProbeID uuid.UUID `json:"probe_id" yaml:"probe_id"`
+	Name    string    `json:"name" yaml:"name"`
+	Status  string    `json:"status" yaml:"status"`
+	Depth   int       `json:"depth" yaml:"depth"`
+	Via     string    `json:"via" yaml:"via"`
+}
+
+// ProbeDepsImpactPage is a status page showing at least one affected probe.
+type ProbeDepsImpactPage struct {
+	ID     uint     `json:"id" yaml:"id"`
+	Name   string   `json:"name" yaml:"name"`
+	Slug   string   `json:"slug" yaml:"slug"`
+	Probes []string `json:"probes" yaml:"probes"`
+}
+
+// ProbeDepsImpactChannel is a notification channel linked to at least one affected probe.
+type ProbeDepsImpactChannel struct {
+	ID      uuid.UUID `json:"id" yaml:"id"`
+	Name    string    `json:"name" yaml:"name"`
+	Type    string    `json:"type" yaml:"type"`
+	Enabled bool      `json:"enabled" yaml:"enabled"`
+	Probes  []string  `json:"probes" yaml:"probes"`
+}
+
+// ProbeDepsImpactTotals holds the impact counts.
+type ProbeDepsImpactTotals struct {
+	DependentProbes int `json:"dependent_probes" yaml:"dependent_probes"`
+	StatusPages     int `json:"status_pages" yaml:"status_pages"`
+	Channels        int `json:"channels" yaml:"channels"`
+}
+
+// probeDepsImpactProbeRow is a row in the dependent probes table.
+type probeDepsImpactProbeRow struct {
+	Name   string `table:"PROBE"`
+	Status string `table:"STATUS"`
+	Depth  string `table:"DEPTH"`
+	Via    string `table:"VIA"`
+	ID     string `table:"ID,wide"`
+}
+
+// probeDepsImpactPageRow is a row in the status pages table.
+type probeDepsImpactPageRow struct {
+	Name   string `table:"STATUS PAGE"`
+	Slug   string `table:"SLUG"`
+	Probes string `table:"AFFECTED PROBES"`
+	ID     string `table:"ID,wide"`
+}
+
+// probeDepsImpactChannelRow is a row in the channels table.
+type probeDepsImpactChannelRow struct {
+	Name    string `table:"CHANNEL"`
+	Type    string `table:"TYPE"`
+	Enabled string `table:"ENABLED"`
+	Probes  string `table:"AFFECTED PROBES"`
+	ID      string `table:"ID,wide"`
+}
+
+// NewProbeDepsImpactCmd creates and returns the probe deps impact subcommand.
+func NewProbeDepsImpactCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "impact <probe>",
+		Short:             "Show everything affected if a probe goes down",
+		ValidArgsFunction: ProbeCompletion(),
+		Long: `Show the blast radius of a probe before maintenance or changes.
+
+Walks the dependency graph downward from the probe and lists:
+  - Every probe that depends on it, directly or transitively, with its depth
+    and the parent it is reached through
+  - The status pages that show the probe or any of its dependents
+  - The notification channels linked to the probe or any of its dependents
+
+When the probe goes down, its dependents are marked UNREACHABLE and their
+alerts are suppressed, but their status pages still reflect the outage.
+
+The probe can be specified by UUID or by name.
+
+Examples:
+  # Show the impact of taking the database down
+  stackeye probe deps impact "primary-db"
+
+  # Include probe and channel IDs
+  stackeye probe deps impact "primary-db" -o wide
+
+  # Output as JSON for scripting
+  stackeye probe deps impact "primary-db" -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runProbeDepsImpact(cmd.Context(), args[0])
+		},
+	}
+
+	return cmd
+}
+
+// runProbeDepsImpact executes the probe deps impact command logic.
+func runProbeDepsImpact(ctx context.Context, probeArg string) error {
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, probeDepsImpactTimeout)
+	defer cancel()
+
+	probeID, err := ResolveProbeID(reqCtx, apiClient, probeArg)
+	if err != nil {
+		return err
+	}
+
+	orgID, err := currentOrganizationID()
+	if err != nil {
+		return err
+	}
+	tree, err := client.GetOrganizationDependencyTree(reqCtx, apiClient, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to get dependency tree: %w", err)
+	}
+
+	probes, err := fetchAllProbesForExport(reqCtx, apiClient, "", nil)
+	if err != nil {
+		return err
+	}
+
+	pageProbes, err := fetchStatusPageProbes(reqCtx, apiClient)
+	if err != nil {
+		return err
+	}
+
+	channels, err := fetchAllChannels(reqCtx, apiClient)
+	if err != nil {
+		return err
+	}
+
+	impact := buildProbeDepsImpact(probeID, newDependencyGraph(tree), probes, pageProbes, channels)
+
+	return printProbeDepsImpact(impact)
+}
+
+// fetchAllStatusPages lists every status page, paginating through all
+// results.
+func fetchAllStatusPages(ctx context.Context, apiClient *client.Client) ([]client.StatusPage, error) {
+	var pages []client.StatusPage
+	for offset := 0; ; offset += statusPagesPageSize {
+		result, err := client.ListStatusPages(ctx, apiClient, &client.ListStatusPagesOptions{
+			Limit:  statusPagesPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list status pages: %w", err)
+		}
+		pages = append(pages, result.StatusPages...)
+		if len(result.StatusPages) < statusPagesPageSize {
+			return pages, nil
+		}
+	}
+}
+
+// statusPageProbes is a status page with the IDs of the probes it shows.
+type statusPageProbes struct {
+	page   client.StatusPage
+	probes []uuid.UUID
+}
+
+// fetchStatusPageProbes lists every status page with the probes it shows.
+func fetchStatusPageProbes(ctx context.Context, apiClient *client.Client) ([]statusPageProbes, error) {
+	pages, err := fetchAllStatusPages(ctx, apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]statusPageProbes, 0, len(pages))
+	for _, page := range pages {
+		status, err := client.GetAggregatedStatus(ctx, apiClient, page.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get probes for status page %q: %w", page.Name, err)
+		}
+		sp := statusPageProbes{page: page}
+		if status != nil {
+			for _, p := range status.Probes {
+				sp.probes = append(sp.probes, p.ProbeID)
+			}
+		}
+		result = append(result, sp)
+	}
+	return result, nil
+}
+
+// buildProbeDepsImpact computes the dependents of a probe and the status pages
+// and channels they reach. The probe itself counts towards status pages and
+// channels.
+func buildProbeDepsImpact(probeID uuid.UUID, g *dependencyGraph, probes []client.Probe,
+	pages []statusPageProbes, channels []client.Channel) *ProbeDepsImpact {
+	impact := &ProbeDepsImpact{
+		ProbeID:         probeID,
+		Probe:           g.name(probeID),
+		Status:          g.status(probeID),
+		DependentProbes: []ProbeDepsImpactProbe{},
+		StatusPages:     []ProbeDepsImpactPage{},
+		Channels:        []ProbeDepsImpactChannel{},
+	}
+
+	probeByID := make(map[uuid.UUID]client.Probe, len(probes))
+	for _, p := range probes {
+		probeByID[p.ID] = p
+	}
+	if p, ok := probeByID[probeID]; ok && impact.Probe == probeID.String() {
+		impact.Probe = p.Name
+		impact.Status = p.Status
+	}
+
+	affected := []uuid.UUID{probeID}
+	for _, d := range g.descendants(probeID) {
+		affected = append(affected, d.id)
+		impact.DependentProbes = append(impact.DependentProbes, ProbeDepsImpactProbe{
+			ProbeID: d.id,
+			Name:    g.name(d.id),
+			Status:  g.status(d.id),
+			Depth:   d.depth,
+			Via:     g.name(d.parent),
+		})
+	}
+
+	affectedNames := make(map[uuid.UUID]string, len(affected))
+	for _, id := range affected {
+		affectedNames[id] = g.name(id)
+	}
+	affectedNames[probeID] = impact.Probe
+
+	for _, sp := range pages {
+		var names []string
+		for _, id := range sp.probes {
+			if name, ok := affectedNames[id]; ok {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			continue
+		}
+		sort.Strings(names)
+		impact.StatusPages = append(impact.StatusPages, ProbeDepsImpactPage{
+			ID:     sp.page.ID,
+			Name:   sp.page.Name,
+			Slug:   sp.page.Slug,
+			Probes: names,
+		})
+	}
+
+	channelProbes := make(map[uuid.UUID][]string)
+	for _, id := range affected {
+		for _, channelID := range probeByID[id].AlertChannelIDs {
+			channelProbes[channelID] = append(channelProbes[channelID], affectedNames[id])
+		}
+	}
+	for _, ch := range channels {
+		names, ok := channelProbes[ch.ID]
+		if !ok {
+			continue
+		}
+		sort.Strings(names)
+		impact.Channels = append(impact.Channels, ProbeDepsImpactChannel{
+			ID:      ch.ID,
+			Name:    ch.Name,
+			Type:    string(ch.Type),
+			Enabled: ch.Enabled,
+			Probes:  names,
+		})
+	}
+	sort.Slice(impact.Channels, func(i, j int) bool {
+		return impact.Channels[i].Name < impact.Channels[j].Name
+	})
+
+	impact.Totals = ProbeDepsImpactTotals{
+		DependentProbes: len(impact.DependentProbes),
+		StatusPages:     len(impact.StatusPages),
+		Channels:        len(impact.Channels),
+	}
+	return impact
+}
+
+// formatImpactProbes joins affected probe names for a table cell, showing at
+// most three.
+func formatImpactProbes(names []string) string {
+	if len(names) <= 3 {
+		return strings.Join(names, ", ")
+	}
+	return fmt.Sprintf("%s +%d", strings.Join(names[:3], ", "), len(names)-3)
+}
+
+// printProbeDepsImpact prints the impact as tables, or as a single
+// ProbeDepsImpact for JSON and YAML output.
+func printProbeDepsImpact(impact *ProbeDepsImpact) error {
+	format := output.NewPrinter(GetConfig()).Format()
+	if format == sdkoutput.FormatJSON || format == sdkoutput.FormatYAML {
+		return output.Print(impact)
+	}
+
+	status := ""
+	if impact.Status != "" {
+		status = fmt.Sprintf(" [%s]", strings.ToUpper(impact.Status))
+	}
+	fmt.Printf("Impact of %q%s\n\n", impact.Probe, status)
+
+	fmt.Printf("Dependent probes (%d):\n", impact.Totals.DependentProbes)
+	if len(impact.DependentProbes) == 0 {
+		fmt.Println("  No probes depend on this probe.")
+	} else {
+		rows := make([]probeDepsImpactProbeRow, 0, len(impact.DependentProbes))
+		for _, p := range impact.DependentProbes {
+			rows = append(rows, probeDepsImpactProbeRow{
+				Name:   p.Name,
+				Status: strings.ToUpper(p.Status),
+				Depth:  strconv.Itoa(p.Depth),
+				Via:    p.Via,
+				ID:     p.ProbeID.String(),
+			})
+		}
+		if err := output.Print(rows); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("\nStatus pages (%d):\n", impact.Totals.StatusPages)
+	if len(impact.StatusPages) == 0 {
+		fmt.Println("  No status pages show the affected probes.")
+	} else {
+		rows := make([]probeDepsImpactPageRow, 0, len(impact.StatusPages))
+		for _, p := range impact.StatusPages {
+			rows = append(rows, probeDepsImpactPageRow{
+				Name:   p.Name,
+				Slug:   p.Slug,
+				Probes: formatImpactProbes(p.Probes),
+				ID:     strconv.FormatUint(uint64(p.ID), 10),
+			})
+		}
+		if err := output.Print(rows); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("\nChannels (%d):\n", impact.Totals.Channels)
+	if len(impact.Channels) == 0 {
+		fmt.Println("  No channels are linked to the affected probes.")
+	} else {
+		rows := make([]probeDepsImpactChannelRow, 0, len(impact.Channels))
+		for _, ch := range impact.Channels {
+			enabled := "yes"
+			if !ch.Enabled {
+				enabled = "no"
+			}
+			rows = append(rows, probeDepsImpactChannelRow{
+				Name:    ch.Name,
+				Type:    ch.Type,
+				Enabled: enabled,
+				Probes:  formatImpactProbes(ch.Probes),
+				ID:      ch.ID.String(),
+			})
+		}
+		if err := output.Print(rows); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("\nTotal: %d dependent probe(s), %d status page(s), %d channel(s)\n",
+		impact.Totals.DependentProbes, impact.Totals.StatusPages, impact.Totals.Channels)
+	return nil
+}
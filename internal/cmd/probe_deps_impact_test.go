@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/StackEye-IO/stackeye-go-sdk/config"
+	"github.com/google/uuid"
+)
+
+func TestNewProbeDepsImpactCmd(t *testing.T) {
+	cmd := NewProbeDepsImpactCmd()
+
+	if cmd.Use != "impact <probe>" {
+		t.Errorf("Use = %q, want %q", cmd.Use, "impact <probe>")
+	}
+	if cmd.Short == "" {
+		t.Error("Short description should not be empty")
+	}
+	if err := cmd.Args(cmd, []string{}); err == nil {
+		t.Error("expected error with no arguments")
+	}
+	if err := cmd.Args(cmd, []string{"primary-db"}); err != nil {
+		t.Errorf("unexpected error with one argument: %v", err)
+	}
+}
+
+func TestBuildProbeDepsImpact(t *testing.T) {
+	db := uuid.New()
+	api := uuid.New()
+	web := uuid.New()
+	unrelated := uuid.New()
+
+	g := testDependencyGraph([]client.DependencyTreeNode{
+		{ProbeID: db, Name: "Database", Status: "up"},
+		{ProbeID: api, Name: "API", Status: "up"},
+		{ProbeID: web, Name: "Web", Status: "up"},
+		{ProbeID: unrelated, Name: "Docs", Status: "up"},
+	},
+		[2]uuid.UUID{db, api},
+		[2]uuid.UUID{api, web},
+	)
+
+	slack := client.Channel{ID: uuid.New(), Name: "ops-slack", Type: client.ChannelTypeSlack, Enabled: true}
+	email := client.Channel{ID: uuid.New(), Name: "dba-email", Type: client.ChannelTypeEmail, Enabled: false}
+	other := client.Channel{ID: uuid.New(), Name: "docs-team", Type: client.ChannelTypeSlack, Enabled: true}
+
+	probes := []client.Probe{
+		{ID: db, Name: "Database", AlertChannelIDs: []uuid.UUID{email.ID}},
+		{ID: api, Name: "API", AlertChannelIDs: []uuid.UUID{slack.ID}},
+		{ID: web, Name: "Web", AlertChannelIDs: []uuid.UUID{slack.ID}},
+		{ID: unrelated, Name: "Docs", AlertChannelIDs: []uuid.UUID{other.ID}},
+	}
+
+	pages := []statusPageProbes{
+		{page: client.StatusPage{ID: 1, Name: "Public", Slug: "public"}, probes: []uuid.UUID{web, unrelated}},
+		{page: client.StatusPage{ID: 2, Name: "Docs", Slug: "docs"}, probes: []uuid.UUID{unrelated}},
+		{page: client.StatusPage{ID: 3, Name: "Internal", Slug: "internal"}, probes: []uuid.UUID{db, api}},
+	}
+
+	impact := buildProbeDepsImpact(db, g, probes, pages, []client.Channel{slack, email, other})
+
+	if impact.Probe != "Database" || impact.Status != "up" {
+		t.Errorf("probe = %q (%s)", impact.Probe, impact.Status)
+	}
+
+	if len(impact.DependentProbes) != 2 {
+		t.Fatalf("dependent probes = %+v, want API and Web", impact.DependentProbes)
+	}
+	if p := impact.DependentProbes[1]; p.Name != "Web" || p.Depth != 2 || p.Via != "API" {
+		t.Errorf("second dependent = %+v, want Web at depth 2 via API", p)
+	}
+
+	if len(impact.StatusPages) != 2 || impact.StatusPages[0].Name != "Public" || impact.StatusPages[1].Name != "Internal" {
+		t.Fatalf("status pages = %+v, want Public and Internal", impact.StatusPages)
+	}
+	if got := impact.StatusPages[1].Probes; len(got) != 2 || got[0] != "API" || got[1] != "Database" {
+		t.Errorf("Internal probes = %v, want [API Database]", got)
+	}
+	if got := impact.StatusPages[0].Probes; len(got) != 1 || got[0] != "Web" {
+		t.Errorf("Public probes = %v, want only Web", got)
+	}
+
+	if len(impact.Channels) != 2 || impact.Channels[0].Name != "dba-email" || impact.Channels[1].Name != "ops-slack" {
+		t.Fatalf("channels = %+v, want dba-email and ops-slack", impact.Channels)
+	}
+	if got := impact.Channels[1].Probes; len(got) != 2 {
+		t.Errorf("ops-slack probes = %v, want API and Web", got)
+	}
+
+	want := ProbeDepsImpactTotals{DependentProbes: 2, StatusPages: 2, Channels: 2}
+	if impact.Totals != want {
+		t.Errorf("totals = %+v, want %+v", impact.Totals, want)
+	}
+}
+
+func TestBuildProbeDepsImpact_NoDependents(t *testing.T) {
+	id := uuid.New()
+	impact := buildProbeDepsImpact(id, testDependencyGraph(nil), []client.Probe{{ID: id, Name: "Standalone", Status: "down"}}, nil, nil)
+
+	if impact.Probe != "Standalone" || impact.Status != "down" {
+		t.Errorf("probe = %q (%s), want name and status from the probe list", impact.Probe, impact.Status)
+	}
+	if impact.DependentProbes == nil || len(impact.DependentProbes) != 0 || impact.Totals.DependentProbes != 0 {
+		t.Errorf("dependents = %+v, want an empty list", impact.DependentProbes)
+	}
+}
+
+func TestPrintProbeDepsImpact_JSON(t *testing.T) {
+	setTestOutputFormat(t, config.OutputFormatJSON)
+
+	db := uuid.New()
+	api := uuid.New()
+	g := testDependencyGraph([]client.DependencyTreeNode{
+		{ProbeID: db, Name: "Database", Status: "down"},
+		{ProbeID: api, Name: "API", Status: "down"},
+	}, [2]uuid.UUID{db, api})
+	impact := buildProbeDepsImpact(db, g, nil, nil, nil)
+
+	out, err := captureStdout(t, func() error { return printProbeDepsImpact(impact) })
+	if err != nil {
+		t.Fatalf("printProbeDepsImpact() error = %v", err)
+	}
+
+	var got ProbeDepsImpact
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("expected a single JSON object with -o json, got:\n%s", out)
+	}
+	if got.Probe != "Database" || len(got.DependentProbes) != 1 {
+		t.Errorf("impact = %+v, want Database with 1 dependent probe", got)
+	}
+}
+
+func TestFetchAllStatusPages_FetchesEveryPage(t *testing.T) {
+	pageSizes := []int{statusPagesPageSize, statusPagesPageSize, 3}
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var pages []map[string]interface{}
+		if requests < len(pageSizes) {
+			for i := 0; i < pageSizes[requests]; i++ {
+				id := requests*statusPagesPageSize + i + 1
+				pages = append(pages, map[string]interface{}{"id": id, "name": "Page"})
+			}
+		}
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"status_pages": pages})
+	}))
+	defer server.Close()
+
+	setupTestConfigWithURL(t, server.URL)
+	apiClient, err := api.GetClient()
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	pages, err := fetchAllStatusPages(context.Background(), apiClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pages) != 2*statusPagesPageSize+3 {
+		t.Errorf("expected %d status pages, got %d", 2*statusPagesPageSize+3, len(pages))
+	}
+	if requests != len(pageSizes) {
+		t.Errorf("expected %d page requests, got %d", len(pageSizes), requests)
+	}
+}
+
+func TestFormatImpactProbes(t *testing.T) {
+	if got := formatImpactProbes([]string{"a", "b"}); got != "a, b" {
+		t.Errorf("got %q", got)
+	}
+	if got := formatImpactProbes([]string{"a", "b", "c", "d", "e"}); got != "a, b, c +2" {
+		t.Errorf("got %q", got)
+	}
+}
@@ -0,0 +1,319 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	cliinteractive "github.com/StackEye-IO/stackeye-cli/internal/interactive"
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// probeDepsSuggestTimeout is the maximum time to wait for probes and the
+// dependency tree.
+const probeDepsSuggestTimeout = 60 * time.Second
+
+// probeDepsSuggestFlags holds the flag values for the probe deps suggest command.
+type probeDepsSuggestFlags struct {
+	yes      bool
+	maxDepth int
+}
+
+// ProbeDepsSuggestion is a dependency proposed by probe deps suggest.
+// This struct is exported to allow JSON/YAML serialization with proper field tags.
+type ProbeDepsSuggestion struct {
+	Probe    string `json:"probe" yaml:"probe" table:"PROBE"`
+	ProbeID  string `json:"probe_id" yaml:"probe_id" table:"PROBE ID,wide"`
+	Parent   string `json:"parent" yaml:"parent" table:"PARENT"`
+	ParentID string `json:"parent_id" yaml:"parent_id" table:"PARENT ID,wide"`
+	Reason   string `json:"reason" yaml:"reason" table:"REASON"`
+}
+
+// probeTarget is the host and port a probe checks.
+type probeTarget struct {
+	checkType string
+	host      string
+	port      string
+}
+
+// NewProbeDepsSuggestCmd creates and returns the probe deps suggest subcommand.
+func NewProbeDepsSuggestCmd() *cobra.Command {
+	flags := &probeDepsSuggestFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "suggest",
+		Short: "Suggest dependencies from probe targets",
+		Long: `Suggest parent dependencies by matching the hosts and ports that probes check.
+
+Probes that check the same host are layered by what they test:
+  DNS probe for a host    parent of every other probe on that host
+  Ping probe for a host   parent of TCP and HTTP probes on that host
+  TCP probe for host:port parent of HTTP probes on that host and port
+
+For example, an HTTP probe for https://api.example.com/health is suggested to
+depend on a DNS probe for api.example.com and a TCP probe for
+api.example.com:443. Dependencies that already exist, or that would create a
+cycle, are not suggested.
+
+The suggestions are shown in a multi-select prompt with all of them selected;
+the chosen ones are added. With --no-input the suggestions are only listed,
+unless --yes is set to add them all. Use --dry-run or -o json to list them
+without prompting.
+
+Examples:
+  # Review and add suggested dependencies
+  stackeye probe deps suggest
+
+  # List suggestions without changing anything
+  stackeye probe deps suggest --dry-run
+
+  # Add every suggestion without prompting
+  stackeye probe deps suggest --yes`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runProbeDepsSuggestCmd(cmd.Context(), flags)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&flags.yes, "yes", "y", false, "add all suggestions without prompting")
+	cmd.Flags().IntVar(&flags.maxDepth, "max-depth", defaultMaxDependencyDepth, "Maximum dependency chain depth below the root probe (0 disables the check)")
+
+	return cmd
+}
+
+// runProbeDepsSuggestCmd executes the probe deps suggest command logic.
+func runProbeDepsSuggestCmd(ctx context.Context, flags *probeDepsSuggestFlags) error {
+	if flags.maxDepth < 0 {
+		return fmt.Errorf("--max-depth must be 0 or greater, got %d", flags.maxDepth)
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	orgID, err := currentOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, probeDepsSuggestTimeout)
+	defer cancel()
+
+	probes, err := fetchAllProbesForExport(reqCtx, apiClient, "", nil)
+	if err != nil {
+		return err
+	}
+	tree, err := client.GetOrganizationDependencyTree(reqCtx, apiClient, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to get dependency tree: %w", err)
+	}
+
+	g := newDependencyGraph(tree)
+	suggestions := suggestDependencies(probes, g)
+
+	if suggestListOnly(flags) {
+		if err := output.PrintIfNotEmpty(suggestions, "No dependencies to suggest."); err != nil {
+			return err
+		}
+		if GetNoInput() && !flags.yes && len(suggestions) > 0 {
+			fmt.Fprintln(os.Stderr, "\nRun with --yes to add all suggestions, or without --no-input to choose.")
+		}
+		return nil
+	}
+
+	if len(suggestions) == 0 {
+		fmt.Println("No dependencies to suggest.")
+		return nil
+	}
+
+	selected := suggestions
+	if !flags.yes {
+		selected, err = selectSuggestions(suggestions)
+		if errors.Is(err, cliinteractive.ErrCancelled) {
+			fmt.Println("Operation cancelled.")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(selected) == 0 {
+			fmt.Println("No dependencies selected.")
+			return nil
+		}
+	}
+
+	changes := make([]ProbeDepsApplyChange, 0, len(selected))
+	for _, s := range selected {
+		changes = append(changes, ProbeDepsApplyChange{
+			Action:   depsActionAdd,
+			ProbeID:  uuid.MustParse(s.ProbeID),
+			Probe:    s.Probe,
+			ParentID: uuid.MustParse(s.ParentID),
+			Parent:   s.Parent,
+			Status:   depsStatusPlanned,
+		})
+	}
+	if err := checkDependencyChanges(g, changes, flags.maxDepth); err != nil {
+		return err
+	}
+
+	failed := applyDependencyChanges(ctx, apiClient, changes)
+	for _, c := range changes {
+		if c.Status == depsStatusFailed {
+			fmt.Fprintf(os.Stderr, "Failed: %s\n", c.Error)
+		}
+	}
+	fmt.Printf("Added %d of %d dependenc(ies).\n", len(changes)-failed, len(changes))
+	if failed > 0 {
+		return fmt.Errorf("failed to add %d dependenc(ies)", failed)
+	}
+	return nil
+}
+
+// suggestListOnly reports whether suggestions should only be listed, without
+// prompting or adding them: for JSON and YAML output, dry runs, and --no-input
+// without --yes.
+func suggestListOnly(flags *probeDepsSuggestFlags) bool {
+	format := output.NewPrinter(GetConfig()).Format()
+	return format == sdkoutput.FormatJSON || format == sdkoutput.FormatYAML || GetDryRun() ||
+		(GetNoInput() && !flags.yes)
+}
+
+// selectSuggestions prompts for the suggestions to add, with all selected.
+func selectSuggestions(suggestions []ProbeDepsSuggestion) ([]ProbeDepsSuggestion, error) {
+	options := make([]string, len(suggestions))
+	byOption := make(map[string]ProbeDepsSuggestion, len(suggestions))
+	for i, s := range suggestions {
+		option := fmt.Sprintf("%s depends on %s (%s)", s.Probe, s.Parent, s.Reason)
+		if _, dup := byOption[option]; dup {
+			option = fmt.Sprintf("%s depends on %s (%s, %s)", s.Probe, s.Parent, s.Reason, s.ProbeID)
+		}
+		options[i] = option
+		byOption[option] = s
+	}
+
+	chosen, err := cliinteractive.MultiSelect("Select the dependencies to add:", options,
+		cliinteractive.WithMultiSelectDefaults(options),
+		cliinteractive.WithMultiSelectPageSize(15),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make([]ProbeDepsSuggestion, 0, len(chosen))
+	for _, option := range chosen {
+		selected = append(selected, byOption[option])
+	}
+	return selected, nil
+}
+
+// suggestDependencies proposes dependencies between probes that check the
+// same host, skipping existing dependencies and ones that would create a
+// cycle. Suggestions are sorted by probe and then parent name.
+func suggestDependencies(probes []client.Probe, g *dependencyGraph) []ProbeDepsSuggestion {
+	targets := make([]probeTarget, len(probes))
+	for i := range probes {
+		targets[i] = parseProbeTarget(&probes[i])
+	}
+
+	var suggestions []ProbeDepsSuggestion
+	for i := range probes {
+		child := targets[i]
+		if child.host == "" {
+			continue
+		}
+		for j := range probes {
+			parent := targets[j]
+			if i == j || parent.host != child.host {
+				continue
+			}
+			reason := suggestionReason(parent, child)
+			if reason == "" {
+				continue
+			}
+			childID, parentID := probes[i].ID, probes[j].ID
+			if slices.Contains(g.parents[childID], parentID) || g.path(childID, parentID) != nil {
+				continue
+			}
+			suggestions = append(suggestions, ProbeDepsSuggestion{
+				Probe:    probes[i].Name,
+				ProbeID:  childID.String(),
+				Parent:   probes[j].Name,
+				ParentID: parentID.String(),
+				Reason:   reason,
+			})
+		}
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Probe != suggestions[j].Probe {
+			return suggestions[i].Probe < suggestions[j].Probe
+		}
+		return suggestions[i].Parent < suggestions[j].Parent
+	})
+	return suggestions
+}
+
+// suggestionReason returns why a probe on the same host as child should be
+// its parent, or "" if it should not.
+func suggestionReason(parent, child probeTarget) string {
+	switch parent.checkType {
+	case "dns_resolve":
+		if child.checkType != "dns_resolve" {
+			return "DNS for " + child.host
+		}
+	case "ping":
+		if child.checkType == "tcp" || child.checkType == "http" {
+			return "ping " + child.host
+		}
+	case "tcp":
+		if child.checkType == "http" && parent.port != "" && parent.port == child.port {
+			return "TCP " + net.JoinHostPort(child.host, child.port)
+		}
+	}
+	return ""
+}
+
+// parseProbeTarget extracts the host and port a probe checks. HTTP probes
+// without an explicit port use the scheme's default port.
+func parseProbeTarget(p *client.Probe) probeTarget {
+	t := probeTarget{checkType: strings.ToLower(string(p.CheckType))}
+	target := strings.TrimSpace(p.URL)
+
+	if strings.Contains(target, "://") {
+		u, err := url.Parse(target)
+		if err != nil {
+			return t
+		}
+		t.host, t.port = u.Hostname(), u.Port()
+		if t.port == "" && t.checkType == "http" {
+			switch strings.ToLower(u.Scheme) {
+			case "https":
+				t.port = "443"
+			case "http":
+				t.port = "80"
+			}
+		}
+	} else if host, port, err := net.SplitHostPort(target); err == nil {
+		t.host, t.port = host, port
+	} else {
+		t.host = target
+	}
+
+	t.host = strings.TrimSuffix(strings.ToLower(t.host), ".")
+	return t
+}
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/StackEye-IO/stackeye-go-sdk/config"
+	"github.com/google/uuid"
+)
+
+func TestNewProbeDepsSuggestCmd(t *testing.T) {
+	cmd := NewProbeDepsSuggestCmd()
+
+	if cmd.Use != "suggest" {
+		t.Errorf("Use = %q, want %q", cmd.Use, "suggest")
+	}
+	if cmd.Short == "" {
+		t.Error("Short description should not be empty")
+	}
+	if f := cmd.Flags().Lookup("yes"); f == nil || f.Shorthand != "y" {
+		t.Error("expected --yes/-y flag to be defined")
+	}
+	if cmd.Flags().Lookup("max-depth") == nil {
+		t.Error("expected --max-depth flag to be defined")
+	}
+}
+
+func TestRunProbeDepsSuggestCmd_NegativeMaxDepth(t *testing.T) {
+	err := runProbeDepsSuggestCmd(context.Background(), &probeDepsSuggestFlags{maxDepth: -1})
+	if err == nil {
+		t.Fatal("expected error for negative --max-depth")
+	}
+	if strings.Contains(err.Error(), "API client") {
+		t.Errorf("expected validation before API client init, got: %v", err)
+	}
+}
+
+func TestParseProbeTarget(t *testing.T) {
+	tests := []struct {
+		checkType client.CheckType
+		url       string
+		wantHost  string
+		wantPort  string
+	}{
+		{"http", "https://API.example.com/health", "api.example.com", "443"},
+		{"http", "http://api.example.com/", "api.example.com", "80"},
+		{"http", "https://api.example.com:8443/", "api.example.com", "8443"},
+		{"tcp", "db.example.com:5432", "db.example.com", "5432"},
+		{"dns_resolve", "api.example.com.", "api.example.com", ""},
+		{"ping", "10.0.0.1", "10.0.0.1", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			got := parseProbeTarget(&client.Probe{CheckType: tt.checkType, URL: tt.url})
+			if got.host != tt.wantHost || got.port != tt.wantPort {
+				t.Errorf("parseProbeTarget(%q) = %s:%s, want %s:%s", tt.url, got.host, got.port, tt.wantHost, tt.wantPort)
+			}
+		})
+	}
+}
+
+func TestSuggestDependencies(t *testing.T) {
+	dns := client.Probe{ID: uuid.New(), Name: "api-dns", CheckType: "dns_resolve", URL: "api.example.com"}
+	ping := client.Probe{ID: uuid.New(), Name: "api-ping", CheckType: "ping", URL: "api.example.com"}
+	tcp := client.Probe{ID: uuid.New(), Name: "api-tcp", CheckType: "tcp", URL: "api.example.com:443"}
+	tcpOther := client.Probe{ID: uuid.New(), Name: "api-ssh", CheckType: "tcp", URL: "api.example.com:22"}
+	web := client.Probe{ID: uuid.New(), Name: "api-health", CheckType: "http", URL: "https://api.example.com/health"}
+	other := client.Probe{ID: uuid.New(), Name: "docs", CheckType: "http", URL: "https://docs.example.com"}
+	probes := []client.Probe{dns, ping, tcp, tcpOther, web, other}
+
+	var nodes []client.DependencyTreeNode
+	for _, p := range probes {
+		nodes = append(nodes, client.DependencyTreeNode{ProbeID: p.ID, Name: p.Name})
+	}
+	// api-health already depends on api-dns
+	g := testDependencyGraph(nodes, [2]uuid.UUID{dns.ID, web.ID})
+
+	var got []string
+	for _, s := range suggestDependencies(probes, g) {
+		got = append(got, s.Probe+" <- "+s.Parent+" ("+s.Reason+")")
+	}
+	want := []string{
+		"api-health <- api-ping (ping api.example.com)",
+		"api-health <- api-tcp (TCP api.example.com:443)",
+		"api-ping <- api-dns (DNS for api.example.com)",
+		"api-ssh <- api-dns (DNS for api.example.com)",
+		"api-ssh <- api-ping (ping api.example.com)",
+		"api-tcp <- api-dns (DNS for api.example.com)",
+		"api-tcp <- api-ping (ping api.example.com)",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("suggestions:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestSuggestDependencies_SkipsCycles(t *testing.T) {
+	dns := client.Probe{ID: uuid.New(), Name: "dns", CheckType: "dns_resolve", URL: "example.com"}
+	web := client.Probe{ID: uuid.New(), Name: "web", CheckType: "http", URL: "https://example.com"}
+	g := testDependencyGraph([]client.DependencyTreeNode{
+		{ProbeID: dns.ID, Name: dns.Name},
+		{ProbeID: web.ID, Name: web.Name},
+	}, [2]uuid.UUID{web.ID, dns.ID})
+
+	if got := suggestDependencies([]client.Probe{dns, web}, g); len(got) != 0 {
+		t.Errorf("expected no suggestions when dns already depends on web, got %+v", got)
+	}
+}
+
+func TestSuggestListOnly(t *testing.T) {
+	originalDryRun, originalNoInput := dryRun, noInput
+	defer func() { dryRun, noInput = originalDryRun, originalNoInput }()
+	dryRun, noInput = false, false
+
+	setTestOutputFormat(t, config.OutputFormatTable)
+	if suggestListOnly(&probeDepsSuggestFlags{}) {
+		t.Error("expected table output to prompt for suggestions")
+	}
+
+	for _, format := range []config.OutputFormat{config.OutputFormatJSON, config.OutputFormatYAML} {
+		setTestOutputFormat(t, format)
+		if !suggestListOnly(&probeDepsSuggestFlags{}) {
+			t.Errorf("expected -o %s to only list suggestions", format)
+		}
+		if !suggestListOnly(&probeDepsSuggestFlags{yes: true}) {
+			t.Errorf("expected -o %s to only list suggestions even with --yes", format)
+		}
+	}
+
+	setTestOutputFormat(t, config.OutputFormatTable)
+	noInput = true
+	if !suggestListOnly(&probeDepsSuggestFlags{}) {
+		t.Error("expected --no-input without --yes to only list suggestions")
+	}
+	if suggestListOnly(&probeDepsSuggestFlags{yes: true}) {
+		t.Error("expected --no-input --yes to add suggestions")
+	}
+}
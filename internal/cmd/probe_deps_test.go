@@ -48,7 +48,7 @@ func TestNewProbeDepsCmd_Long(t *testing.T) {
 	}
 
 	// Should list available subcommands
-	subcommands := []string{"list", "add", "remove", "clear", "tree", "wizard"}
+	subcommands := []string{"list", "add", "remove", "clear", "tree", "impact", "validate", "apply", "suggest", "wizard"}
 	for _, sub := range subcommands {
 		if !strings.Contains(long, sub) {
 			t.Errorf("expected Long description to mention %q subcommand", sub)
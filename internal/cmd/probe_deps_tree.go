@@ -4,6 +4,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/StackEye-IO/stackeye-cli/internal/api"
@@ -33,6 +34,11 @@ func NewProbeDepsTreeCmd() *cobra.Command {
 The tree shows parent-child relationships between probes, where parent probes
 represent infrastructure that child probes depend on.
 
+Use -o dot or -o mermaid to export the full dependency graph instead. Unlike
+the tree view, probes with several parents appear once with an edge from each
+parent, and nodes are colored by status. Render DOT with Graphviz or paste the
+Mermaid output into any Markdown document that supports Mermaid diagrams.
+
 Status colors (if terminal supports colors):
   UP          Green    - Probe is healthy
   DOWN        Red      - Probe is failing
@@ -57,7 +63,16 @@ Examples:
   stackeye probe deps tree --ascii
 
   # Output as JSON for scripting
-  stackeye probe deps tree -o json`,
+  stackeye probe deps tree -o json
+
+  # Render the dependency graph with Graphviz
+  stackeye probe deps tree -o dot | dot -Tsvg > deps.svg
+
+  # Export a Mermaid diagram of a subtree for documentation
+  stackeye probe deps tree -o mermaid --probe 550e8400-e29b-41d4-a716-446655440000`,
+		Annotations: map[string]string{
+			outputFormatsAnnotation: dependencyGraphFormatDOT + "," + dependencyGraphFormatMermaid,
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runProbeDepsTreeCmd(cmd.Context(), orgID, probeID, useASCII)
 		},
@@ -101,20 +116,9 @@ func runProbeDepsTreeCmd(ctx context.Context, orgIDArg, probeIDArg string, useAS
 
 	// If no org ID provided, get it from the CLI config context
 	if parsedOrgID == uuid.Nil {
-		cfg, cfgErr := config.Load()
-		if cfgErr != nil {
-			return fmt.Errorf("failed to load config: %w", cfgErr)
-		}
-		currentCtx, ctxErr := cfg.GetCurrentContext()
-		if ctxErr != nil {
-			return fmt.Errorf("no current context: %w (run 'stackeye login' first)", ctxErr)
-		}
-		if currentCtx.OrganizationID == "" {
-			return fmt.Errorf("no organization selected. Use 'stackeye org switch <org>' to select one")
-		}
-		parsedOrgID, err = uuid.Parse(currentCtx.OrganizationID)
+		parsedOrgID, err = currentOrganizationID()
 		if err != nil {
-			return fmt.Errorf("invalid organization ID in config: %w", err)
+			return err
 		}
 	}
 
@@ -127,6 +131,14 @@ func runProbeDepsTreeCmd(ctx context.Context, orgIDArg, probeIDArg string, useAS
 		return fmt.Errorf("failed to get dependency tree: %w", err)
 	}
 
+	// Graph formats render the whole DAG rather than a tree
+	switch GetOutputFormat() {
+	case dependencyGraphFormatDOT:
+		return renderDependencyDOT(os.Stdout, newDependencyGraph(tree), parsedProbeID)
+	case dependencyGraphFormatMermaid:
+		return renderDependencyMermaid(os.Stdout, newDependencyGraph(tree), parsedProbeID)
+	}
+
 	// Check output format
 	printer := output.NewPrinter(nil)
 	format := printer.Format()
@@ -138,6 +150,26 @@ func runProbeDepsTreeCmd(ctx context.Context, orgIDArg, probeIDArg string, useAS
 	return printDependencyTree(tree, parsedProbeID, useASCII)
 }
 
+// currentOrganizationID returns the organization ID of the current CLI context.
+func currentOrganizationID() (uuid.UUID, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	currentCtx, err := cfg.GetCurrentContext()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("no current context: %w (run 'stackeye login' first)", err)
+	}
+	if currentCtx.OrganizationID == "" {
+		return uuid.Nil, fmt.Errorf("no organization selected. Use 'stackeye org switch <org>' to select one")
+	}
+	orgID, err := uuid.Parse(currentCtx.OrganizationID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid organization ID in config: %w", err)
+	}
+	return orgID, nil
+}
+
 // printDependencyTree renders the dependency tree in ASCII format.
 func printDependencyTree(tree *client.DependencyTree, startProbeID uuid.UUID, useASCII bool) error {
 	if len(tree.Nodes) == 0 {
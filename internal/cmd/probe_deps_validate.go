@@ -0,0 +1,196 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	clierrors "github.com/StackEye-IO/stackeye-cli/internal/errors"
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// probeDepsValidateTimeout is the maximum time to wait for the dependency
+// tree and probe list.
+const probeDepsValidateTimeout = 60 * time.Second
+
+// Dependency validation checks.
+const (
+	depsCheckSelfLoop     = "self-loop"
+	depsCheckCycle        = "cycle"
+	depsCheckDeletedProbe = "deleted-probe"
+	depsCheckPausedProbe  = "paused-probe"
+	depsCheckMaxDepth     = "max-depth"
+)
+
+// ProbeDepsValidateFinding is a single problem reported by probe deps validate.
+// This struct is exported to allow JSON/YAML serialization with proper field tags.
+type ProbeDepsValidateFinding struct {
+	Severity string `json:"severity" yaml:"severity" table:"SEVERITY"`
+	Check    string `json:"check" yaml:"check" table:"CHECK"`
+	Probe    string `json:"probe" yaml:"probe" table:"PROBE"`
+	Message  string `json:"message" yaml:"message" table:"MESSAGE"`
+}
+
+// NewProbeDepsValidateCmd creates and returns the probe deps validate subcommand.
+func NewProbeDepsValidateCmd() *cobra.Command {
+	var maxDepth int
+
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Check the dependency graph for problems",
+		Long: `Audit the organization's dependency graph for problems that break alert
+suppression.
+
+Checks:
+  self-loop      error    a probe depends on itself
+  cycle          error    probes depend on each other in a loop
+  deleted-probe  error    a dependency points to a probe that no longer exists
+  paused-probe   warning  a dependency points to a paused probe, so it never
+                          suppresses or is suppressed
+  max-depth      warning  a chain is deeper than --max-depth levels below its
+                          root probe (0 disables the check)
+
+Exit Codes:
+  0  No errors found (warnings are reported but do not fail)
+  1  At least one error found
+
+Examples:
+  # Validate the dependency graph
+  stackeye probe deps validate
+
+  # Also warn about chains deeper than 5 levels
+  stackeye probe deps validate --max-depth 5
+
+  # Output findings as JSON
+  stackeye probe deps validate -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runProbeDepsValidateCmd(cmd.Context(), maxDepth)
+		},
+	}
+
+	cmd.Flags().IntVar(&maxDepth, "max-depth", defaultMaxDependencyDepth, "Maximum dependency chain depth below the root probe (0 disables the check)")
+
+	return cmd
+}
+
+// runProbeDepsValidateCmd executes the probe deps validate command logic.
+func runProbeDepsValidateCmd(ctx context.Context, maxDepth int) error {
+	if maxDepth < 0 {
+		return fmt.Errorf("--max-depth must be 0 or greater, got %d", maxDepth)
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	orgID, err := currentOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, probeDepsValidateTimeout)
+	defer cancel()
+
+	tree, err := client.GetOrganizationDependencyTree(reqCtx, apiClient, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to get dependency tree: %w", err)
+	}
+
+	probes, err := fetchAllProbesForExport(reqCtx, apiClient, "", nil)
+	if err != nil {
+		return err
+	}
+
+	g := newDependencyGraph(tree)
+	findings := validateDependencyGraph(g, probes, maxDepth)
+
+	edges := 0
+	for _, children := range g.children {
+		edges += len(children)
+	}
+	if err := output.PrintIfNotEmpty(findings, fmt.Sprintf("No problems found in %d dependenc(ies)", edges)); err != nil {
+		return err
+	}
+
+	errorCount := 0
+	for _, f := range findings {
+		if f.Severity == lintSeverityError {
+			errorCount++
+		}
+	}
+	warningCount := len(findings) - errorCount
+	if len(findings) > 0 {
+		fmt.Fprintf(os.Stderr, "\n%d error(s), %d warning(s) in %d dependenc(ies)\n", errorCount, warningCount, edges)
+	}
+	if errorCount > 0 {
+		return clierrors.WithExitCode(clierrors.ExitError, nil)
+	}
+	return nil
+}
+
+// validateDependencyGraph audits the dependency graph against the current
+// probes. Probes missing from probes are treated as deleted.
+func validateDependencyGraph(g *dependencyGraph, probes []client.Probe, maxDepth int) []ProbeDepsValidateFinding {
+	existing := make(map[uuid.UUID]*client.Probe, len(probes))
+	for i := range probes {
+		existing[probes[i].ID] = &probes[i]
+	}
+
+	var findings []ProbeDepsValidateFinding
+	add := func(severity, check string, probe uuid.UUID, format string, args ...any) {
+		findings = append(findings, ProbeDepsValidateFinding{
+			Severity: severity,
+			Check:    check,
+			Probe:    g.name(probe),
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+	paused := func(id uuid.UUID) bool {
+		p, ok := existing[id]
+		return ok && strings.EqualFold(p.Status, "paused")
+	}
+
+	for _, id := range g.ids() {
+		for _, parent := range g.parents[id] {
+			switch {
+			case parent == id:
+				add(lintSeverityError, depsCheckSelfLoop, id, "depends on itself")
+			case existing[id] == nil:
+				add(lintSeverityError, depsCheckDeletedProbe, id, "deleted probe still depends on %q", g.name(parent))
+			case existing[parent] == nil:
+				add(lintSeverityError, depsCheckDeletedProbe, id, "depends on deleted probe %q", g.name(parent))
+			case paused(parent):
+				add(lintSeverityWarning, depsCheckPausedProbe, id, "depends on paused probe %q, so its alerts are never suppressed", g.name(parent))
+			case paused(id):
+				add(lintSeverityWarning, depsCheckPausedProbe, id, "is paused, so its dependency on %q has no effect", g.name(parent))
+			}
+		}
+	}
+
+	for _, cycle := range g.cycles() {
+		add(lintSeverityError, depsCheckCycle, cycle[0], "dependency cycle: %s",
+			g.formatChain(append(cycle, cycle[0])))
+	}
+
+	if maxDepth > 0 {
+		for _, id := range g.ids() {
+			chain := g.ancestorChain(id)
+			if len(chain)-1 != maxDepth+1 {
+				continue
+			}
+			add(lintSeverityWarning, depsCheckMaxDepth, id, "is %d levels below its root probe (max %d): %s",
+				len(chain)-1, maxDepth, g.formatChain(chain))
+		}
+	}
+
+	return findings
+}
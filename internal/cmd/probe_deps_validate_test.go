@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+)
+
+func TestNewProbeDepsValidateCmd(t *testing.T) {
+	cmd := NewProbeDepsValidateCmd()
+
+	if cmd.Use != "validate" {
+		t.Errorf("Use = %q, want %q", cmd.Use, "validate")
+	}
+	if cmd.Short == "" {
+		t.Error("Short description should not be empty")
+	}
+	if err := cmd.Args(cmd, []string{"extra"}); err == nil {
+		t.Error("expected error with arguments")
+	}
+	flag := cmd.Flags().Lookup("max-depth")
+	if flag == nil {
+		t.Fatal("expected --max-depth flag to be defined")
+	}
+	if flag.DefValue != "10" {
+		t.Errorf("--max-depth default = %q, want 10", flag.DefValue)
+	}
+}
+
+func TestRunProbeDepsValidateCmd_NegativeMaxDepth(t *testing.T) {
+	err := runProbeDepsValidateCmd(context.Background(), -1)
+	if err == nil {
+		t.Fatal("expected error for negative --max-depth")
+	}
+	if strings.Contains(err.Error(), "API client") {
+		t.Errorf("expected validation before API client init, got: %v", err)
+	}
+}
+
+func TestValidateDependencyGraph(t *testing.T) {
+	db := uuid.New()
+	api := uuid.New()
+	web := uuid.New()
+	loop := uuid.New()
+	paused := uuid.New()
+	worker := uuid.New()
+	deleted := uuid.New()
+
+	g := testDependencyGraph([]client.DependencyTreeNode{
+		{ProbeID: db, Name: "Database", Status: "up"},
+		{ProbeID: api, Name: "API", Status: "up"},
+		{ProbeID: web, Name: "Web", Status: "up"},
+		{ProbeID: loop, Name: "Loop", Status: "up"},
+		{ProbeID: paused, Name: "Queue", Status: "paused"},
+		{ProbeID: worker, Name: "Worker", Status: "up"},
+	},
+		[2]uuid.UUID{db, api},
+		[2]uuid.UUID{api, web},
+		[2]uuid.UUID{web, db},
+		[2]uuid.UUID{loop, loop},
+		[2]uuid.UUID{paused, worker},
+		[2]uuid.UUID{deleted, worker},
+	)
+	probes := []client.Probe{
+		{ID: db, Name: "Database", Status: "up"},
+		{ID: api, Name: "API", Status: "up"},
+		{ID: web, Name: "Web", Status: "up"},
+		{ID: loop, Name: "Loop", Status: "up"},
+		{ID: paused, Name: "Queue", Status: "paused"},
+		{ID: worker, Name: "Worker", Status: "up"},
+	}
+
+	findings := validateDependencyGraph(g, probes, 0)
+
+	byCheck := make(map[string][]ProbeDepsValidateFinding)
+	for _, f := range findings {
+		byCheck[f.Check] = append(byCheck[f.Check], f)
+	}
+	if len(findings) != 4 {
+		t.Fatalf("got %d findings, want 4: %+v", len(findings), findings)
+	}
+	if f := byCheck[depsCheckSelfLoop]; len(f) != 1 || f[0].Probe != "Loop" || f[0].Severity != lintSeverityError {
+		t.Errorf("self-loop findings = %+v", f)
+	}
+	if f := byCheck[depsCheckCycle]; len(f) != 1 || f[0].Message != "dependency cycle: API -> Web -> Database -> API" {
+		t.Errorf("cycle findings = %+v", f)
+	}
+	if f := byCheck[depsCheckDeletedProbe]; len(f) != 1 || !strings.Contains(f[0].Message, deleted.String()) {
+		t.Errorf("deleted-probe findings = %+v", f)
+	}
+	if f := byCheck[depsCheckPausedProbe]; len(f) != 1 || f[0].Severity != lintSeverityWarning || f[0].Probe != "Worker" {
+		t.Errorf("paused-probe findings = %+v", f)
+	}
+}
+
+func TestValidateDependencyGraph_MaxDepth(t *testing.T) {
+	ids := make([]uuid.UUID, 5)
+	nodes := make([]client.DependencyTreeNode, 5)
+	probes := make([]client.Probe, 5)
+	var edges [][2]uuid.UUID
+	for i := range ids {
+		ids[i] = uuid.New()
+		name := string(rune('A' + i))
+		nodes[i] = client.DependencyTreeNode{ProbeID: ids[i], Name: name, Status: "up"}
+		probes[i] = client.Probe{ID: ids[i], Name: name, Status: "up"}
+		if i > 0 {
+			edges = append(edges, [2]uuid.UUID{ids[i-1], ids[i]})
+		}
+	}
+	g := testDependencyGraph(nodes, edges...)
+
+	findings := validateDependencyGraph(g, probes, 2)
+	if len(findings) != 1 {
+		t.Fatalf("got %d findings, want 1: %+v", len(findings), findings)
+	}
+	f := findings[0]
+	if f.Check != depsCheckMaxDepth || f.Probe != "D" {
+		t.Errorf("finding = %+v, want max-depth on D", f)
+	}
+	if !strings.Contains(f.Message, "A -> B -> C -> D") {
+		t.Errorf("message %q should show the chain", f.Message)
+	}
+
+	if findings := validateDependencyGraph(g, probes, 0); len(findings) != 0 {
+		t.Errorf("expected no findings with the depth check disabled, got %+v", findings)
+	}
+}
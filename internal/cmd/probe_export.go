@@ -115,6 +115,9 @@ Examples:
 	cmd.Flags().StringVarP(&flags.status, "status", "s", "", "filter by status: up, down, degraded, paused, pending")
 	cmd.Flags().StringVarP(&flags.labels, "labels", "l", "", "filter by labels: key=value,key2=value2 (AND logic)")
 
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("labels", LabelFilterCompletion())
+
 	return cmd
 }
 
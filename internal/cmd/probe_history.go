@@ -23,6 +23,7 @@ type probeHistoryFlags struct {
 	region string
 	status string
 	page   int
+	chart  bool
 }
 
 // ProbeHistoryOutput wraps probe results for output formatting.
@@ -70,6 +71,14 @@ Status Filtering:
     success - Only show successful checks
     failure - Only show failed checks
 
+Charts:
+  Use --chart to draw response time over the --since window (default 24h)
+  instead of listing checks: a braille line chart of all regions combined,
+  annotated with min/avg/p95/max, followed by a sparkline per region. Windows
+  up to 7 days use hourly buckets, longer windows daily buckets. --region
+  limits the sparklines to one region; --limit, --page and --status are
+  ignored.
+
 The probe can be specified by UUID or by name. If the name matches multiple
 probes, you'll be prompted to use the UUID instead.
 
@@ -93,7 +102,10 @@ Examples:
   stackeye probe history "Production API" --region us-east-1
 
   # Output as JSON for scripting
-  stackeye probe history 550e8400-e29b-41d4-a716-446655440000 -o json`,
+  stackeye probe history 550e8400-e29b-41d4-a716-446655440000 -o json
+
+  # Chart response time over the last 3 days
+  stackeye probe history "Production API" --since 3d --chart`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runProbeHistory(cmd.Context(), args[0], flags)
@@ -106,6 +118,10 @@ Examples:
 	cmd.Flags().StringVar(&flags.region, "region", "", "filter by region")
 	cmd.Flags().StringVar(&flags.status, "status", "", "filter by status: success, failure")
 	cmd.Flags().IntVar(&flags.page, "page", 1, "page number for pagination")
+	cmd.Flags().BoolVar(&flags.chart, "chart", false, "chart response time over the --since window with per-region sparklines")
+
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("region", RegionCompletion())
 
 	return cmd
 }
@@ -156,6 +172,10 @@ func runProbeHistory(ctx context.Context, idArg string, flags *probeHistoryFlags
 		return err
 	}
 
+	if flags.chart {
+		return runProbeChart(ctx, apiClient, probeHistoryChartRequest(probeID, flags, time.Now()))
+	}
+
 	// Build options for the API call
 	opts := &client.ListProbeResultsOptions{
 		Page:   flags.page,
@@ -185,6 +205,31 @@ func runProbeHistory(ctx context.Context, idArg string, flags *probeHistoryFlags
 	return output.Print(historyOutput)
 }
 
+// probeHistoryChartRequest builds the chart request for --chart. The window
+// is --since (default 24h), bucketed hourly up to 7 days and daily beyond.
+func probeHistoryChartRequest(probeID uuid.UUID, flags *probeHistoryFlags, now time.Time) probeChartRequest {
+	period := flags.since
+	if period == "" {
+		period = "24h"
+	}
+	// Already validated by runProbeHistory
+	window, _ := parseSinceDuration(period)
+
+	aggregate := "1h"
+	if window > 7*24*time.Hour {
+		aggregate = "1d"
+	}
+
+	return probeChartRequest{
+		probeID:   probeID,
+		period:    period,
+		aggregate: aggregate,
+		from:      now.Add(-window),
+		to:        now,
+		region:    flags.region,
+	}
+}
+
 // parseSinceDuration parses a duration string like "1h", "24h", "7d", "30d" into a time.Duration.
 // Returns the duration and any parsing error.
 func parseSinceDuration(since string) (time.Duration, error) {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/StackEye-IO/stackeye-cli/internal/api"
 	"github.com/StackEye-IO/stackeye-cli/internal/dryrun"
+	cliinteractive "github.com/StackEye-IO/stackeye-cli/internal/interactive"
 	"github.com/StackEye-IO/stackeye-cli/internal/output"
 	"github.com/StackEye-IO/stackeye-go-sdk/client"
 	"github.com/spf13/cobra"
@@ -18,13 +19,21 @@ import (
 // probeLabelTimeout is the maximum time to wait for the API response.
 const probeLabelTimeout = 30 * time.Second
 
+// probeLabelFlags holds the flag values for the probe label command.
+type probeLabelFlags struct {
+	labels string // Label selector used instead of a probe ID
+	yes    bool   // Skip confirmation prompt (selector mode only)
+}
+
 // NewProbeLabelCmd creates and returns the probe label subcommand.
 // Task #8068
 func NewProbeLabelCmd() *cobra.Command {
+	flags := &probeLabelFlags{}
+
 	cmd := &cobra.Command{
 		Use:               "label <probe-id> <labels...>",
 		Short:             "Add labels to a probe",
-		ValidArgsFunction: ProbeCompletion(),
+		ValidArgsFunction: LeadingArgCompletion(ProbeCompletion(), LabelCompletion()),
 		Long: `Add or update labels on a probe.
 
 Labels are added/merged with existing labels. If a label key already exists on
@@ -38,6 +47,10 @@ Labels can be specified in two formats:
 The probe can be specified by UUID or by name. If the name matches multiple
 probes, you'll be prompted to use the UUID instead.
 
+To label many probes at once, omit the probe ID and use --labels to select
+every probe matching a label selector (key=value, key!=value or key). The
+matched probes are listed and you are asked to confirm before any change.
+
 Examples:
   # Add environment and tier labels
   stackeye probe label api-health env=production tier=backend
@@ -52,13 +65,25 @@ Examples:
   stackeye probe label 550e8400-e29b-41d4-a716-446655440000 env=dev
 
   # Update an existing label value
-  stackeye probe label api-health env=production  # changes env from staging to production`,
-		Args: cobra.MinimumNArgs(2),
+  stackeye probe label api-health env=production  # changes env from staging to production
+
+  # Add an owner label to every staging probe
+  stackeye probe label -l env=staging team=web`,
+		Args: probeSelectorArgs(cobra.MinimumNArgs(1), cobra.MinimumNArgs(2)),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if flags.labels != "" {
+				return runProbeLabelBySelector(cmd.Context(), args, flags)
+			}
 			return runProbeLabel(cmd.Context(), args[0], args[1:])
 		},
 	}
 
+	cmd.Flags().StringVarP(&flags.labels, "labels", "l", "", probeSelectorUsage)
+	cmd.Flags().BoolVarP(&flags.yes, "yes", "y", false, "skip confirmation prompt when using --labels")
+
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("labels", LabelFilterCompletion())
+
 	return cmd
 }
 
@@ -73,17 +98,9 @@ func runProbeLabel(ctx context.Context, probeIDArg string, labelArgs []string) e
 
 	// Dry-run check: print what would happen and exit without making API calls
 	if GetDryRun() {
-		labelStrs := make([]string, len(labels))
-		for i, l := range labels {
-			if l.Value != nil {
-				labelStrs[i] = fmt.Sprintf("%s=%s", l.Key, *l.Value)
-			} else {
-				labelStrs[i] = l.Key
-			}
-		}
 		dryrun.PrintAction("add labels to", "probe",
 			"Probe", probeIDArg,
-			"Labels", strings.Join(labelStrs, ", "),
+			"Labels", formatLabelInputs(labels),
 		)
 		return nil
 	}
@@ -113,6 +130,89 @@ func runProbeLabel(ctx context.Context, probeIDArg string, labelArgs []string) e
 	return output.PrintProbeLabels(result.Labels)
 }
 
+// runProbeLabelBySelector adds labels to every probe matching the --labels
+// selector after previewing the matches and asking for confirmation.
+func runProbeLabelBySelector(ctx context.Context, labelArgs []string, flags *probeLabelFlags) error {
+	labels, err := parseLabelArgs(labelArgs)
+	if err != nil {
+		return err
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	probeIDs, err := resolveProbeTargets(ctx, apiClient, nil, flags.labels)
+	if err != nil {
+		return err
+	}
+	if len(probeIDs) == 0 {
+		return nil
+	}
+
+	labelStr := formatLabelInputs(labels)
+	if GetDryRun() {
+		dryrun.PrintAction("add labels to", "probe",
+			"Selector", flags.labels,
+			"Probes", strings.Join(probeIDStrings(probeIDs), ", "),
+			"Labels", labelStr,
+		)
+		return nil
+	}
+
+	message := fmt.Sprintf("Add labels %s to %d probe(s)?", labelStr, len(probeIDs))
+	confirmed, err := cliinteractive.Confirm(message, cliinteractive.WithYesFlag(flags.yes))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Label cancelled.")
+		return nil
+	}
+
+	var labelErrors []error
+	labeledCount := 0
+
+	for _, probeID := range probeIDs {
+		reqCtx, cancel := context.WithTimeout(ctx, probeLabelTimeout)
+		_, err := client.AddProbeLabels(reqCtx, apiClient, probeID, labels)
+		cancel()
+
+		if err != nil {
+			labelErrors = append(labelErrors, fmt.Errorf("failed to label probe %s: %w", probeID, err))
+			continue
+		}
+
+		labeledCount++
+		fmt.Printf("Labeled probe %s\n", probeID)
+	}
+
+	if len(labelErrors) > 0 {
+		fmt.Printf("\nLabeled %d of %d probes.\n", labeledCount, len(probeIDs))
+		for _, err := range labelErrors {
+			fmt.Printf("Error: %v\n", err)
+		}
+		return fmt.Errorf("failed to label %d probe(s)", len(labelErrors))
+	}
+
+	fmt.Printf("\nSuccessfully labeled %d probe(s) with %s.\n", labeledCount, labelStr)
+	return nil
+}
+
+// formatLabelInputs renders labels as "key=value, key" for messages.
+func formatLabelInputs(labels []client.ProbeLabelInput) string {
+	labelStrs := make([]string, len(labels))
+	for i, l := range labels {
+		if l.Value != nil {
+			labelStrs[i] = fmt.Sprintf("%s=%s", l.Key, *l.Value)
+		} else {
+			labelStrs[i] = l.Key
+		}
+	}
+	return strings.Join(labelStrs, ", ")
+}
+
 // parseLabelArgs parses command-line label arguments into ProbeLabelInput structs.
 // Accepts formats: "key=value" and "key" (key-only with no value).
 // Task #8068
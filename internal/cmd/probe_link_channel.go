@@ -4,10 +4,12 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/StackEye-IO/stackeye-cli/internal/api"
 	"github.com/StackEye-IO/stackeye-cli/internal/dryrun"
+	cliinteractive "github.com/StackEye-IO/stackeye-cli/internal/interactive"
 	"github.com/StackEye-IO/stackeye-cli/internal/output"
 	"github.com/StackEye-IO/stackeye-go-sdk/client"
 	"github.com/google/uuid"
@@ -17,18 +19,31 @@ import (
 // probeLinkChannelTimeout is the maximum time to wait for the API response.
 const probeLinkChannelTimeout = 30 * time.Second
 
+// probeLinkChannelFlags holds the flag values for the probe link-channel command.
+type probeLinkChannelFlags struct {
+	labels string // Label selector used instead of a probe ID
+	yes    bool   // Skip confirmation prompt (selector mode only)
+}
+
 // NewProbeLinkChannelCmd creates and returns the probe link-channel subcommand.
 func NewProbeLinkChannelCmd() *cobra.Command {
+	flags := &probeLinkChannelFlags{}
+
 	cmd := &cobra.Command{
 		Use:               "link-channel <probe-id> <channel-id>",
 		Short:             "Link a notification channel to a probe",
-		ValidArgsFunction: ProbeCompletion(),
+		ValidArgsFunction: PositionalCompletion(ProbeCompletion(), ChannelCompletion()),
 		Long: `Link a notification channel to a probe for alert notifications.
 
 The probe can be specified by UUID or by name. If the name matches multiple
 probes, you'll be prompted to use the UUID instead. The channel must still
 be specified by UUID.
 
+To link a channel to many probes at once, omit the probe ID and use --labels
+to select every probe matching a label selector (key=value, key!=value or key).
+The matched probes are listed and you are asked to confirm before any change.
+Probes where the channel is already linked are skipped.
+
 When a probe detects an issue, alerts will be sent to all linked channels.
 A probe can have multiple channels linked, and a channel can be linked
 to multiple probes.
@@ -42,14 +57,27 @@ Examples:
   stackeye probe link-channel 550e8400-e29b-41d4-a716-446655440000 \
     660e8400-e29b-41d4-a716-446655440001
 
+  # Link a channel to every production probe owned by the web team
+  stackeye probe link-channel -l env=production,team=web \
+    660e8400-e29b-41d4-a716-446655440001
+
 Use 'stackeye probe get <probe-id>' to view currently linked channels.
 Use 'stackeye channel list' to see available notification channels.`,
-		Args: cobra.ExactArgs(2),
+		Args: probeSelectorArgs(cobra.ExactArgs(1), cobra.ExactArgs(2)),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if flags.labels != "" {
+				return runProbeLinkChannelBySelector(cmd.Context(), args[0], flags)
+			}
 			return runProbeLinkChannel(cmd, args[0], args[1])
 		},
 	}
 
+	cmd.Flags().StringVarP(&flags.labels, "labels", "l", "", probeSelectorUsage)
+	cmd.Flags().BoolVarP(&flags.yes, "yes", "y", false, "skip confirmation prompt when using --labels")
+
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("labels", LabelFilterCompletion())
+
 	return cmd
 }
 
@@ -122,3 +150,115 @@ func runProbeLinkChannel(cmd *cobra.Command, probeIDArg, channelIDArg string) er
 	fmt.Printf("Successfully linked channel %q to probe %q\n\n", channel.Name, probe.Name)
 	return output.Print(updatedProbe)
 }
+
+// runProbeLinkChannelBySelector links a channel to every probe matching the
+// --labels selector after previewing the matches and asking for confirmation.
+func runProbeLinkChannelBySelector(ctx context.Context, channelIDArg string, flags *probeLinkChannelFlags) error {
+	// Parse and validate channel UUID (channels are always referenced by UUID)
+	channelID, err := uuid.Parse(channelIDArg)
+	if err != nil {
+		return fmt.Errorf("invalid channel ID %q: must be a valid UUID", channelIDArg)
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	probeIDs, err := resolveProbeTargets(ctx, apiClient, nil, flags.labels)
+	if err != nil {
+		return err
+	}
+	if len(probeIDs) == 0 {
+		return nil
+	}
+
+	if GetDryRun() {
+		dryrun.PrintAction("link channel to", "probe",
+			"Selector", flags.labels,
+			"Probes", strings.Join(probeIDStrings(probeIDs), ", "),
+			"Channel", channelIDArg,
+		)
+		return nil
+	}
+
+	// Validate channel exists once before touching any probe
+	chCtx, chCancel := context.WithTimeout(ctx, probeLinkChannelTimeout)
+	channel, err := client.GetChannel(chCtx, apiClient, channelID)
+	chCancel()
+	if err != nil {
+		return fmt.Errorf("failed to get channel: %w", err)
+	}
+
+	message := fmt.Sprintf("Link channel %q to %d probe(s)?", channel.Name, len(probeIDs))
+	confirmed, err := cliinteractive.Confirm(message, cliinteractive.WithYesFlag(flags.yes))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Link cancelled.")
+		return nil
+	}
+
+	var linkErrors []error
+	linkedCount := 0
+	skippedCount := 0
+
+	for _, probeID := range probeIDs {
+		reqCtx, cancel := context.WithTimeout(ctx, probeLinkChannelTimeout)
+		probe, err := client.GetProbe(reqCtx, apiClient, probeID, "")
+		if err != nil {
+			cancel()
+			linkErrors = append(linkErrors, fmt.Errorf("probe %s: %w", probeID, err))
+			continue
+		}
+
+		if containsChannelID(probe.AlertChannelIDs, channelID) {
+			fmt.Printf("Skipped probe %q: channel already linked\n", probe.Name)
+			cancel()
+			skippedCount++
+			continue
+		}
+
+		newChannelIDs := make([]uuid.UUID, len(probe.AlertChannelIDs)+1)
+		copy(newChannelIDs, probe.AlertChannelIDs)
+		newChannelIDs[len(probe.AlertChannelIDs)] = channelID
+
+		_, err = client.UpdateProbe(reqCtx, apiClient, probeID, &client.UpdateProbeRequest{
+			AlertChannelIDs: newChannelIDs,
+		})
+		cancel()
+		if err != nil {
+			linkErrors = append(linkErrors, fmt.Errorf("probe %s: %w", probeID, err))
+			continue
+		}
+
+		linkedCount++
+		fmt.Printf("Linked channel to probe %q\n", probe.Name)
+	}
+
+	if len(linkErrors) > 0 {
+		fmt.Printf("\nLinked channel to %d of %d probes.\n", linkedCount, len(probeIDs))
+		for _, err := range linkErrors {
+			fmt.Printf("Error: %v\n", err)
+		}
+		return fmt.Errorf("failed to link channel to %d probe(s)", len(linkErrors))
+	}
+
+	fmt.Printf("\nSuccessfully linked channel %q to %d probe(s)", channel.Name, linkedCount)
+	if skippedCount > 0 {
+		fmt.Printf(" (%d skipped)", skippedCount)
+	}
+	fmt.Println(".")
+	return nil
+}
+
+// containsChannelID reports whether channelID is in ids.
+func containsChannelID(ids []uuid.UUID, channelID uuid.UUID) bool {
+	for _, id := range ids {
+		if id == channelID {
+			return true
+		}
+	}
+	return false
+}
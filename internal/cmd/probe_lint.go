@@ -0,0 +1,411 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	clierrors "github.com/StackEye-IO/stackeye-cli/internal/errors"
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-cli/internal/probecheck"
+	"github.com/spf13/cobra"
+)
+
+// probeLintTimeout is the maximum time to wait for probes to be listed with --live.
+const probeLintTimeout = 60 * time.Second
+
+// Lint finding severities.
+const (
+	lintSeverityError   = "error"
+	lintSeverityWarning = "warning"
+)
+
+// probeLintRule describes a lint rule.
+type probeLintRule struct {
+	ID       string
+	Name     string
+	Severity string
+}
+
+// Probe lint rules. IDs are stable so they can be referenced from --ignore
+// and CI configuration.
+var (
+	lintRuleJSONPathSyntax      = probeLintRule{"PL001", "json-path-syntax", lintSeverityError}
+	lintRuleJSONPathUnsupported = probeLintRule{"PL002", "json-path-unsupported", lintSeverityWarning}
+	lintRuleContentCheckOnHead  = probeLintRule{"PL003", "content-check-on-head", lintSeverityError}
+	lintRuleBodyOnGet           = probeLintRule{"PL004", "body-on-get", lintSeverityWarning}
+	lintRuleTimeoutInterval     = probeLintRule{"PL005", "timeout-exceeds-interval", lintSeverityError}
+	lintRuleSSLOnHTTP           = probeLintRule{"PL006", "ssl-check-on-http", lintSeverityWarning}
+	lintRuleDuplicateURL        = probeLintRule{"PL007", "duplicate-url", lintSeverityWarning}
+	lintRuleNoAlertChannels     = probeLintRule{"PL008", "no-alert-channels", lintSeverityWarning}
+	lintRulePlaintextSecret     = probeLintRule{"PL009", "plaintext-credentials", lintSeverityError}
+)
+
+// probeLintRules lists every rule, in ID order.
+var probeLintRules = []probeLintRule{
+	lintRuleJSONPathSyntax,
+	lintRuleJSONPathUnsupported,
+	lintRuleContentCheckOnHead,
+	lintRuleBodyOnGet,
+	lintRuleTimeoutInterval,
+	lintRuleSSLOnHTTP,
+	lintRuleDuplicateURL,
+	lintRuleNoAlertChannels,
+	lintRulePlaintextSecret,
+}
+
+// ProbeLintFinding is a single problem reported by probe lint.
+// This struct is exported to allow JSON/YAML serialization with proper field tags.
+type ProbeLintFinding struct {
+	Severity string `json:"severity" yaml:"severity" table:"SEVERITY"`
+	Rule     string `json:"rule" yaml:"rule" table:"RULE"`
+	Name     string `json:"name" yaml:"name" table:"NAME,wide"`
+	Probe    string `json:"probe" yaml:"probe" table:"PROBE"`
+	Message  string `json:"message" yaml:"message" table:"MESSAGE"`
+}
+
+// probeLintFlags holds the flag values for the probe lint command.
+type probeLintFlags struct {
+	file   string
+	format string
+	live   bool
+	failOn string
+	ignore []string
+}
+
+// NewProbeLintCmd creates and returns the probe lint subcommand.
+func NewProbeLintCmd() *cobra.Command {
+	flags := &probeLintFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Check probe configurations for common mistakes",
+		Long: `Check probe configurations for mistakes the API accepts but that make a
+probe unreliable, noisy or insecure.
+
+Lint a file in the "probe export" format with --file, or every existing probe
+with --live. Each finding has a rule ID and a severity; by default the command
+exits with 1 only when there are errors, so CI can block on errors and report
+warnings.
+
+Rules:
+  PL001  json-path-syntax           error    json_path_check is not valid JSONPath
+  PL002  json-path-unsupported      warning  json_path_check uses filters, slices,
+                                             unions or recursive descent, which
+                                             "probe check" cannot evaluate
+  PL003  content-check-on-head      error    keyword or JSONPath check on a HEAD
+                                             request, which has no body
+  PL004  body-on-get                warning  request body on a GET or HEAD request
+  PL005  timeout-exceeds-interval   error    timeout is longer than the interval
+  PL006  ssl-check-on-http          warning  SSL check enabled for a non-HTTPS URL
+  PL007  duplicate-url              warning  another probe checks the same URL
+  PL008  no-alert-channels          warning  no alert channels linked
+  PL009  plaintext-credentials      error    credentials in headers or the URL
+
+Exit Codes:
+  0  No findings at or above the --fail-on severity
+  1  At least one finding at or above the --fail-on severity
+
+Examples:
+  # Lint a probe file before importing it
+  stackeye probe lint -f probes.yaml
+
+  # Lint all existing probes
+  stackeye probe lint --live
+
+  # Fail CI on warnings too, but ignore missing alert channels
+  stackeye probe lint -f probes.yaml --fail-on warning --ignore PL008
+
+  # Output findings as JSON
+  stackeye probe lint -f probes.yaml -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runProbeLint(cmd.Context(), flags)
+		},
+	}
+
+	cmd.Flags().StringVarP(&flags.file, "file", "f", "", "probe configuration file to lint (YAML or JSON)")
+	cmd.Flags().StringVar(&flags.format, "format", "", "file format: yaml, json (auto-detected from extension)")
+	cmd.Flags().BoolVar(&flags.live, "live", false, "lint all existing probes instead of a file")
+	cmd.Flags().StringVar(&flags.failOn, "fail-on", lintSeverityError, "exit with 1 on findings of this severity or higher: error, warning, none")
+	cmd.Flags().StringSliceVar(&flags.ignore, "ignore", nil, "rule IDs or names to skip (comma-separated)")
+
+	cmd.MarkFlagsMutuallyExclusive("file", "live")
+
+	return cmd
+}
+
+// runProbeLint executes the probe lint command logic.
+func runProbeLint(ctx context.Context, flags *probeLintFlags) error {
+	if flags.file == "" && !flags.live {
+		return fmt.Errorf("either --file or --live is required")
+	}
+	switch flags.failOn {
+	case lintSeverityError, lintSeverityWarning, "none":
+	default:
+		return clierrors.InvalidValueError("--fail-on", flags.failOn, []string{lintSeverityError, lintSeverityWarning, "none"})
+	}
+	ignore, err := parseLintIgnore(flags.ignore)
+	if err != nil {
+		return err
+	}
+
+	var configs []probeExportConfig
+	if flags.live {
+		configs, err = fetchLiveProbeConfigs(ctx)
+	} else {
+		configs, err = readLintConfigs(flags.file, flags.format)
+	}
+	if err != nil {
+		return err
+	}
+
+	findings := lintProbeConfigs(configs, ignore)
+	if err := output.PrintIfNotEmpty(findings, fmt.Sprintf("No problems found in %d probe(s)", len(configs))); err != nil {
+		return err
+	}
+
+	errorCount, warningCount := countLintFindings(findings)
+	if len(findings) > 0 {
+		fmt.Fprintf(os.Stderr, "\n%d error(s), %d warning(s) in %d probe(s)\n", errorCount, warningCount, len(configs))
+	}
+
+	if (errorCount > 0 && flags.failOn != "none") || (warningCount > 0 && flags.failOn == lintSeverityWarning) {
+		return clierrors.WithExitCode(clierrors.ExitError, nil)
+	}
+	return nil
+}
+
+// parseLintIgnore converts --ignore values (rule IDs or names) to rule IDs.
+func parseLintIgnore(values []string) (map[string]bool, error) {
+	ignore := make(map[string]bool, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		found := false
+		for _, rule := range probeLintRules {
+			if strings.EqualFold(v, rule.ID) || strings.EqualFold(v, rule.Name) {
+				ignore[rule.ID] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown lint rule %q in --ignore", v)
+		}
+	}
+	return ignore, nil
+}
+
+// readLintConfigs reads probe definitions from a file for linting.
+func readLintConfigs(file, flagFormat string) ([]probeExportConfig, error) {
+	format, err := resolveImportFormat(file, flagFormat)
+	if err != nil {
+		return nil, err
+	}
+	return readProbeConfigs(file, format)
+}
+
+// fetchLiveProbeConfigs lists every probe and converts it to the export format.
+func fetchLiveProbeConfigs(ctx context.Context) ([]probeExportConfig, error) {
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, probeLintTimeout)
+	defer cancel()
+
+	probes, err := fetchAllProbesForExport(reqCtx, apiClient, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	configs := make([]probeExportConfig, 0, len(probes))
+	for i := range probes {
+		configs = append(configs, convertProbeToExportConfig(&probes[i]))
+	}
+	return configs, nil
+}
+
+// lintProbeConfigs runs every rule not in ignore against the configurations.
+func lintProbeConfigs(configs []probeExportConfig, ignore map[string]bool) []ProbeLintFinding {
+	var findings []ProbeLintFinding
+	add := func(rule probeLintRule, probe, format string, args ...any) {
+		if ignore[rule.ID] {
+			return
+		}
+		findings = append(findings, ProbeLintFinding{
+			Severity: rule.Severity,
+			Rule:     rule.ID,
+			Name:     rule.Name,
+			Probe:    probe,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	firstByURL := make(map[string]string)
+	for i := range configs {
+		cfg := &configs[i]
+		probe := cfg.Name
+		if probe == "" {
+			probe = fmt.Sprintf("#%d", i+1)
+		}
+		lintProbeConfig(cfg, probe, add)
+
+		key := lintURLKey(cfg)
+		if first, ok := firstByURL[key]; ok {
+			add(lintRuleDuplicateURL, probe, "checks the same URL as %q", first)
+		} else {
+			firstByURL[key] = probe
+		}
+	}
+
+	return findings
+}
+
+// lintProbeConfig runs the per-probe rules against a single configuration.
+func lintProbeConfig(cfg *probeExportConfig, probe string, add func(rule probeLintRule, probe, format string, args ...any)) {
+	checkType := cfg.CheckType
+	if checkType == "" {
+		checkType = "http"
+	}
+	method := strings.ToUpper(cfg.Method)
+	if method == "" {
+		method = "GET"
+	}
+	isHTTP := checkType == "http"
+
+	if cfg.JSONPathCheck != nil && *cfg.JSONPathCheck != "" {
+		if _, err := probecheck.ParseJSONPath(*cfg.JSONPathCheck); err != nil {
+			if errors.Is(err, probecheck.ErrUnsupportedJSONPath) {
+				add(lintRuleJSONPathUnsupported, probe, "%v", err)
+			} else {
+				add(lintRuleJSONPathSyntax, probe, "%v", err)
+			}
+		}
+	}
+
+	if isHTTP && method == "HEAD" {
+		if cfg.KeywordCheck != nil && *cfg.KeywordCheck != "" {
+			add(lintRuleContentCheckOnHead, probe, "keyword check on a HEAD request always sees an empty body")
+		}
+		if cfg.JSONPathCheck != nil && *cfg.JSONPathCheck != "" {
+			add(lintRuleContentCheckOnHead, probe, "JSONPath check on a HEAD request always sees an empty body")
+		}
+	}
+
+	if isHTTP && (method == "GET" || method == "HEAD") && cfg.Body != nil && *cfg.Body != "" {
+		add(lintRuleBodyOnGet, probe, "request body is set on a %s request; many servers ignore or reject it", method)
+	}
+
+	timeoutMs := cfg.TimeoutMs
+	if timeoutMs == 0 {
+		timeoutMs = 10000
+	}
+	intervalSeconds := cfg.IntervalSeconds
+	if intervalSeconds == 0 {
+		intervalSeconds = 60
+	}
+	if timeoutMs > intervalSeconds*1000 {
+		add(lintRuleTimeoutInterval, probe, "timeout %ds is longer than the %ds check interval", timeoutMs/1000, intervalSeconds)
+	}
+
+	if isHTTP && cfg.SSLCheckEnabled {
+		if u, err := url.Parse(cfg.URL); err == nil && !strings.EqualFold(u.Scheme, "https") {
+			add(lintRuleSSLOnHTTP, probe, "SSL check is enabled but %s is not an HTTPS URL", cfg.URL)
+		}
+	}
+
+	if len(cfg.AlertChannelIDs) == 0 {
+		add(lintRuleNoAlertChannels, probe, "no alert channels are linked, so failures notify nobody")
+	}
+
+	for _, name := range sortedHeaderNames(cfg.Headers) {
+		if isCredentialHeader(name) && isPlaintextSecret(cfg.Headers[name]) {
+			add(lintRulePlaintextSecret, probe, "header %q contains a plaintext credential", name)
+		}
+	}
+	if u, err := url.Parse(cfg.URL); err == nil && u.User != nil {
+		if _, hasPassword := u.User.Password(); hasPassword {
+			add(lintRulePlaintextSecret, probe, "URL contains a password")
+		}
+	}
+}
+
+// lintURLKey normalizes a probe target for duplicate detection. Probes with
+// the same URL but a different check type or method are not duplicates.
+func lintURLKey(cfg *probeExportConfig) string {
+	target := strings.TrimSpace(cfg.URL)
+	if u, err := url.Parse(target); err == nil && u.Host != "" {
+		u.Scheme = strings.ToLower(u.Scheme)
+		u.Host = strings.ToLower(u.Host)
+		if u.Path == "/" {
+			u.Path = ""
+		}
+		target = u.String()
+	} else {
+		target = strings.ToLower(target)
+	}
+	return strings.Join([]string{cfg.CheckType, strings.ToUpper(cfg.Method), target}, " ")
+}
+
+// credentialHeaderHints are substrings of header names that carry credentials.
+var credentialHeaderHints = []string{"authorization", "cookie", "token", "secret", "password", "api-key", "apikey", "api_key"}
+
+// isCredentialHeader reports whether a header name usually carries a credential.
+func isCredentialHeader(name string) bool {
+	lower := strings.ToLower(name)
+	for _, hint := range credentialHeaderHints {
+		if strings.Contains(lower, hint) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPlaintextSecret reports whether a header value looks like a literal
+// secret rather than a placeholder or a redacted value.
+func isPlaintextSecret(value string) bool {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return false
+	}
+	if strings.Contains(value, "${") || strings.Contains(value, "{{") {
+		return false
+	}
+	if strings.Trim(value, "*") == "" || strings.EqualFold(value, "redacted") || strings.EqualFold(value, "[redacted]") {
+		return false
+	}
+	return true
+}
+
+// sortedHeaderNames returns header names in a stable order.
+func sortedHeaderNames(headers map[string]string) []string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// countLintFindings counts findings by severity.
+func countLintFindings(findings []ProbeLintFinding) (errorCount, warningCount int) {
+	for _, f := range findings {
+		if f.Severity == lintSeverityError {
+			errorCount++
+		} else {
+			warningCount++
+		}
+	}
+	return errorCount, warningCount
+}
@@ -4,6 +4,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -89,6 +90,9 @@ Examples:
 	cmd.Flags().StringVarP(&flags.period, "period", "p", "", "include uptime stats for period: 24h, 7d, 30d")
 	cmd.Flags().StringVarP(&flags.labels, "labels", "l", "", "filter by labels: key=value,key2=value2 (AND logic)")
 
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("labels", LabelFilterCompletion())
+
 	return cmd
 }
 
@@ -168,13 +172,21 @@ func runProbeList(ctx context.Context, flags *probeListFlags) error {
 		return output.PrintEmpty("No probes found. Create one with 'stackeye probe create'")
 	}
 
-	// Task #8070: Show count message when label filters are applied
+	labels := ""
 	if len(labelFilters) > 0 {
-		fmt.Printf("Showing %d probes with labels: %s\n\n", len(result.Probes), flags.labels)
+		labels = flags.labels
 	}
+	return printProbeList(result.Probes, labels)
+}
 
-	// Print the probes using the configured output format
-	return output.PrintProbes(result.Probes)
+// printProbeList prints probes using the configured output format.
+// Task #8070: Show count message when label filters are applied. It goes to
+// stderr so JSON and YAML output can be piped into commands that read IDs.
+func printProbeList(probes []client.Probe, labels string) error {
+	if labels != "" {
+		fmt.Fprintf(os.Stderr, "Showing %d probes with labels: %s\n\n", len(probes), labels)
+	}
+	return output.PrintProbes(probes)
 }
 
 // parseLabelFilters parses a comma-separated label filter string into a map.
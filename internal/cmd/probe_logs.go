@@ -28,6 +28,7 @@ type probeLogsFlags struct {
 	region string
 	status string
 	follow bool
+	labels string // Label selector used instead of a probe ID
 }
 
 // NewProbeLogsCmd creates and returns the probe logs subcommand.
@@ -59,6 +60,14 @@ Follow Mode:
 
 The probe can be specified by UUID or by name.
 
+Multiple Probes:
+  Use --labels (-l) instead of a probe ID to show the logs of every probe
+  matching a label selector (key=value, key!=value or key). Results from all
+  matched probes are merged into one time-ordered list with a probe name
+  column. With --follow, each probe is polled at a rate matching its check
+  interval (between 5s and 5m), and results already printed are skipped.
+  --limit applies to the merged results.
+
 Examples:
   # View last 50 check results (default)
   stackeye probe logs "Production API"
@@ -81,10 +90,19 @@ Examples:
   # Follow only failures in us-east-1
   stackeye probe logs "Production API" -f --status failure --region us-east-1
 
+  # Follow every checkout probe as one stream
+  stackeye probe logs -l service=checkout -f
+
+  # Failures across all production probes in the last hour
+  stackeye probe logs -l env=production --status failure --since 1h
+
   # Output as JSON for scripting
   stackeye probe logs "Production API" -o json`,
-		Args: cobra.ExactArgs(1),
+		Args: probeSelectorArgs(noProbeArgsWithSelector, cobra.ExactArgs(1)),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if flags.labels != "" {
+				return runProbeLogsSelector(cmd.Context(), flags)
+			}
 			return runProbeLogs(cmd.Context(), args[0], flags)
 		},
 	}
@@ -95,6 +113,11 @@ Examples:
 	cmd.Flags().StringVar(&flags.region, "region", "", "filter by region")
 	cmd.Flags().StringVar(&flags.status, "status", "", "filter by status: success, failure")
 	cmd.Flags().BoolVarP(&flags.follow, "follow", "f", false, "follow new results (poll every 5s)")
+	cmd.Flags().StringVarP(&flags.labels, "labels", "l", "", "show merged logs of all probes matching labels: key=value,key!=value,key")
+
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("region", RegionCompletion())
+	_ = cmd.RegisterFlagCompletionFunc("labels", LabelFilterCompletion())
 
 	return cmd
 }
@@ -0,0 +1,433 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+)
+
+// probeLogsMaxPollInterval caps how long a probe with a long check interval
+// goes without being polled while following.
+const probeLogsMaxPollInterval = 5 * time.Minute
+
+// probeLogsLateWindow is how far behind the newest seen result each poll
+// looks, so results reported late by slower regions are not missed.
+const probeLogsLateWindow = 2 * time.Minute
+
+// probeLogsConcurrency is the maximum number of probes polled at once.
+const probeLogsConcurrency = 5
+
+// probeLogsNameWidth is the maximum width of the probe name column.
+const probeLogsNameWidth = 24
+
+// ProbeLogStreamEntry is a check result tagged with the probe it belongs to,
+// used when results from several probes are merged.
+type ProbeLogStreamEntry struct {
+	ProbeID        uuid.UUID `json:"probe_id" yaml:"probe_id"`
+	ProbeName      string    `json:"probe_name" yaml:"probe_name"`
+	CheckedAt      time.Time `json:"checked_at" yaml:"checked_at"`
+	Region         string    `json:"region" yaml:"region"`
+	Status         string    `json:"status" yaml:"status"`
+	ResponseTimeMs int       `json:"response_time_ms" yaml:"response_time_ms"`
+	StatusCode     *int      `json:"status_code,omitempty" yaml:"status_code,omitempty"`
+	ErrorMessage   *string   `json:"error_message,omitempty" yaml:"error_message,omitempty"`
+}
+
+// MultiProbeLogsOutput wraps merged results for probe logs --labels.
+type MultiProbeLogsOutput struct {
+	Selector string                `json:"selector" yaml:"selector"`
+	Probes   int                   `json:"probes" yaml:"probes"`
+	Results  []ProbeLogStreamEntry `json:"results" yaml:"results"`
+}
+
+// probeLogCursor tracks follow-mode polling state for one probe.
+type probeLogCursor struct {
+	probe  client.Probe
+	every  time.Duration
+	next   time.Time
+	latest time.Time
+}
+
+// runProbeLogsSelector shows or follows the merged logs of every probe that
+// matches the --labels selector.
+func runProbeLogsSelector(ctx context.Context, flags *probeLogsFlags) error {
+	if err := validateProbeLogsFlags(flags); err != nil {
+		return err
+	}
+	if _, err := parseLabelSelector(flags.labels); err != nil {
+		return err
+	}
+
+	from, to, err := resolveLogsTimeRange(flags)
+	if err != nil {
+		return err
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	probes, err := selectProbesByLabels(ctx, apiClient, flags.labels)
+	if err != nil {
+		return err
+	}
+	if len(probes) == 0 {
+		return output.PrintEmpty(fmt.Sprintf("No probes match selector %q", flags.labels))
+	}
+
+	if flags.follow && output.IsInteractive() {
+		return runProbeLogsFollowMulti(ctx, apiClient, probes, flags, from)
+	}
+
+	entries, err := fetchMergedProbeLogs(ctx, apiClient, probes, flags, from, to)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return output.PrintEmpty("No check logs found for the selected probes")
+	}
+
+	// Newest first, like single-probe logs
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].CheckedAt.After(entries[j].CheckedAt)
+	})
+	if len(entries) > flags.limit {
+		entries = entries[:flags.limit]
+	}
+
+	return output.Print(&MultiProbeLogsOutput{
+		Selector: flags.labels,
+		Probes:   len(probes),
+		Results:  entries,
+	})
+}
+
+// fetchMergedProbeLogs fetches up to flags.limit results for each probe
+// concurrently and returns them unsorted. Probes that fail are reported on
+// stderr; an error is returned only if every probe fails.
+func fetchMergedProbeLogs(ctx context.Context, apiClient *client.Client, probes []client.Probe, flags *probeLogsFlags, from, to time.Time) ([]ProbeLogStreamEntry, error) {
+	type probeLogsResult struct {
+		entries []ProbeLogStreamEntry
+		err     error
+	}
+
+	results := make([]probeLogsResult, len(probes))
+	sem := make(chan struct{}, probeLogsConcurrency)
+	var wg sync.WaitGroup
+
+	for i, p := range probes {
+		wg.Add(1)
+		go func(i int, p client.Probe) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			entries, err := fetchProbeLogEntries(ctx, apiClient, p, &client.ListProbeResultsOptions{
+				Page:   1,
+				Limit:  flags.limit,
+				Region: flags.region,
+				Status: flags.status,
+				From:   from,
+				To:     to,
+			})
+			results[i] = probeLogsResult{entries: entries, err: err}
+		}(i, p)
+	}
+	wg.Wait()
+
+	var merged []ProbeLogStreamEntry
+	failed := 0
+	var lastErr error
+	for i, r := range results {
+		if r.err != nil {
+			failed++
+			lastErr = r.err
+			fmt.Fprintf(os.Stderr, "Warning: %s: %v\n", probes[i].Name, r.err)
+			continue
+		}
+		merged = append(merged, r.entries...)
+	}
+
+	if failed == len(probes) {
+		return nil, lastErr
+	}
+	return merged, nil
+}
+
+// fetchProbeLogEntries fetches one page of results for a probe and tags them
+// with the probe's name.
+func fetchProbeLogEntries(ctx context.Context, apiClient *client.Client, probe client.Probe, opts *client.ListProbeResultsOptions) ([]ProbeLogStreamEntry, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, probeLogsTimeout)
+	defer cancel()
+
+	results, err := client.GetProbeResults(reqCtx, apiClient, probe.ID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get probe logs: %w", err)
+	}
+
+	entries := make([]ProbeLogStreamEntry, 0, len(results.Results))
+	for _, r := range results.Results {
+		entries = append(entries, ProbeLogStreamEntry{
+			ProbeID:        probe.ID,
+			ProbeName:      probe.Name,
+			CheckedAt:      r.CheckedAt,
+			Region:         r.Region,
+			Status:         r.Status,
+			ResponseTimeMs: r.ResponseTimeMs,
+			StatusCode:     r.StatusCode,
+			ErrorMessage:   r.ErrorMessage,
+		})
+	}
+	return entries, nil
+}
+
+// runProbeLogsFollowMulti prints recent results for every probe as one
+// time-ordered stream, then polls each probe at a rate matching its check
+// interval and prints results not seen before.
+func runProbeLogsFollowMulti(ctx context.Context, apiClient *client.Client, probes []client.Probe, flags *probeLogsFlags, from time.Time) error {
+	if from.IsZero() {
+		from = time.Now()
+	}
+
+	format := output.NewPrinter(GetConfig()).Format()
+	nameWidth := probeLogsPrefixWidth(probes)
+	seen := make(map[string]time.Time)
+
+	// Print the initial batch oldest first so the stream reads top to bottom
+	initial, err := fetchMergedProbeLogs(ctx, apiClient, probes, flags, from, time.Time{})
+	if err != nil {
+		return fmt.Errorf("failed to get initial probe logs: %w", err)
+	}
+	initial = dedupeProbeLogEntries(initial, seen)
+	sortProbeLogEntries(initial)
+	if len(initial) > flags.limit {
+		initial = initial[len(initial)-flags.limit:]
+	}
+	printProbeLogStream(os.Stdout, initial, format, nameWidth)
+
+	now := time.Now()
+	cursors := make([]*probeLogCursor, 0, len(probes))
+	for _, p := range probes {
+		c := &probeLogCursor{probe: p, every: probeLogsPollInterval(p), latest: from}
+		c.next = now.Add(c.every)
+		cursors = append(cursors, c)
+	}
+	for _, e := range initial {
+		for _, c := range cursors {
+			if c.probe.ID == e.ProbeID && e.CheckedAt.After(c.latest) {
+				c.latest = e.CheckedAt
+			}
+		}
+	}
+
+	tick := probeLogsTickInterval(cursors)
+	fmt.Fprintf(os.Stderr, "\n--- Following %d probes, polling every %s or slower per probe (Ctrl+C to stop) ---\n\n", len(probes), tick)
+
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			fmt.Fprintln(os.Stderr, "\nFollow stopped.")
+			return nil
+		case now := <-ticker.C:
+			entries := pollDueProbeLogs(ctx, apiClient, cursors, flags, now)
+			entries = dedupeProbeLogEntries(entries, seen)
+			sortProbeLogEntries(entries)
+			printProbeLogStream(os.Stdout, entries, format, nameWidth)
+			pruneSeenProbeLogs(seen, cursors)
+		}
+	}
+}
+
+// pollDueProbeLogs polls every probe whose next poll time has passed and
+// returns the results it reported since shortly before its newest seen
+// result. Errors are reported on stderr and the probe is retried next time.
+func pollDueProbeLogs(ctx context.Context, apiClient *client.Client, cursors []*probeLogCursor, flags *probeLogsFlags, now time.Time) []ProbeLogStreamEntry {
+	var (
+		mu      sync.Mutex
+		entries []ProbeLogStreamEntry
+		wg      sync.WaitGroup
+	)
+	sem := make(chan struct{}, probeLogsConcurrency)
+
+	for _, c := range cursors {
+		if now.Before(c.next) {
+			continue
+		}
+		c.next = now.Add(c.every)
+
+		wg.Add(1)
+		go func(c *probeLogCursor) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result, err := fetchProbeLogEntries(ctx, apiClient, c.probe, &client.ListProbeResultsOptions{
+				Page:   1,
+				Limit:  100,
+				Region: flags.region,
+				Status: flags.status,
+				From:   c.latest.Add(-probeLogsLateWindow),
+			})
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error polling %s: %v (retrying...)\n", c.probe.Name, err)
+				return
+			}
+
+			// Each cursor is only touched by its own goroutine
+			for _, e := range result {
+				if e.CheckedAt.After(c.latest) {
+					c.latest = e.CheckedAt
+				}
+			}
+
+			mu.Lock()
+			entries = append(entries, result...)
+			mu.Unlock()
+		}(c)
+	}
+
+	wg.Wait()
+	return entries
+}
+
+// probeLogKey identifies a check result for deduplication.
+func probeLogKey(e ProbeLogStreamEntry) string {
+	return fmt.Sprintf("%s|%s|%d", e.ProbeID, e.Region, e.CheckedAt.UnixNano())
+}
+
+// dedupeProbeLogEntries drops entries already in seen and records the rest.
+func dedupeProbeLogEntries(entries []ProbeLogStreamEntry, seen map[string]time.Time) []ProbeLogStreamEntry {
+	fresh := entries[:0]
+	for _, e := range entries {
+		key := probeLogKey(e)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = e.CheckedAt
+		fresh = append(fresh, e)
+	}
+	return fresh
+}
+
+// pruneSeenProbeLogs forgets results too old to be returned by any future
+// poll, keeping the dedupe set bounded.
+func pruneSeenProbeLogs(seen map[string]time.Time, cursors []*probeLogCursor) {
+	if len(cursors) == 0 {
+		return
+	}
+	oldest := cursors[0].latest
+	for _, c := range cursors[1:] {
+		if c.latest.Before(oldest) {
+			oldest = c.latest
+		}
+	}
+
+	cutoff := oldest.Add(-2 * probeLogsLateWindow)
+	for key, checkedAt := range seen {
+		if checkedAt.Before(cutoff) {
+			delete(seen, key)
+		}
+	}
+}
+
+// sortProbeLogEntries sorts entries oldest first, then by probe name.
+func sortProbeLogEntries(entries []ProbeLogStreamEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if !entries[i].CheckedAt.Equal(entries[j].CheckedAt) {
+			return entries[i].CheckedAt.Before(entries[j].CheckedAt)
+		}
+		return entries[i].ProbeName < entries[j].ProbeName
+	})
+}
+
+// probeLogsPollInterval returns how often a probe is polled while following:
+// its check interval, clamped to between 5 seconds and 5 minutes.
+func probeLogsPollInterval(p client.Probe) time.Duration {
+	every := time.Duration(p.IntervalSeconds) * time.Second
+	if every < probeLogsFollowInterval {
+		return probeLogsFollowInterval
+	}
+	if every > probeLogsMaxPollInterval {
+		return probeLogsMaxPollInterval
+	}
+	return every
+}
+
+// probeLogsTickInterval returns the shortest poll interval among the cursors.
+func probeLogsTickInterval(cursors []*probeLogCursor) time.Duration {
+	tick := probeLogsMaxPollInterval
+	for _, c := range cursors {
+		if c.every < tick {
+			tick = c.every
+		}
+	}
+	return tick
+}
+
+// probeLogsPrefixWidth returns the width of the probe name column.
+func probeLogsPrefixWidth(probes []client.Probe) int {
+	width := 0
+	for _, p := range probes {
+		if n := len(p.Name); n > width {
+			width = n
+		}
+	}
+	if width > probeLogsNameWidth {
+		width = probeLogsNameWidth
+	}
+	return width
+}
+
+// printProbeLogStream writes merged follow-mode entries: one JSON object per
+// line for JSON output, YAML documents for YAML output, or one line per
+// result prefixed with the probe name otherwise.
+func printProbeLogStream(w io.Writer, entries []ProbeLogStreamEntry, format sdkoutput.Format, nameWidth int) {
+	for _, e := range entries {
+		var err error
+		switch format {
+		case sdkoutput.FormatJSON:
+			err = json.NewEncoder(w).Encode(e)
+		case sdkoutput.FormatYAML:
+			err = output.Print(e)
+		default:
+			_, err = fmt.Fprintln(w, formatProbeLogLine(e, nameWidth))
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error printing: %v\n", err)
+			return
+		}
+	}
+}
+
+// formatProbeLogLine formats one result as a single line with a probe name
+// prefix column.
+func formatProbeLogLine(e ProbeLogStreamEntry, nameWidth int) string {
+	code := "-"
+	if e.StatusCode != nil {
+		code = fmt.Sprintf("%d", *e.StatusCode)
+	}
+
+	line := fmt.Sprintf("%-*s | %s  %-12s  %-7s  %6dms  %s",
+		nameWidth, truncate(e.ProbeName, nameWidth), e.CheckedAt.Local().Format("2006-01-02 15:04:05"),
+		e.Region, e.Status, e.ResponseTimeMs, code)
+	if e.ErrorMessage != nil && *e.ErrorMessage != "" {
+		line += "  " + *e.ErrorMessage
+	}
+	return line
+}
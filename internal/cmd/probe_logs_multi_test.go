@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func logEntry(probe string, region string, at time.Time) ProbeLogStreamEntry {
+	return ProbeLogStreamEntry{
+		ProbeID:   uuid.NewSHA1(uuid.NameSpaceOID, []byte(probe)),
+		ProbeName: probe,
+		CheckedAt: at,
+		Region:    region,
+		Status:    "success",
+	}
+}
+
+func TestProbeLogsCmd_LabelsFlag(t *testing.T) {
+	cmd := NewProbeLogsCmd()
+
+	flag := cmd.Flags().Lookup("labels")
+	require.NotNil(t, flag)
+	assert.Equal(t, "l", flag.Shorthand)
+
+	require.NoError(t, cmd.Flags().Set("labels", "service=checkout"))
+	assert.NoError(t, cmd.Args(cmd, []string{}))
+	assert.Error(t, cmd.Args(cmd, []string{"my-probe"}), "probe ID and --labels are mutually exclusive")
+}
+
+func TestProbeLogsCmd_RequiresIDWithoutLabels(t *testing.T) {
+	cmd := NewProbeLogsCmd()
+
+	assert.Error(t, cmd.Args(cmd, []string{}))
+	assert.NoError(t, cmd.Args(cmd, []string{"my-probe"}))
+}
+
+func TestRunProbeLogsSelector_InvalidSelector(t *testing.T) {
+	flags := &probeLogsFlags{limit: 50, labels: "=prod"}
+
+	err := runProbeLogsSelector(t.Context(), flags)
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "API client")
+}
+
+func TestDedupeProbeLogEntries(t *testing.T) {
+	now := time.Now()
+	seen := make(map[string]time.Time)
+
+	first := dedupeProbeLogEntries([]ProbeLogStreamEntry{
+		logEntry("api", "nyc3", now),
+		logEntry("api", "fra1", now),
+		logEntry("web", "nyc3", now),
+	}, seen)
+	assert.Len(t, first, 3)
+
+	// A later poll overlapping the previous one only yields the new result
+	second := dedupeProbeLogEntries([]ProbeLogStreamEntry{
+		logEntry("api", "nyc3", now),
+		logEntry("api", "nyc3", now.Add(time.Minute)),
+	}, seen)
+	require.Len(t, second, 1)
+	assert.Equal(t, now.Add(time.Minute), second[0].CheckedAt)
+}
+
+func TestPruneSeenProbeLogs(t *testing.T) {
+	now := time.Now()
+	seen := map[string]time.Time{
+		"old":    now.Add(-time.Hour),
+		"recent": now.Add(-time.Minute),
+	}
+	cursors := []*probeLogCursor{{latest: now}, {latest: now.Add(-30 * time.Second)}}
+
+	pruneSeenProbeLogs(seen, cursors)
+
+	assert.NotContains(t, seen, "old")
+	assert.Contains(t, seen, "recent")
+}
+
+func TestSortProbeLogEntries(t *testing.T) {
+	now := time.Now()
+	entries := []ProbeLogStreamEntry{
+		logEntry("web", "nyc3", now),
+		logEntry("api", "nyc3", now.Add(-time.Minute)),
+		logEntry("api", "nyc3", now),
+	}
+
+	sortProbeLogEntries(entries)
+
+	assert.Equal(t, "api", entries[0].ProbeName)
+	assert.Equal(t, now.Add(-time.Minute), entries[0].CheckedAt)
+	assert.Equal(t, "api", entries[1].ProbeName)
+	assert.Equal(t, "web", entries[2].ProbeName)
+}
+
+func TestProbeLogsPollInterval(t *testing.T) {
+	tests := []struct {
+		intervalSeconds int
+		want            time.Duration
+	}{
+		{0, 5 * time.Second},
+		{1, 5 * time.Second},
+		{30, 30 * time.Second},
+		{300, 5 * time.Minute},
+		{3600, 5 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		got := probeLogsPollInterval(client.Probe{IntervalSeconds: tt.intervalSeconds})
+		assert.Equal(t, tt.want, got, "interval %ds", tt.intervalSeconds)
+	}
+}
+
+func TestProbeLogsTickInterval(t *testing.T) {
+	cursors := []*probeLogCursor{{every: time.Minute}, {every: 30 * time.Second}, {every: 5 * time.Minute}}
+	assert.Equal(t, 30*time.Second, probeLogsTickInterval(cursors))
+}
+
+func TestProbeLogsPrefixWidth(t *testing.T) {
+	assert.Equal(t, 8, probeLogsPrefixWidth([]client.Probe{{Name: "api"}, {Name: "checkout"}}))
+	assert.Equal(t, probeLogsNameWidth, probeLogsPrefixWidth([]client.Probe{{Name: strings.Repeat("x", 40)}}))
+}
+
+func TestFormatProbeLogLine(t *testing.T) {
+	code := 503
+	msg := "Service Unavailable"
+	e := logEntry("checkout", "nyc3", time.Date(2026, 5, 6, 7, 8, 9, 0, time.Local))
+	e.Status = "failure"
+	e.ResponseTimeMs = 120
+	e.StatusCode = &code
+	e.ErrorMessage = &msg
+
+	line := formatProbeLogLine(e, 10)
+
+	assert.True(t, strings.HasPrefix(line, "checkout   | 2026-05-06 07:08:09"), "got %q", line)
+	for _, want := range []string{"nyc3", "failure", "120ms", "503", "Service Unavailable"} {
+		assert.Contains(t, line, want)
+	}
+}
+
+func TestPrintProbeLogStream_JSONLines(t *testing.T) {
+	now := time.Now()
+	entries := []ProbeLogStreamEntry{logEntry("api", "nyc3", now), logEntry("web", "fra1", now)}
+
+	var buf bytes.Buffer
+	printProbeLogStream(&buf, entries, sdkoutput.FormatJSON, 10)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &decoded))
+	assert.Equal(t, "web", decoded["probe_name"])
+}
@@ -18,7 +18,8 @@ const probePauseTimeout = 30 * time.Second
 
 // probePauseFlags holds the flag values for the probe pause command.
 type probePauseFlags struct {
-	yes bool // Skip confirmation prompt
+	yes    bool   // Skip confirmation prompt
+	labels string // Label selector used instead of explicit IDs
 }
 
 // NewProbePauseCmd creates and returns the probe pause subcommand.
@@ -28,7 +29,7 @@ func NewProbePauseCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:               "pause <id> [id...]",
 		Short:             "Pause monitoring for one or more probes",
-		ValidArgsFunction: ProbeCompletion(),
+		ValidArgsFunction: RepeatedCompletion(ProbeCompletion()),
 		Long: `Pause monitoring for one or more probes.
 
 Pausing a probe temporarily stops all monitoring checks without deleting the probe
@@ -38,6 +39,10 @@ temporarily disable alerts.
 Probes can be specified by UUID or by name. If a name matches multiple probes,
 you'll be prompted to use the UUID instead.
 
+Instead of listing probes, use --labels to select every probe matching a label
+selector (key=value, key!=value or key). The matched probes are listed before
+the confirmation prompt, which shows how many probes will be affected.
+
 Paused probes:
   - Stop executing scheduled checks
   - Do not trigger alerts
@@ -47,6 +52,11 @@ Paused probes:
 By default, the command will prompt for confirmation before pausing. Use --yes
 to skip the confirmation prompt for scripting or automation.
 
+Pass - instead of IDs to read them from stdin, one per line or as a JSON array
+of IDs or of objects with an "id" field (such as list output with -o json).
+The confirmation prompt then reads from the terminal; without one, --yes is
+required.
+
 Examples:
   # Pause a single probe by name
   stackeye probe pause "Production API"
@@ -61,22 +71,40 @@ Examples:
   stackeye probe pause "Production API" "Staging DB" 6ba7b810-9dad-11d1-80b4-00c04fd430c8
 
   # Pause multiple probes without confirmation (for scripting)
-  stackeye probe pause --yes "Production API" "Staging DB"`,
-		Args: cobra.MinimumNArgs(1),
+  stackeye probe pause --yes "Production API" "Staging DB"
+
+  # Pause every staging probe not owned by the payments team
+  stackeye probe pause -l "env=staging,team!=payments"
+
+  # Read probe IDs from another command's JSON output
+  stackeye probe list -o json --labels env=staging | stackeye probe pause -`,
+		Args: probeSelectorArgs(noProbeArgsWithSelector, cobra.MinimumNArgs(1)),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runProbePause(cmd.Context(), args, flags)
+			ids, fromStdin, err := readIDArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+			if err := promptFromTTYForStdinIDs(fromStdin, flags.yes); err != nil {
+				return err
+			}
+			return runProbePause(cmd.Context(), ids, flags)
 		},
 	}
 
 	cmd.Flags().BoolVarP(&flags.yes, "yes", "y", false, "skip confirmation prompt")
+	cmd.Flags().StringVarP(&flags.labels, "labels", "l", "", probeSelectorUsage)
+
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("labels", LabelFilterCompletion())
 
 	return cmd
 }
 
 // runProbePause executes the probe pause command logic.
 func runProbePause(ctx context.Context, idArgs []string, flags *probePauseFlags) error {
-	// Dry-run check: print what would happen and exit without making API calls
-	if GetDryRun() {
+	// Dry-run check: print what would happen and exit without making API calls.
+	// Label selectors must be resolved via the API before they can be previewed.
+	if GetDryRun() && flags.labels == "" {
 		dryrun.PrintBatchAction("pause", "probe", idArgs)
 		return nil
 	}
@@ -87,11 +115,20 @@ func runProbePause(ctx context.Context, idArgs []string, flags *probePauseFlags)
 		return fmt.Errorf("failed to initialize API client: %w", err)
 	}
 
-	// Resolve all probe identifiers (UUIDs or names) before prompting for confirmation
-	probeIDs, err := ResolveProbeIDs(ctx, apiClient, idArgs)
+	// Resolve all probe identifiers (UUIDs, names or label selector) before
+	// prompting for confirmation
+	probeIDs, err := resolveProbeTargets(ctx, apiClient, idArgs, flags.labels)
 	if err != nil {
 		return err
 	}
+	if len(probeIDs) == 0 {
+		return nil
+	}
+
+	if GetDryRun() {
+		dryrun.PrintBatchAction("pause", "probe", probeIDStrings(probeIDs))
+		return nil
+	}
 
 	// Prompt for confirmation unless --yes flag is set or --no-input is enabled
 	message := "Are you sure you want to pause monitoring for this probe?"
@@ -0,0 +1,454 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// probeRegionsConcurrency is the maximum number of region result fetches
+// running at once.
+const probeRegionsConcurrency = 5
+
+// probeRegionsTimeout is the maximum time to wait for the results of every
+// region. Each page request is also limited to probeStatsTimeout.
+const probeRegionsTimeout = 5 * time.Minute
+
+// probeRegionsErrorWidth is the maximum width of the last error column.
+const probeRegionsErrorWidth = 40
+
+// probeRegionsFlags holds the flag values for the probe regions command.
+type probeRegionsFlags struct {
+	period string
+	labels string
+}
+
+// ProbeRegionStats summarizes one region's checks of a probe over a period.
+type ProbeRegionStats struct {
+	Region      string     `json:"region" yaml:"region"`
+	Checks      int        `json:"checks" yaml:"checks"`
+	Failures    int        `json:"failures" yaml:"failures"`
+	SuccessRate *float64   `json:"success_rate" yaml:"success_rate"`
+	P50Ms       float64    `json:"p50_response_time_ms" yaml:"p50_response_time_ms"`
+	P95Ms       float64    `json:"p95_response_time_ms" yaml:"p95_response_time_ms"`
+	P99Ms       float64    `json:"p99_response_time_ms" yaml:"p99_response_time_ms"`
+	LastFailure *time.Time `json:"last_failure,omitempty" yaml:"last_failure,omitempty"`
+	LastError   string     `json:"last_error,omitempty" yaml:"last_error,omitempty"`
+}
+
+// ProbeRegionsOutput is the per-region breakdown of one probe.
+// This struct is exported to allow JSON/YAML serialization with proper field tags.
+type ProbeRegionsOutput struct {
+	ProbeID   uuid.UUID          `json:"probe_id" yaml:"probe_id"`
+	ProbeName string             `json:"probe_name" yaml:"probe_name"`
+	Period    string             `json:"period" yaml:"period"`
+	From      time.Time          `json:"from" yaml:"from"`
+	To        time.Time          `json:"to" yaml:"to"`
+	Regions   []ProbeRegionStats `json:"regions" yaml:"regions"`
+}
+
+// MultiProbeRegionsOutput is the per-region breakdown of every probe matching
+// a label selector.
+type MultiProbeRegionsOutput struct {
+	Selector string               `json:"selector" yaml:"selector"`
+	Period   string               `json:"period" yaml:"period"`
+	Probes   []ProbeRegionsOutput `json:"probes" yaml:"probes"`
+}
+
+// ProbeRegionTableRow is a row of the single-probe region table.
+type ProbeRegionTableRow struct {
+	Region      string `table:"REGION"`
+	Success     string `table:"SUCCESS"`
+	Checks      string `table:"CHECKS"`
+	P50         string `table:"P50"`
+	P95         string `table:"P95"`
+	P99         string `table:"P99"`
+	LastFailure string `table:"LAST FAILURE"`
+	LastError   string `table:"LAST ERROR,wide"`
+}
+
+// NewProbeRegionsCmd creates and returns the probe regions subcommand.
+func NewProbeRegionsCmd() *cobra.Command {
+	flags := &probeRegionsFlags{}
+
+	cmd := &cobra.Command{
+		Use:               "regions [id]",
+		Short:             "Compare latency and availability across regions",
+		ValidArgsFunction: ProbeCompletion(),
+		Long: `Compare a probe's latency and availability across monitoring regions.
+
+For each region the probe checks from, shows the success rate, p50/p95/p99
+response time and the most recent failure over the period. A problem limited
+to one or two regions usually points at the network path rather than the
+service itself.
+
+With --labels, every matching probe is shown as a matrix: one row per probe,
+one column per region, each cell holding the success rate and p95 response
+time. Regions a probe does not check from are shown as "-".
+
+Time Periods:
+  24h - Last 24 hours (default)
+  7d  - Last 7 days
+  30d - Last 30 days
+
+Statistics are computed from every raw check result in the period, so longer
+periods and shorter check intervals take longer to fetch.
+
+The probe can be specified by UUID or by name. If the name matches multiple
+probes, you'll be prompted to use the UUID instead.
+
+Examples:
+  # Per-region breakdown for the last 24 hours
+  stackeye probe regions "Production API"
+
+  # Last 7 days, including the last error message
+  stackeye probe regions "Production API" --period 7d -o wide
+
+  # Matrix of every probe labeled env=production
+  stackeye probe regions -l env=production
+
+  # Output as JSON for scripting
+  stackeye probe regions "Production API" -o json`,
+		Args: probeSelectorArgs(noProbeArgsWithSelector, cobra.ExactArgs(1)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if flags.labels != "" {
+				return runProbeRegionsSelector(cmd.Context(), flags)
+			}
+			return runProbeRegions(cmd.Context(), args[0], flags)
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.period, "period", "24h", "statistics period: 24h, 7d, 30d")
+	cmd.Flags().StringVarP(&flags.labels, "labels", "l", "", probeSelectorUsage)
+
+	_ = cmd.RegisterFlagCompletionFunc("labels", LabelFilterCompletion())
+
+	return cmd
+}
+
+// runProbeRegions shows the per-region breakdown of a single probe.
+func runProbeRegions(ctx context.Context, idArg string, flags *probeRegionsFlags) error {
+	_, from, to, err := parsePeriodToAggregateParams(flags.period)
+	if err != nil {
+		return err
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	probeID, err := ResolveProbeID(ctx, apiClient, idArg)
+	if err != nil {
+		return err
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, probeStatsTimeout)
+	defer cancel()
+
+	probe, err := client.GetProbe(reqCtx, apiClient, probeID, "24h")
+	if err != nil {
+		return fmt.Errorf("failed to get probe: %w", err)
+	}
+
+	regionsCtx, cancelRegions := context.WithTimeout(ctx, probeRegionsTimeout)
+	defer cancelRegions()
+
+	stats, err := fetchProbeRegionStats(regionsCtx, apiClient, []client.Probe{*probe}, flags.period, from, to)
+	if err != nil {
+		return err
+	}
+	result := stats[0]
+
+	format := output.NewPrinter(GetConfig()).Format()
+	if format == sdkoutput.FormatJSON || format == sdkoutput.FormatYAML {
+		return output.Print(result)
+	}
+
+	if len(result.Regions) == 0 {
+		return output.PrintEmpty("No check results found for this probe in the specified period")
+	}
+	return output.Print(probeRegionTableRows(result.Regions, time.Now()))
+}
+
+// runProbeRegionsSelector shows the region matrix of every probe matching
+// the --labels selector.
+func runProbeRegionsSelector(ctx context.Context, flags *probeRegionsFlags) error {
+	_, from, to, err := parsePeriodToAggregateParams(flags.period)
+	if err != nil {
+		return err
+	}
+	if _, err := parseLabelSelector(flags.labels); err != nil {
+		return err
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	probes, err := selectProbesByLabels(ctx, apiClient, flags.labels)
+	if err != nil {
+		return err
+	}
+	if len(probes) == 0 {
+		return output.PrintEmpty(fmt.Sprintf("No probes match selector %q", flags.labels))
+	}
+
+	regionsCtx, cancel := context.WithTimeout(ctx, probeRegionsTimeout)
+	defer cancel()
+
+	stats, err := fetchProbeRegionStats(regionsCtx, apiClient, probes, flags.period, from, to)
+	if err != nil {
+		return err
+	}
+
+	format := output.NewPrinter(GetConfig()).Format()
+	if format == sdkoutput.FormatJSON || format == sdkoutput.FormatYAML {
+		return output.Print(&MultiProbeRegionsOutput{
+			Selector: flags.labels,
+			Period:   flags.period,
+			Probes:   stats,
+		})
+	}
+
+	renderProbeRegionMatrix(os.Stdout, stats)
+	return nil
+}
+
+// fetchProbeRegionStats fetches all raw results for every region of every probe
+// concurrently and summarizes them. Regions that fail are reported on stderr;
+// an error is returned only if every fetch fails.
+func fetchProbeRegionStats(ctx context.Context, apiClient *client.Client, probes []client.Probe, period string, from, to time.Time) ([]ProbeRegionsOutput, error) {
+	type regionJob struct {
+		probe, region int
+	}
+
+	out := make([]ProbeRegionsOutput, len(probes))
+	var jobs []regionJob
+	for i, p := range probes {
+		out[i] = ProbeRegionsOutput{
+			ProbeID:   p.ID,
+			ProbeName: p.Name,
+			Period:    period,
+			From:      from,
+			To:        to,
+			Regions:   make([]ProbeRegionStats, len(p.Regions)),
+		}
+		for j := range p.Regions {
+			jobs = append(jobs, regionJob{probe: i, region: j})
+		}
+	}
+
+	errs := make([]error, len(jobs))
+	sem := make(chan struct{}, probeRegionsConcurrency)
+	var wg sync.WaitGroup
+	for n, job := range jobs {
+		wg.Add(1)
+		go func(n int, job regionJob) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			probe := probes[job.probe]
+			region := probe.Regions[job.region]
+			results, err := fetchAllRegionResults(ctx, apiClient, probe.ID, region, from, to)
+			if err != nil {
+				errs[n] = fmt.Errorf("%s (%s): %w", probe.Name, region, err)
+				return
+			}
+			out[job.probe].Regions[job.region] = summarizeRegionResults(region, results)
+		}(n, job)
+	}
+	wg.Wait()
+
+	failed := 0
+	for _, err := range errs {
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			failed++
+		}
+	}
+	if len(jobs) > 0 && failed == len(jobs) {
+		return nil, fmt.Errorf("failed to get probe results for every region")
+	}
+
+	// Drop regions that failed or have no results in the period
+	for i := range out {
+		kept := out[i].Regions[:0]
+		for _, r := range out[i].Regions {
+			if r.Checks > 0 {
+				kept = append(kept, r)
+			}
+		}
+		sort.Slice(kept, func(a, b int) bool { return kept[a].Region < kept[b].Region })
+		out[i].Regions = kept
+	}
+	return out, nil
+}
+
+// fetchAllRegionResults fetches every raw result for one region in the
+// period, one page at a time.
+func fetchAllRegionResults(ctx context.Context, apiClient *client.Client, probeID uuid.UUID, region string, from, to time.Time) ([]client.ProbeResult, error) {
+	var all []client.ProbeResult
+	for page := 1; ; page++ {
+		reqCtx, cancel := context.WithTimeout(ctx, probeStatsTimeout)
+		results, err := client.GetProbeResults(reqCtx, apiClient, probeID, &client.ListProbeResultsOptions{
+			Page:   page,
+			Limit:  probeChartPageSize,
+			Region: region,
+			From:   from,
+			To:     to,
+		})
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get probe results: %w", err)
+		}
+		all = append(all, results.Results...)
+		if len(results.Results) < probeChartPageSize {
+			return all, nil
+		}
+	}
+}
+
+// summarizeRegionResults computes success rate, latency percentiles and the
+// most recent failure from one region's results.
+func summarizeRegionResults(region string, results []client.ProbeResult) ProbeRegionStats {
+	stats := ProbeRegionStats{Region: region, Checks: len(results)}
+	if len(results) == 0 {
+		return stats
+	}
+
+	times := make([]float64, 0, len(results))
+	for _, r := range results {
+		times = append(times, float64(r.ResponseTimeMs))
+		if r.Status != "failure" {
+			continue
+		}
+		stats.Failures++
+		if stats.LastFailure == nil || r.CheckedAt.After(*stats.LastFailure) {
+			at := r.CheckedAt
+			stats.LastFailure = &at
+			stats.LastError = ""
+			if r.ErrorMessage != nil {
+				stats.LastError = *r.ErrorMessage
+			}
+		}
+	}
+
+	rate := float64(stats.Checks-stats.Failures) / float64(stats.Checks) * 100
+	stats.SuccessRate = &rate
+
+	// calculatePercentiles sorts times in place
+	stats.P95Ms, stats.P99Ms = calculatePercentiles(times)
+	stats.P50Ms = times[len(times)/2]
+	return stats
+}
+
+// probeRegionTableRows converts region stats into table rows.
+func probeRegionTableRows(regions []ProbeRegionStats, now time.Time) []ProbeRegionTableRow {
+	rows := make([]ProbeRegionTableRow, 0, len(regions))
+	for _, r := range regions {
+		checks := fmt.Sprintf("%d", r.Checks)
+		lastFailure, lastError := "-", "-"
+		if r.LastFailure != nil {
+			lastFailure = formatTriageAge(now.Sub(*r.LastFailure)) + " ago"
+			if r.LastError != "" {
+				lastError = truncate(r.LastError, probeRegionsErrorWidth)
+			}
+		}
+		rows = append(rows, ProbeRegionTableRow{
+			Region:      r.Region,
+			Success:     formatSuccessRate(r.SuccessRate),
+			Checks:      checks,
+			P50:         formatChartMs(r.P50Ms),
+			P95:         formatChartMs(r.P95Ms),
+			P99:         formatChartMs(r.P99Ms),
+			LastFailure: lastFailure,
+			LastError:   lastError,
+		})
+	}
+	return rows
+}
+
+// renderProbeRegionMatrix writes one row per probe and one column per
+// region, each cell holding the success rate and p95 response time.
+func renderProbeRegionMatrix(w io.Writer, probes []ProbeRegionsOutput) {
+	regionSet := make(map[string]bool)
+	for _, p := range probes {
+		for _, r := range p.Regions {
+			regionSet[r.Region] = true
+		}
+	}
+	regions := make([]string, 0, len(regionSet))
+	for r := range regionSet {
+		regions = append(regions, r)
+	}
+	sort.Strings(regions)
+
+	cells := make([][]string, len(probes))
+	nameWidth := len("PROBE")
+	colWidths := make([]int, len(regions))
+	for i, r := range regions {
+		colWidths[i] = len(r)
+	}
+	for i, p := range probes {
+		nameWidth = max(nameWidth, len(truncate(p.ProbeName, probeLogsNameWidth)))
+		byRegion := make(map[string]ProbeRegionStats, len(p.Regions))
+		for _, r := range p.Regions {
+			byRegion[r.Region] = r
+		}
+		cells[i] = make([]string, len(regions))
+		for j, region := range regions {
+			cell := "-"
+			if r, ok := byRegion[region]; ok {
+				cell = formatSuccessRate(r.SuccessRate) + " " + formatChartMs(r.P95Ms)
+			}
+			cells[i][j] = cell
+			colWidths[j] = max(colWidths[j], len(cell))
+		}
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%-*s", nameWidth, "PROBE")
+	for j, region := range regions {
+		fmt.Fprintf(&sb, "  %-*s", colWidths[j], strings.ToUpper(region))
+	}
+	fmt.Fprintln(w, strings.TrimRight(sb.String(), " "))
+
+	for i, p := range probes {
+		sb.Reset()
+		fmt.Fprintf(&sb, "%-*s", nameWidth, truncate(p.ProbeName, probeLogsNameWidth))
+		for j := range regions {
+			fmt.Fprintf(&sb, "  %-*s", colWidths[j], cells[i][j])
+		}
+		fmt.Fprintln(w, strings.TrimRight(sb.String(), " "))
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Cells show success rate and p95 response time.")
+}
+
+// formatSuccessRate formats a success percentage, or "-" without checks.
+func formatSuccessRate(rate *float64) string {
+	if rate == nil {
+		return "-"
+	}
+	if *rate >= 100 {
+		return "100%"
+	}
+	return fmt.Sprintf("%.2f%%", *rate)
+}
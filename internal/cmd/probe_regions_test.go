@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+)
+
+func regionResult(at time.Time, ms int, status string) client.ProbeResult {
+	return client.ProbeResult{CheckedAt: at, ResponseTimeMs: ms, Status: status}
+}
+
+func TestNewProbeRegionsCmd(t *testing.T) {
+	cmd := NewProbeRegionsCmd()
+
+	periodFlag := cmd.Flags().Lookup("period")
+	if periodFlag == nil || periodFlag.DefValue != "24h" {
+		t.Errorf("expected 'period' flag with default 24h, got %v", periodFlag)
+	}
+
+	labelsFlag := cmd.Flags().Lookup("labels")
+	if labelsFlag == nil || labelsFlag.Shorthand != "l" {
+		t.Fatalf("expected 'labels' flag with shorthand l, got %v", labelsFlag)
+	}
+
+	if err := cmd.Args(cmd, []string{}); err == nil {
+		t.Error("expected error without probe ID or --labels")
+	}
+	if err := cmd.Flags().Set("labels", "env=prod"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Args(cmd, []string{"api"}); err == nil {
+		t.Error("expected probe ID and --labels to be mutually exclusive")
+	}
+}
+
+func TestRunProbeRegions_InvalidPeriod(t *testing.T) {
+	err := runProbeRegions(t.Context(), "api", &probeRegionsFlags{period: "1y"})
+	if err == nil {
+		t.Fatal("expected error for invalid period")
+	}
+	if strings.Contains(err.Error(), "API client") {
+		t.Errorf("expected validation before API client initialization, got %v", err)
+	}
+}
+
+func TestRunProbeRegionsSelector_InvalidSelector(t *testing.T) {
+	err := runProbeRegionsSelector(t.Context(), &probeRegionsFlags{period: "24h", labels: "=prod"})
+	if err == nil {
+		t.Fatal("expected error for invalid selector")
+	}
+	if strings.Contains(err.Error(), "API client") {
+		t.Errorf("expected validation before API client initialization, got %v", err)
+	}
+}
+
+func TestSummarizeRegionResults(t *testing.T) {
+	now := time.Now()
+	msg := "connection reset"
+	failure := regionResult(now.Add(-time.Hour), 900, "failure")
+	failure.ErrorMessage = &msg
+
+	var results []client.ProbeResult
+	for i := 1; i <= 19; i++ {
+		results = append(results, regionResult(now.Add(-time.Duration(i)*time.Minute), i*10, "success"))
+	}
+	results = append(results, failure, regionResult(now.Add(-2*time.Hour), 1000, "failure"))
+
+	stats := summarizeRegionResults("nyc3", results)
+
+	if stats.Checks != 21 || stats.Failures != 2 {
+		t.Errorf("expected 21 checks / 2 failures, got %d/%d", stats.Checks, stats.Failures)
+	}
+	if stats.SuccessRate == nil || *stats.SuccessRate < 90.47 || *stats.SuccessRate > 90.48 {
+		t.Errorf("expected ~90.48%% success, got %v", stats.SuccessRate)
+	}
+	if stats.P50Ms != 110 {
+		t.Errorf("expected p50 110ms, got %v", stats.P50Ms)
+	}
+	if stats.P99Ms != 1000 {
+		t.Errorf("expected p99 1000ms, got %v", stats.P99Ms)
+	}
+	if stats.LastFailure == nil || !stats.LastFailure.Equal(failure.CheckedAt) {
+		t.Errorf("expected last failure at %v, got %v", failure.CheckedAt, stats.LastFailure)
+	}
+	if stats.LastError != msg {
+		t.Errorf("expected last error %q, got %q", msg, stats.LastError)
+	}
+}
+
+func TestFetchAllRegionResults_FetchesEveryPage(t *testing.T) {
+	now := time.Now()
+	pages := []int{probeChartPageSize, probeChartPageSize, 30}
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := client.ProbeResultListResponse{}
+		if requests < len(pages) {
+			for i := 0; i < pages[requests]; i++ {
+				resp.Results = append(resp.Results, regionResult(now, 100, "success"))
+			}
+		}
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	setupTestConfigWithURL(t, server.URL)
+	apiClient, err := api.GetClient()
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	results, err := fetchAllRegionResults(context.Background(), apiClient, uuid.New(), "nyc3", now.Add(-30*24*time.Hour), now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 2*probeChartPageSize+30 {
+		t.Errorf("expected %d results, got %d", 2*probeChartPageSize+30, len(results))
+	}
+	if requests != len(pages) {
+		t.Errorf("expected %d page requests, got %d", len(pages), requests)
+	}
+}
+
+func TestSummarizeRegionResults_Empty(t *testing.T) {
+	stats := summarizeRegionResults("nyc3", nil)
+	if stats.Checks != 0 || stats.SuccessRate != nil {
+		t.Errorf("expected empty stats, got %+v", stats)
+	}
+}
+
+func TestProbeRegionTableRows(t *testing.T) {
+	now := time.Now()
+	lastFailure := now.Add(-3 * time.Hour)
+	rate := 99.5
+
+	rows := probeRegionTableRows([]ProbeRegionStats{
+		{Region: "fra1", Checks: 1000, SuccessRate: &rate, P50Ms: 80, P95Ms: 150, P99Ms: 300, LastFailure: &lastFailure, LastError: "timeout"},
+		{Region: "nyc3", Checks: 10, SuccessRate: ptrFloat(100)},
+	}, now)
+
+	if rows[0].Success != "99.50%" || rows[0].Checks != "1000" || rows[0].LastFailure != "3h ago" || rows[0].LastError != "timeout" {
+		t.Errorf("unexpected first row: %+v", rows[0])
+	}
+	if rows[1].Success != "100%" || rows[1].LastFailure != "-" || rows[1].LastError != "-" {
+		t.Errorf("unexpected second row: %+v", rows[1])
+	}
+}
+
+func TestRenderProbeRegionMatrix(t *testing.T) {
+	probes := []ProbeRegionsOutput{
+		{ProbeID: uuid.New(), ProbeName: "api", Regions: []ProbeRegionStats{
+			{Region: "fra1", Checks: 10, SuccessRate: ptrFloat(100), P95Ms: 120},
+			{Region: "nyc3", Checks: 10, SuccessRate: ptrFloat(90), P95Ms: 900},
+		}},
+		{ProbeID: uuid.New(), ProbeName: "checkout", Regions: []ProbeRegionStats{
+			{Region: "nyc3", Checks: 10, SuccessRate: ptrFloat(100), P95Ms: 200},
+		}},
+	}
+
+	var buf bytes.Buffer
+	renderProbeRegionMatrix(&buf, probes)
+	lines := strings.Split(buf.String(), "\n")
+
+	if lines[0] != "PROBE     FRA1        NYC3" {
+		t.Errorf("unexpected header %q", lines[0])
+	}
+	if lines[1] != "api       100% 120ms  90.00% 900ms" {
+		t.Errorf("unexpected api row %q", lines[1])
+	}
+	if lines[2] != "checkout  -           100% 200ms" {
+		t.Errorf("unexpected checkout row %q", lines[2])
+	}
+}
+
+func ptrFloat(v float64) *float64 {
+	return &v
+}
@@ -18,7 +18,8 @@ const probeResumeTimeout = 30 * time.Second
 
 // probeResumeFlags holds the flag values for the probe resume command.
 type probeResumeFlags struct {
-	yes bool // Skip confirmation prompt
+	yes    bool   // Skip confirmation prompt
+	labels string // Label selector used instead of explicit IDs
 }
 
 // NewProbeResumeCmd creates and returns the probe resume subcommand.
@@ -28,12 +29,16 @@ func NewProbeResumeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:               "resume <id> [id...]",
 		Short:             "Resume monitoring for one or more paused probes",
-		ValidArgsFunction: ProbeCompletion(),
+		ValidArgsFunction: RepeatedCompletion(ProbeCompletion()),
 		Long: `Resume monitoring for one or more paused probes.
 
 Probes can be specified by UUID or by name. If a name matches multiple probes,
 you'll be prompted to use the UUID instead.
 
+Instead of listing probes, use --labels to select every probe matching a label
+selector (key=value, key!=value or key). The matched probes are listed before
+the confirmation prompt, which shows how many probes will be affected.
+
 Resuming a probe restarts all monitoring checks that were previously paused.
 The probe will immediately begin executing scheduled checks again and can
 trigger alerts based on the probe configuration.
@@ -47,6 +52,11 @@ Resumed probes:
 By default, the command will prompt for confirmation before resuming. Use --yes
 to skip the confirmation prompt for scripting or automation.
 
+Pass - instead of IDs to read them from stdin, one per line or as a JSON array
+of IDs or of objects with an "id" field (such as list output with -o json).
+The confirmation prompt then reads from the terminal; without one, --yes is
+required.
+
 Examples:
   # Resume a single probe by name
   stackeye probe resume "Production API"
@@ -61,22 +71,40 @@ Examples:
   stackeye probe resume "Production API" "Staging DB" 6ba7b810-9dad-11d1-80b4-00c04fd430c8
 
   # Resume multiple probes without confirmation (for scripting)
-  stackeye probe resume --yes "Production API" "Staging DB"`,
-		Args: cobra.MinimumNArgs(1),
+  stackeye probe resume --yes "Production API" "Staging DB"
+
+  # Resume every staging probe after maintenance
+  stackeye probe resume -l env=staging --yes
+
+  # Read probe IDs from another command's JSON output
+  stackeye probe list -o json --status paused | stackeye probe resume -`,
+		Args: probeSelectorArgs(noProbeArgsWithSelector, cobra.MinimumNArgs(1)),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runProbeResume(cmd.Context(), args, flags)
+			ids, fromStdin, err := readIDArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+			if err := promptFromTTYForStdinIDs(fromStdin, flags.yes); err != nil {
+				return err
+			}
+			return runProbeResume(cmd.Context(), ids, flags)
 		},
 	}
 
 	cmd.Flags().BoolVarP(&flags.yes, "yes", "y", false, "skip confirmation prompt")
+	cmd.Flags().StringVarP(&flags.labels, "labels", "l", "", probeSelectorUsage)
+
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("labels", LabelFilterCompletion())
 
 	return cmd
 }
 
 // runProbeResume executes the probe resume command logic.
 func runProbeResume(ctx context.Context, idArgs []string, flags *probeResumeFlags) error {
-	// Dry-run check: print what would happen and exit without making API calls
-	if GetDryRun() {
+	// Dry-run check: print what would happen and exit without making API calls.
+	// Label selectors must be resolved via the API before they can be previewed.
+	if GetDryRun() && flags.labels == "" {
 		dryrun.PrintBatchAction("resume", "probe", idArgs)
 		return nil
 	}
@@ -87,11 +115,20 @@ func runProbeResume(ctx context.Context, idArgs []string, flags *probeResumeFlag
 		return fmt.Errorf("failed to initialize API client: %w", err)
 	}
 
-	// Resolve all probe identifiers (UUIDs or names) before prompting for confirmation
-	probeIDs, err := ResolveProbeIDs(ctx, apiClient, idArgs)
+	// Resolve all probe identifiers (UUIDs, names or label selector) before
+	// prompting for confirmation
+	probeIDs, err := resolveProbeTargets(ctx, apiClient, idArgs, flags.labels)
 	if err != nil {
 		return err
 	}
+	if len(probeIDs) == 0 {
+		return nil
+	}
+
+	if GetDryRun() {
+		dryrun.PrintBatchAction("resume", "probe", probeIDStrings(probeIDs))
+		return nil
+	}
 
 	// Prompt for confirmation unless --yes flag is set or --no-input is enabled
 	message := "Are you sure you want to resume monitoring for this probe?"
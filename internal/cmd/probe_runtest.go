@@ -33,6 +33,8 @@ type ProbeTestResult struct {
 
 // NewProbeTestCmd creates and returns the probe test subcommand.
 func NewProbeTestCmd() *cobra.Command {
+	var local bool
+
 	cmd := &cobra.Command{
 		Use:               "test <id>",
 		Short:             "Run an immediate test check for a probe",
@@ -57,6 +59,12 @@ This is useful for:
   - Troubleshooting connectivity issues
   - Testing changes before enabling monitoring
 
+With --local, the check runs from this machine instead of a monitoring region,
+using the same rules (expected status codes, keyword and JSONPath checks,
+redirect limits and SSL expiry threshold). This helps tell apart problems with
+the target from problems reaching it. To check a definition before creating
+the probe, use "stackeye probe check".
+
 Examples:
   # Run a test check by probe name
   stackeye probe test "Production API"
@@ -64,19 +72,24 @@ Examples:
   # Run a test check by probe UUID
   stackeye probe test 550e8400-e29b-41d4-a716-446655440000
 
+  # Run the check from this machine
+  stackeye probe test "Production API" --local
+
   # Output as JSON for scripting
   stackeye probe test "Production API" -o json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runProbeTest(cmd.Context(), args[0])
+			return runProbeTest(cmd.Context(), args[0], local)
 		},
 	}
 
+	cmd.Flags().BoolVar(&local, "local", false, "run the check from this machine instead of a monitoring region")
+
 	return cmd
 }
 
 // runProbeTest executes the probe test command logic.
-func runProbeTest(ctx context.Context, idArg string) error {
+func runProbeTest(ctx context.Context, idArg string, local bool) error {
 	// Get authenticated API client (needed for name resolution)
 	apiClient, err := api.GetClient()
 	if err != nil {
@@ -100,6 +113,14 @@ func runProbeTest(ctx context.Context, idArg string) error {
 		return fmt.Errorf("failed to get probe: %w", err)
 	}
 
+	if local {
+		cfg := convertProbeToExportConfig(probe)
+		fmt.Printf("Running local check for %q (%s)...\n", probe.Name, probe.URL)
+		testResult := runLocalProbeCheck(ctx, &cfg)
+		testResult.ProbeID = probe.ID
+		return output.Print(&testResult)
+	}
+
 	// Convert probe configuration to test request
 	testReq := probeToTestRequest(probe)
 
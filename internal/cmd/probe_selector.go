@@ -0,0 +1,206 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// probeSelectorTimeout is the maximum time to wait while listing the probes
+// matched by a label selector.
+const probeSelectorTimeout = 60 * time.Second
+
+// probeSelectorPreviewLimit is the maximum number of matched probes listed in
+// the preview before a bulk action.
+const probeSelectorPreviewLimit = 20
+
+// probeSelectorUsage is the help text for the --labels selector flag on bulk
+// probe commands.
+const probeSelectorUsage = "select probes by labels instead of IDs: key=value,key!=value,key (AND logic)"
+
+// labelSelector is a parsed label selector such as "env=staging,team!=payments".
+//
+// Equality and existence terms are sent to the API as label filters (the same
+// filters 'probe list --labels' uses). Inequality terms are not supported by
+// the API and are evaluated client-side against the returned probes.
+type labelSelector struct {
+	include map[string]string // key=value or key (any value), filtered server-side
+	exclude map[string]string // key!=value, filtered client-side
+}
+
+// parseLabelSelector parses a comma-separated label selector.
+// Accepts "key=value" (exact match), "key" (key present with any value) and
+// "key!=value" (key absent or set to a different value).
+func parseLabelSelector(selector string) (*labelSelector, error) {
+	sel := &labelSelector{exclude: make(map[string]string)}
+
+	var includeParts []string
+	for _, part := range strings.Split(selector, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		if idx := strings.Index(part, "!="); idx >= 0 {
+			key := strings.TrimSpace(part[:idx])
+			if err := validateLabelKey(key); err != nil {
+				return nil, err
+			}
+			sel.exclude[key] = strings.TrimSpace(part[idx+2:])
+			continue
+		}
+
+		includeParts = append(includeParts, part)
+	}
+
+	include, err := parseLabelFilters(strings.Join(includeParts, ","))
+	if err != nil {
+		return nil, err
+	}
+	sel.include = include
+
+	if len(sel.include) == 0 && len(sel.exclude) == 0 {
+		return nil, fmt.Errorf("label selector %q is empty", selector)
+	}
+
+	return sel, nil
+}
+
+// matches reports whether a probe satisfies every term of the selector.
+// Include terms are re-checked so the result is correct even if the API
+// ignores a filter.
+func (s *labelSelector) matches(p client.Probe) bool {
+	values := make(map[string]string, len(p.Labels))
+	for _, l := range p.Labels {
+		value := ""
+		if l.Value != nil {
+			value = *l.Value
+		}
+		values[l.Key] = value
+	}
+
+	for key, want := range s.include {
+		got, ok := values[key]
+		if !ok || (want != "" && got != want) {
+			return false
+		}
+	}
+
+	for key, unwanted := range s.exclude {
+		if got, ok := values[key]; ok && got == unwanted {
+			return false
+		}
+	}
+
+	return true
+}
+
+// selectProbesByLabels returns every probe matching the label selector,
+// paginating through all results.
+func selectProbesByLabels(ctx context.Context, apiClient *client.Client, selector string) ([]client.Probe, error) {
+	sel, err := parseLabelSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, probeSelectorTimeout)
+	defer cancel()
+
+	probes, err := fetchAllProbesForExport(reqCtx, apiClient, "", sel.include)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]client.Probe, 0, len(probes))
+	for _, p := range probes {
+		if sel.matches(p) {
+			matched = append(matched, p)
+		}
+	}
+
+	return matched, nil
+}
+
+// resolveProbeTargets resolves the probes a bulk command acts on: either the
+// explicit identifiers (UUIDs or names) or every probe matching the label
+// selector. When a selector is used, a preview of the matched probes is
+// printed so the user can review them before confirming. Returns an empty
+// slice (and no error) when the selector matches nothing.
+func resolveProbeTargets(ctx context.Context, apiClient *client.Client, idArgs []string, selector string) ([]uuid.UUID, error) {
+	if selector == "" {
+		return ResolveProbeIDs(ctx, apiClient, idArgs)
+	}
+
+	probes, err := selectProbesByLabels(ctx, apiClient, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(probes) == 0 {
+		fmt.Printf("No probes match selector %q.\n", selector)
+		return nil, nil
+	}
+
+	printProbeSelectionPreview(selector, probes)
+
+	probeIDs := make([]uuid.UUID, 0, len(probes))
+	for _, p := range probes {
+		probeIDs = append(probeIDs, p.ID)
+	}
+	return probeIDs, nil
+}
+
+// printProbeSelectionPreview lists the probes matched by a selector.
+func printProbeSelectionPreview(selector string, probes []client.Probe) {
+	noun := "probes"
+	if len(probes) == 1 {
+		noun = "probe"
+	}
+	fmt.Printf("Selector %q matched %d %s:\n", selector, len(probes), noun)
+
+	for i, p := range probes {
+		if i == probeSelectorPreviewLimit {
+			fmt.Printf("  ... and %d more\n", len(probes)-probeSelectorPreviewLimit)
+			break
+		}
+		fmt.Printf("  - %s (%s) [%s]\n", p.Name, p.ID, p.Status)
+	}
+	fmt.Println()
+}
+
+// probeIDStrings converts probe UUIDs to strings for dry-run output.
+func probeIDStrings(ids []uuid.UUID) []string {
+	items := make([]string, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, id.String())
+	}
+	return items
+}
+
+// probeSelectorArgs returns a cobra.PositionalArgs validator that applies
+// withSelector when the --labels selector flag is set and withoutSelector
+// otherwise. Bulk commands take fewer positional arguments when probes are
+// selected by label.
+func probeSelectorArgs(withSelector, withoutSelector cobra.PositionalArgs) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if selector, _ := cmd.Flags().GetString("labels"); selector != "" {
+			return withSelector(cmd, args)
+		}
+		return withoutSelector(cmd, args)
+	}
+}
+
+// noProbeArgsWithSelector rejects positional probe identifiers when probes
+// are selected with --labels.
+func noProbeArgsWithSelector(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("probe IDs cannot be combined with --labels; use one or the other")
+	}
+	return nil
+}
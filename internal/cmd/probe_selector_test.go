@@ -0,0 +1,134 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"testing"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// labeledProbe builds a probe with the given labels; an empty value means a
+// key-only label.
+func labeledProbe(labels map[string]string) client.Probe {
+	p := client.Probe{ID: uuid.New(), Name: "probe"}
+	for k, v := range labels {
+		label := client.ProbeLabel{Key: k}
+		if v != "" {
+			value := v
+			label.Value = &value
+		}
+		p.Labels = append(p.Labels, label)
+	}
+	return p
+}
+
+func TestParseLabelSelector(t *testing.T) {
+	sel, err := parseLabelSelector("env=staging, team!=payments,critical")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"env": "staging", "critical": ""}, sel.include)
+	assert.Equal(t, map[string]string{"team": "payments"}, sel.exclude)
+}
+
+func TestParseLabelSelector_OnlyExclusions(t *testing.T) {
+	sel, err := parseLabelSelector("env!=production")
+	require.NoError(t, err)
+
+	assert.Empty(t, sel.include)
+	assert.Equal(t, map[string]string{"env": "production"}, sel.exclude)
+}
+
+func TestParseLabelSelector_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		" , ",
+		"Env!=prod",
+		"=prod",
+	}
+
+	for _, selector := range tests {
+		_, err := parseLabelSelector(selector)
+		assert.Error(t, err, "selector %q should be rejected", selector)
+	}
+}
+
+func TestLabelSelector_Matches(t *testing.T) {
+	sel, err := parseLabelSelector("env=staging,team!=payments,canary")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   bool
+	}{
+		{"all terms satisfied", map[string]string{"env": "staging", "team": "web", "canary": ""}, true},
+		{"excluded key absent", map[string]string{"env": "staging", "canary": "yes"}, true},
+		{"wrong value", map[string]string{"env": "production", "canary": ""}, false},
+		{"missing key-only label", map[string]string{"env": "staging"}, false},
+		{"excluded value", map[string]string{"env": "staging", "team": "payments", "canary": ""}, false},
+		{"no labels", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sel.matches(labeledProbe(tt.labels)))
+		})
+	}
+}
+
+func TestProbeIDStrings(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+	assert.Equal(t, []string{a.String(), b.String()}, probeIDStrings([]uuid.UUID{a, b}))
+	assert.Empty(t, probeIDStrings(nil))
+}
+
+func TestProbeSelectorArgs(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.Flags().StringP("labels", "l", "", "")
+	validate := probeSelectorArgs(noProbeArgsWithSelector, cobra.MinimumNArgs(1))
+
+	// Without a selector at least one probe ID is required
+	assert.Error(t, validate(cmd, nil))
+	assert.NoError(t, validate(cmd, []string{"api"}))
+
+	// With a selector probe IDs are rejected
+	require.NoError(t, cmd.Flags().Set("labels", "env=staging"))
+	assert.NoError(t, validate(cmd, nil))
+	assert.ErrorContains(t, validate(cmd, []string{"api"}), "cannot be combined with --labels")
+}
+
+func TestBulkProbeCommands_HaveLabelSelector(t *testing.T) {
+	commands := map[string]*cobra.Command{
+		"pause":          NewProbePauseCmd(),
+		"resume":         NewProbeResumeCmd(),
+		"delete":         NewProbeDeleteCmd(),
+		"label":          NewProbeLabelCmd(),
+		"unlabel":        NewProbeUnlabelCmd(),
+		"link-channel":   NewProbeLinkChannelCmd(),
+		"unlink-channel": NewProbeUnlinkChannelCmd(),
+	}
+
+	for name, cmd := range commands {
+		flag := cmd.Flags().Lookup("labels")
+		if assert.NotNil(t, flag, "probe %s should have --labels", name) {
+			assert.Equal(t, "l", flag.Shorthand, "probe %s --labels shorthand", name)
+		}
+		assert.NotNil(t, cmd.Flags().Lookup("yes"), "probe %s should have --yes", name)
+
+		_, ok := cmd.GetFlagCompletionFunc("labels")
+		assert.True(t, ok, "probe %s --labels should have a completion function", name)
+	}
+}
+
+func TestProbeLinkChannelCmd_SelectorTakesOnlyChannel(t *testing.T) {
+	cmd := NewProbeLinkChannelCmd()
+	cmd.SetArgs([]string{"-l", "env=staging", "not-a-uuid"})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid channel ID")
+}
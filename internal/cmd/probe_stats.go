@@ -20,6 +20,7 @@ const probeStatsTimeout = 30 * time.Second
 // probeStatsFlags holds the flag values for the probe stats command.
 type probeStatsFlags struct {
 	period string
+	chart  bool
 }
 
 // ProbeStatsOutput wraps probe statistics for output formatting.
@@ -66,6 +67,12 @@ Statistics Displayed:
   P99 Latency   - 99th percentile response time (estimated from buckets)
   Min/Max       - Minimum and maximum response times observed
 
+Charts:
+  Use --chart to draw response time over the period instead of the summary:
+  a braille line chart of all regions combined, annotated with min/avg/p95/max,
+  followed by a sparkline per region. With -o json or -o yaml, the chart data
+  (bucket averages and annotations) is printed instead.
+
 The probe can be specified by UUID or by name. If the name matches multiple
 probes, you'll be prompted to use the UUID instead.
 
@@ -86,7 +93,10 @@ Examples:
   stackeye probe stats 550e8400-e29b-41d4-a716-446655440000 -o json
 
   # Output as YAML
-  stackeye probe stats "Production API" --period 7d -o yaml`,
+  stackeye probe stats "Production API" --period 7d -o yaml
+
+  # Chart response time over the last 7 days
+  stackeye probe stats "Production API" --period 7d --chart`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runProbeStats(cmd.Context(), args[0], flags)
@@ -95,6 +105,7 @@ Examples:
 
 	// Define command-specific flags
 	cmd.Flags().StringVar(&flags.period, "period", "24h", "statistics period: 24h, 7d, 30d")
+	cmd.Flags().BoolVar(&flags.chart, "chart", false, "chart response time over the period with per-region sparklines")
 
 	return cmd
 }
@@ -119,6 +130,16 @@ func runProbeStats(ctx context.Context, idArg string, flags *probeStatsFlags) er
 		return err
 	}
 
+	if flags.chart {
+		return runProbeChart(ctx, apiClient, probeChartRequest{
+			probeID:   probeID,
+			period:    flags.period,
+			aggregate: aggregate,
+			from:      from,
+			to:        to,
+		})
+	}
+
 	// Call SDK to get aggregated probe results with timeout
 	reqCtx, cancel := context.WithTimeout(ctx, probeStatsTimeout)
 	defer cancel()
@@ -10,21 +10,31 @@ import (
 
 	"github.com/StackEye-IO/stackeye-cli/internal/api"
 	"github.com/StackEye-IO/stackeye-cli/internal/dryrun"
+	cliinteractive "github.com/StackEye-IO/stackeye-cli/internal/interactive"
 	"github.com/StackEye-IO/stackeye-cli/internal/output"
 	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 )
 
 // probeUnlabelTimeout is the maximum time to wait for each API response.
 const probeUnlabelTimeout = 30 * time.Second
 
+// probeUnlabelFlags holds the flag values for the probe unlabel command.
+type probeUnlabelFlags struct {
+	labels string // Label selector used instead of a probe ID
+	yes    bool   // Skip confirmation prompt (selector mode only)
+}
+
 // NewProbeUnlabelCmd creates and returns the probe unlabel subcommand.
 // Task #8069
 func NewProbeUnlabelCmd() *cobra.Command {
+	flags := &probeUnlabelFlags{}
+
 	cmd := &cobra.Command{
 		Use:               "unlabel <probe-id> <keys...>",
 		Short:             "Remove labels from a probe",
-		ValidArgsFunction: ProbeCompletion(),
+		ValidArgsFunction: LeadingArgCompletion(ProbeCompletion(), LabelKeyCompletion()),
 		Long: `Remove one or more labels from a probe by key name.
 
 Label keys that don't exist on the probe are silently ignored (no error).
@@ -33,6 +43,10 @@ This operation does not affect other labels on the probe.
 The probe can be specified by UUID or by name. If the name matches multiple
 probes, you'll be prompted to use the UUID instead.
 
+To remove labels from many probes at once, omit the probe ID and use --labels
+to select every probe matching a label selector (key=value, key!=value or key).
+The matched probes are listed and you are asked to confirm before any change.
+
 Examples:
   # Remove a single label
   stackeye probe unlabel api-health env
@@ -41,13 +55,25 @@ Examples:
   stackeye probe unlabel api-health env tier pci
 
   # Use probe UUID
-  stackeye probe unlabel 550e8400-e29b-41d4-a716-446655440000 env`,
-		Args: cobra.MinimumNArgs(2),
+  stackeye probe unlabel 550e8400-e29b-41d4-a716-446655440000 env
+
+  # Remove a temporary label from every probe that carries it
+  stackeye probe unlabel -l canary canary`,
+		Args: probeSelectorArgs(cobra.MinimumNArgs(1), cobra.MinimumNArgs(2)),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if flags.labels != "" {
+				return runProbeUnlabelBySelector(cmd.Context(), args, flags)
+			}
 			return runProbeUnlabel(cmd.Context(), args[0], args[1:])
 		},
 	}
 
+	cmd.Flags().StringVarP(&flags.labels, "labels", "l", "", probeSelectorUsage)
+	cmd.Flags().BoolVarP(&flags.yes, "yes", "y", false, "skip confirmation prompt when using --labels")
+
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("labels", LabelFilterCompletion())
+
 	return cmd
 }
 
@@ -79,8 +105,92 @@ func runProbeUnlabel(ctx context.Context, probeIDArg string, keys []string) erro
 		return err
 	}
 
-	// Remove each label key sequentially
-	// Per acceptance criteria: silently succeed if label not present
+	if err := removeProbeLabelKeys(ctx, apiClient, probeID, keys); err != nil {
+		return err
+	}
+
+	// Print the updated labels
+	reqCtx, cancel := context.WithTimeout(ctx, probeUnlabelTimeout)
+	defer cancel()
+
+	result, err := client.GetProbeLabels(reqCtx, apiClient, probeID)
+	if err != nil {
+		return fmt.Errorf("failed to get updated labels: %w", err)
+	}
+
+	return output.PrintProbeLabels(result.Labels)
+}
+
+// runProbeUnlabelBySelector removes label keys from every probe matching the
+// --labels selector after previewing the matches and asking for confirmation.
+func runProbeUnlabelBySelector(ctx context.Context, keys []string, flags *probeUnlabelFlags) error {
+	for _, key := range keys {
+		if err := validateLabelKeyForRemoval(key); err != nil {
+			return err
+		}
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	probeIDs, err := resolveProbeTargets(ctx, apiClient, nil, flags.labels)
+	if err != nil {
+		return err
+	}
+	if len(probeIDs) == 0 {
+		return nil
+	}
+
+	keyStr := strings.Join(keys, ", ")
+	if GetDryRun() {
+		dryrun.PrintAction("remove labels from", "probe",
+			"Selector", flags.labels,
+			"Probes", strings.Join(probeIDStrings(probeIDs), ", "),
+			"Label Keys", keyStr,
+		)
+		return nil
+	}
+
+	message := fmt.Sprintf("Remove labels %s from %d probe(s)?", keyStr, len(probeIDs))
+	confirmed, err := cliinteractive.Confirm(message, cliinteractive.WithYesFlag(flags.yes))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Unlabel cancelled.")
+		return nil
+	}
+
+	var unlabelErrors []error
+	unlabeledCount := 0
+
+	for _, probeID := range probeIDs {
+		if err := removeProbeLabelKeys(ctx, apiClient, probeID, keys); err != nil {
+			unlabelErrors = append(unlabelErrors, fmt.Errorf("probe %s: %w", probeID, err))
+			continue
+		}
+
+		unlabeledCount++
+		fmt.Printf("Removed labels from probe %s\n", probeID)
+	}
+
+	if len(unlabelErrors) > 0 {
+		fmt.Printf("\nUpdated %d of %d probes.\n", unlabeledCount, len(probeIDs))
+		for _, err := range unlabelErrors {
+			fmt.Printf("Error: %v\n", err)
+		}
+		return fmt.Errorf("failed to remove labels from %d probe(s)", len(unlabelErrors))
+	}
+
+	fmt.Printf("\nSuccessfully removed labels %s from %d probe(s).\n", keyStr, unlabeledCount)
+	return nil
+}
+
+// removeProbeLabelKeys removes each label key from a probe sequentially.
+// Per acceptance criteria: silently succeed if label not present.
+func removeProbeLabelKeys(ctx context.Context, apiClient *client.Client, probeID uuid.UUID, keys []string) error {
 	for _, key := range keys {
 		if err := validateLabelKeyForRemoval(key); err != nil {
 			return err
@@ -99,17 +209,7 @@ func runProbeUnlabel(ctx context.Context, probeIDArg string, keys []string) erro
 			return fmt.Errorf("failed to remove label %q: %w", key, err)
 		}
 	}
-
-	// Print the updated labels
-	reqCtx, cancel := context.WithTimeout(ctx, probeUnlabelTimeout)
-	defer cancel()
-
-	result, err := client.GetProbeLabels(reqCtx, apiClient, probeID)
-	if err != nil {
-		return fmt.Errorf("failed to get updated labels: %w", err)
-	}
-
-	return output.PrintProbeLabels(result.Labels)
+	return nil
 }
 
 // validateLabelKeyForRemoval validates a label key argument for removal.
@@ -4,10 +4,12 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/StackEye-IO/stackeye-cli/internal/api"
 	"github.com/StackEye-IO/stackeye-cli/internal/dryrun"
+	cliinteractive "github.com/StackEye-IO/stackeye-cli/internal/interactive"
 	"github.com/StackEye-IO/stackeye-cli/internal/output"
 	"github.com/StackEye-IO/stackeye-go-sdk/client"
 	"github.com/google/uuid"
@@ -17,18 +19,31 @@ import (
 // probeUnlinkChannelTimeout is the maximum time to wait for the API response.
 const probeUnlinkChannelTimeout = 30 * time.Second
 
+// probeUnlinkChannelFlags holds the flag values for the probe unlink-channel command.
+type probeUnlinkChannelFlags struct {
+	labels string // Label selector used instead of a probe ID
+	yes    bool   // Skip confirmation prompt (selector mode only)
+}
+
 // NewProbeUnlinkChannelCmd creates and returns the probe unlink-channel subcommand.
 func NewProbeUnlinkChannelCmd() *cobra.Command {
+	flags := &probeUnlinkChannelFlags{}
+
 	cmd := &cobra.Command{
 		Use:               "unlink-channel <probe-id> <channel-id>",
 		Short:             "Unlink a notification channel from a probe",
-		ValidArgsFunction: ProbeCompletion(),
+		ValidArgsFunction: PositionalCompletion(ProbeCompletion(), ChannelCompletion()),
 		Long: `Unlink a notification channel from a probe to stop receiving alert notifications.
 
 The probe can be specified by UUID or by name. If the name matches multiple
 probes, you'll be prompted to use the UUID instead. The channel must still
 be specified by UUID.
 
+To unlink a channel from many probes at once, omit the probe ID and use --labels
+to select every probe matching a label selector (key=value, key!=value or key).
+The matched probes are listed and you are asked to confirm before any change.
+Probes where the channel is already not linked are skipped.
+
 After unlinking, the probe will no longer send alerts to this channel.
 Other linked channels will continue to receive notifications.
 
@@ -41,14 +56,27 @@ Examples:
   stackeye probe unlink-channel 550e8400-e29b-41d4-a716-446655440000 \
     660e8400-e29b-41d4-a716-446655440001
 
+  # Unlink a channel from every production probe owned by the web team
+  stackeye probe unlink-channel -l env=production,team=web \
+    660e8400-e29b-41d4-a716-446655440001
+
 Use 'stackeye probe get <probe-id>' to view currently linked channels.
 Use 'stackeye channel list' to see available notification channels.`,
-		Args: cobra.ExactArgs(2),
+		Args: probeSelectorArgs(cobra.ExactArgs(1), cobra.ExactArgs(2)),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if flags.labels != "" {
+				return runProbeUnlinkChannelBySelector(cmd.Context(), args[0], flags)
+			}
 			return runProbeUnlinkChannel(cmd, args[0], args[1])
 		},
 	}
 
+	cmd.Flags().StringVarP(&flags.labels, "labels", "l", "", probeSelectorUsage)
+	cmd.Flags().BoolVarP(&flags.yes, "yes", "y", false, "skip confirmation prompt when using --labels")
+
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("labels", LabelFilterCompletion())
+
 	return cmd
 }
 
@@ -130,3 +158,108 @@ func runProbeUnlinkChannel(cmd *cobra.Command, probeIDArg, channelIDArg string)
 	fmt.Printf("Successfully unlinked channel %q from probe %q\n\n", channel.Name, probe.Name)
 	return output.Print(updatedProbe)
 }
+
+// runProbeUnlinkChannelBySelector unlinks a channel from every probe matching the
+// --labels selector after previewing the matches and asking for confirmation.
+func runProbeUnlinkChannelBySelector(ctx context.Context, channelIDArg string, flags *probeUnlinkChannelFlags) error {
+	// Parse and validate channel UUID (channels are always referenced by UUID)
+	channelID, err := uuid.Parse(channelIDArg)
+	if err != nil {
+		return fmt.Errorf("invalid channel ID %q: must be a valid UUID", channelIDArg)
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	probeIDs, err := resolveProbeTargets(ctx, apiClient, nil, flags.labels)
+	if err != nil {
+		return err
+	}
+	if len(probeIDs) == 0 {
+		return nil
+	}
+
+	if GetDryRun() {
+		dryrun.PrintAction("unlink channel from", "probe",
+			"Selector", flags.labels,
+			"Probes", strings.Join(probeIDStrings(probeIDs), ", "),
+			"Channel", channelIDArg,
+		)
+		return nil
+	}
+
+	// Validate channel exists once before touching any probe
+	chCtx, chCancel := context.WithTimeout(ctx, probeUnlinkChannelTimeout)
+	channel, err := client.GetChannel(chCtx, apiClient, channelID)
+	chCancel()
+	if err != nil {
+		return fmt.Errorf("failed to get channel: %w", err)
+	}
+
+	message := fmt.Sprintf("Unlink channel %q from %d probe(s)?", channel.Name, len(probeIDs))
+	confirmed, err := cliinteractive.Confirm(message, cliinteractive.WithYesFlag(flags.yes))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("Unlink cancelled.")
+		return nil
+	}
+
+	var unlinkErrors []error
+	unlinkedCount := 0
+	skippedCount := 0
+
+	for _, probeID := range probeIDs {
+		reqCtx, cancel := context.WithTimeout(ctx, probeUnlinkChannelTimeout)
+		probe, err := client.GetProbe(reqCtx, apiClient, probeID, "")
+		if err != nil {
+			cancel()
+			unlinkErrors = append(unlinkErrors, fmt.Errorf("probe %s: %w", probeID, err))
+			continue
+		}
+
+		if !containsChannelID(probe.AlertChannelIDs, channelID) {
+			fmt.Printf("Skipped probe %q: channel not linked\n", probe.Name)
+			cancel()
+			skippedCount++
+			continue
+		}
+
+		newChannelIDs := make([]uuid.UUID, 0, len(probe.AlertChannelIDs)-1)
+		for _, id := range probe.AlertChannelIDs {
+			if id != channelID {
+				newChannelIDs = append(newChannelIDs, id)
+			}
+		}
+
+		_, err = client.UpdateProbe(reqCtx, apiClient, probeID, &client.UpdateProbeRequest{
+			AlertChannelIDs: newChannelIDs,
+		})
+		cancel()
+		if err != nil {
+			unlinkErrors = append(unlinkErrors, fmt.Errorf("probe %s: %w", probeID, err))
+			continue
+		}
+
+		unlinkedCount++
+		fmt.Printf("Unlinked channel from probe %q\n", probe.Name)
+	}
+
+	if len(unlinkErrors) > 0 {
+		fmt.Printf("\nUnlinked channel from %d of %d probes.\n", unlinkedCount, len(probeIDs))
+		for _, err := range unlinkErrors {
+			fmt.Printf("Error: %v\n", err)
+		}
+		return fmt.Errorf("failed to unlink channel from %d probe(s)", len(unlinkErrors))
+	}
+
+	fmt.Printf("\nSuccessfully unlinked channel %q from %d probe(s)", channel.Name, unlinkedCount)
+	if skippedCount > 0 {
+		fmt.Printf(" (%d skipped)", skippedCount)
+	}
+	fmt.Println(".")
+	return nil
+}
@@ -103,6 +103,9 @@ Examples:
 
 	registerProbeUpdateFlags(cmd, flags)
 
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("regions", RegionListCompletion())
+
 	return cmd
 }
 
@@ -0,0 +1,400 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// probeUptimeCalendarTimeout is the maximum time to wait for the API responses.
+const probeUptimeCalendarTimeout = 60 * time.Second
+
+// probeUptimeCalendarMaxDays is the longest calendar that can be requested.
+const probeUptimeCalendarMaxDays = 365
+
+// probeUptimeCalendarPageSize is the page size used when fetching alerts.
+const probeUptimeCalendarPageSize = 100
+
+// Uptime levels, from no data to a bad day. Each level has its own glyph so
+// the calendar stays readable without colors.
+const (
+	uptimeLevelNoData = iota
+	uptimeLevelPerfect
+	uptimeLevelExcellent
+	uptimeLevelGood
+	uptimeLevelFair
+	uptimeLevelPoor
+)
+
+// uptimeLevelGlyphs are the calendar cells for each uptime level.
+var uptimeLevelGlyphs = [...]string{
+	uptimeLevelNoData:    "·",
+	uptimeLevelPerfect:   "█",
+	uptimeLevelExcellent: "▓",
+	uptimeLevelGood:      "▒",
+	uptimeLevelFair:      "░",
+	uptimeLevelPoor:      "×",
+}
+
+// uptimeLevelLegend describes each uptime level in the legend.
+var uptimeLevelLegend = [...]string{
+	uptimeLevelNoData:    "no data",
+	uptimeLevelPerfect:   "100%",
+	uptimeLevelExcellent: "≥99.9%",
+	uptimeLevelGood:      "≥99%",
+	uptimeLevelFair:      "≥95%",
+	uptimeLevelPoor:      "<95%",
+}
+
+// probeUptimeCalendarFlags holds the flag values for the probe uptime-calendar command.
+type probeUptimeCalendarFlags struct {
+	days int
+}
+
+// UptimeCalendarDay is the uptime of a probe on one UTC day.
+type UptimeCalendarDay struct {
+	Date          string   `json:"date" yaml:"date"`
+	UptimePercent *float64 `json:"uptime_percent" yaml:"uptime_percent"`
+	TotalChecks   int64    `json:"total_checks" yaml:"total_checks"`
+	FailureChecks int64    `json:"failure_checks" yaml:"failure_checks"`
+	Outages       int      `json:"outages" yaml:"outages"`
+}
+
+// UptimeCalendarOutput is the data behind the uptime calendar.
+// This struct is exported to allow JSON/YAML serialization with proper field tags.
+type UptimeCalendarOutput struct {
+	ProbeID       uuid.UUID           `json:"probe_id" yaml:"probe_id"`
+	ProbeName     string              `json:"probe_name" yaml:"probe_name"`
+	From          time.Time           `json:"from" yaml:"from"`
+	To            time.Time           `json:"to" yaml:"to"`
+	UptimePercent *float64            `json:"uptime_percent" yaml:"uptime_percent"`
+	TotalChecks   int64               `json:"total_checks" yaml:"total_checks"`
+	FailureChecks int64               `json:"failure_checks" yaml:"failure_checks"`
+	Outages       int                 `json:"outages" yaml:"outages"`
+	Days          []UptimeCalendarDay `json:"days" yaml:"days"`
+}
+
+// NewProbeUptimeCalendarCmd creates and returns the probe uptime-calendar subcommand.
+func NewProbeUptimeCalendarCmd() *cobra.Command {
+	flags := &probeUptimeCalendarFlags{}
+
+	cmd := &cobra.Command{
+		Use:               "uptime-calendar <id>",
+		Short:             "Show daily uptime as a calendar heatmap",
+		Aliases:           []string{"calendar"},
+		ValidArgsFunction: ProbeCompletion(),
+		Long: `Show a probe's daily uptime as a calendar heatmap.
+
+Renders one cell per day, one column per week (Monday to Sunday), shaded by the
+day's uptime percentage computed from the probe's check results. Below the grid,
+a legend explains the shading and a summary shows overall uptime, the number of
+outages (down alerts raised for the probe) and the worst day.
+
+Cell Shading:
+  █  100%        every check succeeded
+  ▓  ≥99.9%
+  ▒  ≥99%
+  ░  ≥95%
+  ×  <95%
+  ·  no data     no checks ran that day
+
+Days are calendar days in UTC, matching the API's daily aggregation. With
+-o json or -o yaml, the per-day uptime, failed checks and outage counts are
+printed instead of the grid.
+
+The probe can be specified by UUID or by name. If the name matches multiple
+probes, you'll be prompted to use the UUID instead.
+
+Examples:
+  # Last 90 days (default)
+  stackeye probe uptime-calendar "Production API"
+
+  # Last year
+  stackeye probe uptime-calendar "Production API" --days 365
+
+  # Per-day data for a report
+  stackeye probe uptime-calendar "Production API" --days 30 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runProbeUptimeCalendar(cmd.Context(), args[0], flags)
+		},
+	}
+
+	cmd.Flags().IntVar(&flags.days, "days", 90, fmt.Sprintf("number of days to show, including today (max %d)", probeUptimeCalendarMaxDays))
+
+	return cmd
+}
+
+// runProbeUptimeCalendar executes the probe uptime-calendar command logic.
+func runProbeUptimeCalendar(ctx context.Context, idArg string, flags *probeUptimeCalendarFlags) error {
+	if flags.days < 1 || flags.days > probeUptimeCalendarMaxDays {
+		return fmt.Errorf("invalid days %d: must be between 1 and %d", flags.days, probeUptimeCalendarMaxDays)
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	probeID, err := ResolveProbeID(ctx, apiClient, idArg)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	from := calendarStartDay(now, flags.days)
+
+	reqCtx, cancel := context.WithTimeout(ctx, probeUptimeCalendarTimeout)
+	defer cancel()
+
+	probe, err := client.GetProbe(reqCtx, apiClient, probeID, "24h")
+	if err != nil {
+		return fmt.Errorf("failed to get probe: %w", err)
+	}
+
+	results, err := client.GetAggregatedProbeResults(reqCtx, apiClient, probeID, "1d", from, now)
+	if err != nil {
+		return fmt.Errorf("failed to get probe results: %w", err)
+	}
+
+	outages, err := fetchProbeOutageTimes(reqCtx, apiClient, probeID, from, now)
+	if err != nil {
+		return err
+	}
+
+	calendar := buildUptimeCalendar(probeID, probe.Name, from, now, flags.days, results.Results, outages)
+
+	format := output.NewPrinter(GetConfig()).Format()
+	if format == sdkoutput.FormatJSON || format == sdkoutput.FormatYAML {
+		return output.Print(calendar)
+	}
+
+	renderUptimeCalendar(os.Stdout, calendar, output.NewColorManager())
+	return nil
+}
+
+// calendarStartDay returns midnight UTC of the first day of a calendar that
+// spans days days and ends today.
+func calendarStartDay(now time.Time, days int) time.Time {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return today.AddDate(0, 0, -(days - 1))
+}
+
+// fetchProbeOutageTimes returns when each down alert for the probe was
+// triggered within from..to.
+func fetchProbeOutageTimes(ctx context.Context, apiClient *client.Client, probeID uuid.UUID, from, to time.Time) ([]time.Time, error) {
+	var times []time.Time
+	for offset := 0; ; offset += probeUptimeCalendarPageSize {
+		result, err := client.ListAlerts(ctx, apiClient, &client.ListAlertsOptions{
+			Limit:   probeUptimeCalendarPageSize,
+			Offset:  offset,
+			ProbeID: &probeID,
+			From:    &from,
+			To:      &to,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list alerts: %w", err)
+		}
+		for _, a := range result.Alerts {
+			if a.AlertType == client.AlertTypeStatusDown {
+				times = append(times, a.TriggeredAt)
+			}
+		}
+		if len(result.Alerts) < probeUptimeCalendarPageSize {
+			return times, nil
+		}
+	}
+}
+
+// buildUptimeCalendar folds daily result buckets and outage start times into
+// one entry per day, oldest first.
+func buildUptimeCalendar(probeID uuid.UUID, name string, from, to time.Time, days int, results []client.AggregatedResult, outages []time.Time) *UptimeCalendarOutput {
+	cal := &UptimeCalendarOutput{
+		ProbeID:   probeID,
+		ProbeName: name,
+		From:      from,
+		To:        to,
+		Days:      make([]UptimeCalendarDay, days),
+	}
+
+	index := make(map[string]int, days)
+	for i := range cal.Days {
+		date := from.AddDate(0, 0, i).Format(time.DateOnly)
+		cal.Days[i].Date = date
+		index[date] = i
+	}
+
+	success := make([]int64, days)
+	for _, r := range results {
+		i, ok := index[r.TimeBucket.UTC().Format(time.DateOnly)]
+		if !ok {
+			continue
+		}
+		cal.Days[i].TotalChecks += r.TotalChecks
+		cal.Days[i].FailureChecks += r.FailureChecks
+		success[i] += r.SuccessChecks
+	}
+
+	for _, t := range outages {
+		if i, ok := index[t.UTC().Format(time.DateOnly)]; ok {
+			cal.Days[i].Outages++
+			cal.Outages++
+		}
+	}
+
+	var totalSuccess int64
+	for i := range cal.Days {
+		day := &cal.Days[i]
+		if day.TotalChecks > 0 {
+			pct := float64(success[i]) / float64(day.TotalChecks) * 100
+			day.UptimePercent = &pct
+		}
+		cal.TotalChecks += day.TotalChecks
+		cal.FailureChecks += day.FailureChecks
+		totalSuccess += success[i]
+	}
+	if cal.TotalChecks > 0 {
+		pct := float64(totalSuccess) / float64(cal.TotalChecks) * 100
+		cal.UptimePercent = &pct
+	}
+
+	return cal
+}
+
+// uptimeLevel buckets a day's uptime into a shading level.
+func uptimeLevel(pct *float64) int {
+	switch {
+	case pct == nil:
+		return uptimeLevelNoData
+	case *pct >= 100:
+		return uptimeLevelPerfect
+	case *pct >= 99.9:
+		return uptimeLevelExcellent
+	case *pct >= 99:
+		return uptimeLevelGood
+	case *pct >= 95:
+		return uptimeLevelFair
+	default:
+		return uptimeLevelPoor
+	}
+}
+
+// uptimeCell returns the colored glyph for an uptime level.
+func uptimeCell(level int, colorMgr *sdkoutput.ColorManager) string {
+	glyph := uptimeLevelGlyphs[level]
+	switch level {
+	case uptimeLevelPerfect, uptimeLevelExcellent:
+		return colorMgr.StatusUp(glyph)
+	case uptimeLevelGood, uptimeLevelFair:
+		return colorMgr.StatusWarning(glyph)
+	case uptimeLevelPoor:
+		return colorMgr.StatusDown(glyph)
+	default:
+		return glyph
+	}
+}
+
+// renderUptimeCalendar writes the heatmap grid, legend and summary.
+func renderUptimeCalendar(w io.Writer, cal *UptimeCalendarOutput, colorMgr *sdkoutput.ColorManager) {
+	fmt.Fprintf(w, "Uptime: %s, last %d days\n\n", cal.ProbeName, len(cal.Days))
+	if len(cal.Days) == 0 {
+		return
+	}
+
+	// Columns are weeks starting on Monday; lead pads the first week
+	first, _ := time.Parse(time.DateOnly, cal.Days[0].Date)
+	lead := (int(first.Weekday()) + 6) % 7
+	weeks := (lead + len(cal.Days) + 6) / 7
+
+	// Month labels above the first week containing the 1st of each month
+	const cellWidth = 2
+	months := []byte(strings.Repeat(" ", len("Mon ")+weeks*cellWidth+len("Jan")))
+	lastEnd := 0
+	for i := range cal.Days {
+		day := first.AddDate(0, 0, i)
+		if day.Day() != 1 && i != 0 {
+			continue
+		}
+		pos := len("Mon ") + (lead+i)/7*cellWidth
+		label := day.Format("Jan")
+		if pos < lastEnd {
+			continue
+		}
+		copy(months[pos:], label)
+		lastEnd = pos + len(label) + 1
+	}
+	fmt.Fprintln(w, strings.TrimRight(string(months), " "))
+
+	rowLabels := [7]string{"Mon", "", "Wed", "", "Fri", "", "Sun"}
+	for row := 0; row < 7; row++ {
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "%-3s ", rowLabels[row])
+		for week := 0; week < weeks; week++ {
+			i := week*7 + row - lead
+			if i < 0 || i >= len(cal.Days) {
+				sb.WriteString("  ")
+				continue
+			}
+			sb.WriteString(uptimeCell(uptimeLevel(cal.Days[i].UptimePercent), colorMgr))
+			sb.WriteString(" ")
+		}
+		fmt.Fprintln(w, strings.TrimRight(sb.String(), " "))
+	}
+
+	fmt.Fprintln(w)
+	legend := make([]string, 0, len(uptimeLevelGlyphs))
+	for level := uptimeLevelPerfect; level <= uptimeLevelPoor; level++ {
+		legend = append(legend, uptimeCell(level, colorMgr)+" "+uptimeLevelLegend[level])
+	}
+	legend = append(legend, uptimeCell(uptimeLevelNoData, colorMgr)+" "+uptimeLevelLegend[uptimeLevelNoData])
+	fmt.Fprintf(w, "    %s\n\n", strings.Join(legend, "  "))
+
+	if cal.UptimePercent == nil {
+		fmt.Fprintln(w, "Uptime:     no checks in this period")
+	} else {
+		fmt.Fprintf(w, "Uptime:     %.3f%% (%d checks, %d failed)\n", *cal.UptimePercent, cal.TotalChecks, cal.FailureChecks)
+	}
+	fmt.Fprintf(w, "Outages:    %d on %d day(s)\n", cal.Outages, countOutageDays(cal.Days))
+	if worst := worstUptimeDay(cal.Days); worst != nil {
+		fmt.Fprintf(w, "Worst day:  %s, %.2f%% uptime, %d outage(s)\n", worst.Date, *worst.UptimePercent, worst.Outages)
+	}
+}
+
+// countOutageDays returns how many days had at least one outage.
+func countOutageDays(days []UptimeCalendarDay) int {
+	n := 0
+	for _, d := range days {
+		if d.Outages > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// worstUptimeDay returns the day with the lowest uptime below 100%, or nil if
+// every day with data was perfect. Ties go to the day with more outages.
+func worstUptimeDay(days []UptimeCalendarDay) *UptimeCalendarDay {
+	var worst *UptimeCalendarDay
+	for i := range days {
+		d := &days[i]
+		if d.UptimePercent == nil || *d.UptimePercent >= 100 {
+			continue
+		}
+		if worst == nil || *d.UptimePercent < *worst.UptimePercent ||
+			(*d.UptimePercent == *worst.UptimePercent && d.Outages > worst.Outages) {
+			worst = d
+		}
+	}
+	return worst
+}
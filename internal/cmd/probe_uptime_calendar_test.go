@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+)
+
+func TestNewProbeUptimeCalendarCmd(t *testing.T) {
+	cmd := NewProbeUptimeCalendarCmd()
+
+	if cmd.Use != "uptime-calendar <id>" {
+		t.Errorf("expected Use to be 'uptime-calendar <id>', got %q", cmd.Use)
+	}
+
+	daysFlag := cmd.Flags().Lookup("days")
+	if daysFlag == nil {
+		t.Fatal("expected 'days' flag to be defined")
+	}
+	if daysFlag.DefValue != "90" {
+		t.Errorf("expected days default to be 90, got %q", daysFlag.DefValue)
+	}
+}
+
+func TestRunProbeUptimeCalendar_InvalidDays(t *testing.T) {
+	for _, days := range []int{0, -1, probeUptimeCalendarMaxDays + 1} {
+		err := runProbeUptimeCalendar(t.Context(), "api", &probeUptimeCalendarFlags{days: days})
+		if err == nil {
+			t.Errorf("days %d: expected error", days)
+			continue
+		}
+		if !strings.Contains(err.Error(), "invalid days") {
+			t.Errorf("days %d: expected validation error, got %v", days, err)
+		}
+	}
+}
+
+func TestCalendarStartDay(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 30, 0, 0, time.UTC)
+
+	got := calendarStartDay(now, 10)
+	want := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestBuildUptimeCalendar(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 2).Add(12 * time.Hour)
+	results := []client.AggregatedResult{
+		{TimeBucket: from, TotalChecks: 100, SuccessChecks: 100},
+		{TimeBucket: from.AddDate(0, 0, 1), TotalChecks: 200, SuccessChecks: 190, FailureChecks: 10},
+		{TimeBucket: from.AddDate(0, 0, -5), TotalChecks: 50, SuccessChecks: 0, FailureChecks: 50},
+	}
+	outages := []time.Time{
+		from.AddDate(0, 0, 1).Add(3 * time.Hour),
+		from.AddDate(0, 0, 1).Add(9 * time.Hour),
+		from.AddDate(0, 0, -5),
+	}
+
+	cal := buildUptimeCalendar(uuid.New(), "api", from, to, 3, results, outages)
+
+	if len(cal.Days) != 3 {
+		t.Fatalf("expected 3 days, got %d", len(cal.Days))
+	}
+	if cal.Days[0].Date != "2026-03-01" || cal.Days[2].Date != "2026-03-03" {
+		t.Errorf("unexpected dates: %s..%s", cal.Days[0].Date, cal.Days[2].Date)
+	}
+	if cal.Days[0].UptimePercent == nil || *cal.Days[0].UptimePercent != 100 {
+		t.Errorf("expected 100%% on day 1, got %v", cal.Days[0].UptimePercent)
+	}
+	if cal.Days[1].UptimePercent == nil || *cal.Days[1].UptimePercent != 95 {
+		t.Errorf("expected 95%% on day 2, got %v", cal.Days[1].UptimePercent)
+	}
+	if cal.Days[2].UptimePercent != nil {
+		t.Errorf("expected no data on day 3, got %v", *cal.Days[2].UptimePercent)
+	}
+	if cal.Days[1].Outages != 2 || cal.Outages != 2 {
+		t.Errorf("expected 2 outages on day 2 and in total, got %d/%d", cal.Days[1].Outages, cal.Outages)
+	}
+	if cal.TotalChecks != 300 || cal.FailureChecks != 10 {
+		t.Errorf("expected 300 checks / 10 failed, got %d/%d", cal.TotalChecks, cal.FailureChecks)
+	}
+	if cal.UptimePercent == nil || *cal.UptimePercent < 96.66 || *cal.UptimePercent > 96.67 {
+		t.Errorf("expected overall uptime ~96.67%%, got %v", cal.UptimePercent)
+	}
+}
+
+func TestUptimeLevel(t *testing.T) {
+	pct := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		pct  *float64
+		want int
+	}{
+		{nil, uptimeLevelNoData},
+		{pct(100), uptimeLevelPerfect},
+		{pct(99.95), uptimeLevelExcellent},
+		{pct(99.5), uptimeLevelGood},
+		{pct(97), uptimeLevelFair},
+		{pct(80), uptimeLevelPoor},
+	}
+	for _, tt := range tests {
+		if got := uptimeLevel(tt.pct); got != tt.want {
+			t.Errorf("uptimeLevel(%v): expected %d, got %d", tt.pct, tt.want, got)
+		}
+	}
+}
+
+func TestRenderUptimeCalendar(t *testing.T) {
+	// 2026-03-02 is a Monday; 14 days fill exactly two week columns
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	results := []client.AggregatedResult{
+		{TimeBucket: from, TotalChecks: 100, SuccessChecks: 100},
+		{TimeBucket: from.AddDate(0, 0, 1), TotalChecks: 100, SuccessChecks: 90, FailureChecks: 10},
+	}
+	cal := buildUptimeCalendar(uuid.New(), "Production API", from, from.AddDate(0, 0, 14), 14, results,
+		[]time.Time{from.AddDate(0, 0, 1)})
+
+	var buf bytes.Buffer
+	renderUptimeCalendar(&buf, cal, sdkoutput.NewColorManager(sdkoutput.ColorNever))
+	lines := strings.Split(buf.String(), "\n")
+
+	if lines[0] != "Uptime: Production API, last 14 days" {
+		t.Errorf("unexpected title %q", lines[0])
+	}
+	if lines[2] != "    Mar" {
+		t.Errorf("expected month label row, got %q", lines[2])
+	}
+	if lines[3] != "Mon █ ·" {
+		t.Errorf("expected Monday row, got %q", lines[3])
+	}
+	if lines[4] != "    × ·" {
+		t.Errorf("expected Tuesday row, got %q", lines[4])
+	}
+
+	out := buf.String()
+	for _, want := range []string{"█ 100%", "× <95%", "· no data", "Outages:    1 on 1 day(s)", "Worst day:  2026-03-03, 90.00% uptime, 1 outage(s)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderUptimeCalendar_LeadingPadding(t *testing.T) {
+	// 2026-03-04 is a Wednesday, so Monday and Tuesday of the first week are blank
+	from := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	cal := buildUptimeCalendar(uuid.New(), "api", from, from.AddDate(0, 0, 3), 3, nil, nil)
+
+	var buf bytes.Buffer
+	renderUptimeCalendar(&buf, cal, sdkoutput.NewColorManager(sdkoutput.ColorNever))
+	lines := strings.Split(buf.String(), "\n")
+
+	if lines[2] != "    Mar" {
+		t.Errorf("expected month label above the first week, got %q", lines[2])
+	}
+	if lines[3] != "Mon" {
+		t.Errorf("expected empty Monday row, got %q", lines[3])
+	}
+	if lines[5] != "Wed ·" {
+		t.Errorf("expected Wednesday cell, got %q", lines[5])
+	}
+	if !strings.Contains(buf.String(), "no checks in this period") {
+		t.Error("expected no-data summary")
+	}
+}
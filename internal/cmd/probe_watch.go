@@ -22,6 +22,8 @@ const probeWatchTimeout = 15 * time.Second
 type probeWatchFlags struct {
 	interval time.Duration
 	status   string
+	onChange bool
+	exec     string
 }
 
 // NewProbeWatchCmd creates and returns the probe watch subcommand.
@@ -44,6 +46,27 @@ The display automatically refreshes, showing current status, response times,
 and last check timestamps. In non-interactive mode (piped output, JSON/YAML
 format), a single snapshot is printed and the command exits.
 
+Transitions:
+  With --on-change, the table is replaced by an event stream: consecutive
+  polls are compared and one line is printed for every status change (for
+  example UP -> DOWN or DOWN -> UP). Probes' states at startup are the
+  baseline and are not reported. With -o json, each transition is printed as
+  a single JSON object per line. --on-change also works when output is piped.
+  With --status, only transitions into or out of that status are reported.
+
+  --exec runs a shell command for every transition and implies --on-change.
+  The probe is written to the command's stdin as JSON and these environment
+  variables are set:
+
+    STACKEYE_PROBE_ID           Probe UUID
+    STACKEYE_PROBE_NAME         Probe name
+    STACKEYE_PROBE_URL          Probe target
+    STACKEYE_PROBE_OLD_STATUS   Status before the transition
+    STACKEYE_PROBE_NEW_STATUS   Status after the transition
+
+  Hooks run one at a time in the order transitions are detected. Hook output
+  is written to stderr; a failing hook is reported and the watch continues.
+
 Interval:
   The refresh interval controls how often the display is updated. The minimum
   interval is 1 second. Shorter intervals provide more responsive updates but
@@ -69,7 +92,16 @@ Examples:
   stackeye probe watch -i 10s
 
   # Single snapshot as JSON (non-interactive)
-  stackeye probe watch -o json`,
+  stackeye probe watch -o json
+
+  # Print UP/DOWN transitions as they happen
+  stackeye probe watch --on-change
+
+  # Run a script whenever a probe changes status
+  stackeye probe watch --exec './on-status-change.sh'
+
+  # Stream transitions for one probe as JSON lines
+  stackeye probe watch "Production API" --on-change -o json`,
 		Aliases: []string{"w"},
 		Args:    cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -83,6 +115,8 @@ Examples:
 
 	cmd.Flags().DurationVarP(&flags.interval, "interval", "i", 5*time.Second, "refresh interval (minimum 1s)")
 	cmd.Flags().StringVarP(&flags.status, "status", "s", "", "filter by status: up, down, degraded, paused, pending")
+	cmd.Flags().BoolVar(&flags.onChange, "on-change", false, "print status transitions instead of refreshing a table")
+	cmd.Flags().StringVar(&flags.exec, "exec", "", "shell command to run for each transition (probe JSON on stdin, implies --on-change)")
 
 	return cmd
 }
@@ -119,6 +153,10 @@ func runProbeWatch(ctx context.Context, idArg string, flags *probeWatchFlags) er
 		return fmt.Errorf("failed to initialize API client: %w", err)
 	}
 
+	if flags.onChange || flags.exec != "" {
+		return runProbeWatchTransitions(ctx, apiClient, idArg, probeStatus, flags)
+	}
+
 	// If a specific probe is requested, resolve its ID
 	if idArg != "" {
 		return runProbeWatchSingle(ctx, apiClient, idArg, flags)
@@ -75,6 +75,8 @@ func TestNewProbeWatchCmd_Flags(t *testing.T) {
 	}{
 		{"interval", "i", "5s"},
 		{"status", "s", ""},
+		{"on-change", "", "false"},
+		{"exec", "", ""},
 	}
 
 	for _, f := range flags {
@@ -0,0 +1,168 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+)
+
+// ProbeTransition is a probe status change detected by probe watch --on-change.
+type ProbeTransition struct {
+	Time      time.Time    `json:"time" yaml:"time"`
+	ProbeID   uuid.UUID    `json:"probe_id" yaml:"probe_id"`
+	Name      string       `json:"name" yaml:"name"`
+	OldStatus string       `json:"old_status" yaml:"old_status"`
+	NewStatus string       `json:"new_status" yaml:"new_status"`
+	Probe     client.Probe `json:"probe" yaml:"probe"`
+}
+
+// runProbeWatchTransitions polls probes and reports status changes, running
+// the --exec hook for each one. It runs until the context is canceled,
+// regardless of whether output is interactive.
+func runProbeWatchTransitions(ctx context.Context, apiClient *client.Client, idArg string, probeStatus client.ProbeStatus, flags *probeWatchFlags) error {
+	fetch := func() (map[uuid.UUID]client.Probe, error) {
+		reqCtx, cancel := context.WithTimeout(ctx, probeWatchTimeout)
+		defer cancel()
+
+		// The status filter is applied to transitions, not the listing, so
+		// probes moving out of the filtered status are still seen
+		probes, err := fetchAllProbesForExport(reqCtx, apiClient, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		return probesByID(probes), nil
+	}
+
+	if idArg != "" {
+		probeID, err := ResolveProbeID(ctx, apiClient, idArg)
+		if err != nil {
+			return err
+		}
+		fetch = func() (map[uuid.UUID]client.Probe, error) {
+			reqCtx, cancel := context.WithTimeout(ctx, probeWatchTimeout)
+			defer cancel()
+
+			probe, err := client.GetProbe(reqCtx, apiClient, probeID, "24h")
+			if err != nil {
+				return nil, fmt.Errorf("failed to get probe: %w", err)
+			}
+			return probesByID([]client.Probe{*probe}), nil
+		}
+	}
+
+	// The first poll is the baseline: current states are not reported
+	prev, err := fetch()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Watching %d probe(s) for status changes every %s (Ctrl+C to stop)\n", len(prev), flags.interval)
+
+	printer := output.NewPrinter(GetConfig())
+	format := printer.Format()
+
+	ticker := time.NewTicker(flags.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			fmt.Fprintln(os.Stderr, "\nWatch stopped.")
+			return nil
+		case <-ticker.C:
+			curr, err := fetch()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error polling: %v (retrying...)\n", err)
+				continue
+			}
+
+			for _, t := range diffProbeStatuses(prev, curr, probeStatus, time.Now()) {
+				if err := printProbeTransition(os.Stdout, t, format); err != nil {
+					fmt.Fprintf(os.Stderr, "Error printing: %v\n", err)
+				}
+				if flags.exec != "" {
+					if err := runExecHook(ctx, flags.exec, t.Probe, probeTransitionEnv(t)); err != nil {
+						fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+					}
+				}
+			}
+			prev = curr
+		}
+	}
+}
+
+// probesByID indexes probes by ID.
+func probesByID(probes []client.Probe) map[uuid.UUID]client.Probe {
+	byID := make(map[uuid.UUID]client.Probe, len(probes))
+	for _, p := range probes {
+		byID[p.ID] = p
+	}
+	return byID
+}
+
+// diffProbeStatuses returns the status changes between two polls, sorted by
+// probe name. Probes added or removed between polls are not transitions.
+// When filter is set, only transitions into or out of that status are
+// returned.
+func diffProbeStatuses(prev, curr map[uuid.UUID]client.Probe, filter client.ProbeStatus, now time.Time) []ProbeTransition {
+	var transitions []ProbeTransition
+	for id, p := range curr {
+		old, ok := prev[id]
+		if !ok || old.Status == p.Status {
+			continue
+		}
+		if filter != "" && old.Status != string(filter) && p.Status != string(filter) {
+			continue
+		}
+		transitions = append(transitions, ProbeTransition{
+			Time:      now,
+			ProbeID:   id,
+			Name:      p.Name,
+			OldStatus: old.Status,
+			NewStatus: p.Status,
+			Probe:     p,
+		})
+	}
+
+	sort.Slice(transitions, func(i, j int) bool {
+		return transitions[i].Name < transitions[j].Name
+	})
+	return transitions
+}
+
+// printProbeTransition writes one transition: a JSON object per line for JSON
+// output, a YAML document for YAML output, or a single text line otherwise.
+func printProbeTransition(w io.Writer, t ProbeTransition, format sdkoutput.Format) error {
+	switch format {
+	case sdkoutput.FormatJSON:
+		return json.NewEncoder(w).Encode(t)
+	case sdkoutput.FormatYAML:
+		return output.Print(t)
+	}
+
+	_, err := fmt.Fprintf(w, "%s  %-30s  %s -> %s  %s\n",
+		t.Time.Format("2006-01-02 15:04:05"), truncate(t.Name, 30),
+		strings.ToUpper(t.OldStatus), strings.ToUpper(t.NewStatus), t.ProbeID)
+	return err
+}
+
+// probeTransitionEnv returns the environment variables passed to --exec hooks.
+func probeTransitionEnv(t ProbeTransition) []string {
+	return []string{
+		"STACKEYE_PROBE_ID=" + t.ProbeID.String(),
+		"STACKEYE_PROBE_NAME=" + t.Name,
+		"STACKEYE_PROBE_URL=" + t.Probe.URL,
+		"STACKEYE_PROBE_OLD_STATUS=" + t.OldStatus,
+		"STACKEYE_PROBE_NEW_STATUS=" + t.NewStatus,
+	}
+}
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+)
+
+func TestDiffProbeStatuses(t *testing.T) {
+	api := client.Probe{ID: uuid.New(), Name: "api", Status: "up"}
+	web := client.Probe{ID: uuid.New(), Name: "web", Status: "down"}
+	db := client.Probe{ID: uuid.New(), Name: "db", Status: "up"}
+	added := client.Probe{ID: uuid.New(), Name: "new", Status: "down"}
+
+	prev := probesByID([]client.Probe{api, web, db})
+
+	apiDown, webUp := api, web
+	apiDown.Status = "down"
+	webUp.Status = "up"
+	curr := probesByID([]client.Probe{apiDown, webUp, db, added})
+
+	now := time.Now()
+	transitions := diffProbeStatuses(prev, curr, "", now)
+
+	if len(transitions) != 2 {
+		t.Fatalf("expected 2 transitions, got %d: %+v", len(transitions), transitions)
+	}
+	if transitions[0].Name != "api" || transitions[0].OldStatus != "up" || transitions[0].NewStatus != "down" {
+		t.Errorf("expected api up -> down, got %+v", transitions[0])
+	}
+	if transitions[1].Name != "web" || transitions[1].OldStatus != "down" || transitions[1].NewStatus != "up" {
+		t.Errorf("expected web down -> up, got %+v", transitions[1])
+	}
+	if !transitions[0].Time.Equal(now) {
+		t.Errorf("expected transition time %v, got %v", now, transitions[0].Time)
+	}
+}
+
+func TestDiffProbeStatuses_StatusFilter(t *testing.T) {
+	a := client.Probe{ID: uuid.New(), Name: "a", Status: "up"}
+	b := client.Probe{ID: uuid.New(), Name: "b", Status: "up"}
+	prev := probesByID([]client.Probe{a, b})
+
+	aDegraded, bPaused := a, b
+	aDegraded.Status = "degraded"
+	bPaused.Status = "paused"
+	curr := probesByID([]client.Probe{aDegraded, bPaused})
+
+	transitions := diffProbeStatuses(prev, curr, client.ProbeStatusDegraded, time.Now())
+	if len(transitions) != 1 || transitions[0].Name != "a" {
+		t.Errorf("expected only the transition into degraded, got %+v", transitions)
+	}
+}
+
+func TestPrintProbeTransition(t *testing.T) {
+	tr := ProbeTransition{
+		Time:      time.Date(2026, 3, 4, 5, 6, 7, 0, time.Local),
+		ProbeID:   uuid.New(),
+		Name:      "Production API",
+		OldStatus: "up",
+		NewStatus: "down",
+	}
+
+	var buf bytes.Buffer
+	if err := printProbeTransition(&buf, tr, sdkoutput.FormatTable); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{"2026-03-04 05:06:07", "Production API", "UP -> DOWN", tr.ProbeID.String()} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("expected %q in output, got %q", want, buf.String())
+		}
+	}
+
+	buf.Reset()
+	if err := printProbeTransition(&buf, tr, sdkoutput.FormatJSON); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("invalid JSON line: %v", err)
+	}
+	if decoded["old_status"] != "up" || decoded["new_status"] != "down" {
+		t.Errorf("unexpected JSON statuses: %v", decoded)
+	}
+}
+
+func TestProbeTransitionEnv(t *testing.T) {
+	tr := ProbeTransition{
+		ProbeID:   uuid.New(),
+		Name:      "api",
+		OldStatus: "down",
+		NewStatus: "up",
+		Probe:     client.Probe{URL: "https://api.example.com"},
+	}
+
+	env := strings.Join(probeTransitionEnv(tr), "\n")
+	for _, want := range []string{
+		"STACKEYE_PROBE_ID=" + tr.ProbeID.String(),
+		"STACKEYE_PROBE_NAME=api",
+		"STACKEYE_PROBE_URL=https://api.example.com",
+		"STACKEYE_PROBE_OLD_STATUS=down",
+		"STACKEYE_PROBE_NEW_STATUS=up",
+	} {
+		if !strings.Contains(env, want) {
+			t.Errorf("expected %q in env, got %q", want, env)
+		}
+	}
+}
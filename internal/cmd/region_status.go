@@ -68,6 +68,9 @@ Examples:
 	// Define command-specific flags
 	cmd.Flags().StringVar(&flags.region, "region", "", "specific region ID to check (e.g., nyc3)")
 
+	// Dynamic completion for flag values
+	_ = cmd.RegisterFlagCompletionFunc("region", RegionCompletion())
+
 	return cmd
 }
 
@@ -0,0 +1,472 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	clierrors "github.com/StackEye-IO/stackeye-cli/internal/errors"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// completionFunc is the signature cobra expects for ValidArgsFunction and
+// RegisterFlagCompletionFunc.
+type completionFunc = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// resourceCompletion builds a completionFunc for the first positional argument
+// from a cached resource fetcher.
+func resourceCompletion(resource string, fetch completionFetcher) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		// Only complete the first positional argument
+		if len(args) >= 1 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		candidates := fetchCompletionCandidates(cmd, resource, fetch)
+		return filterCompletionCandidates(candidates, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// PositionalCompletion returns a completion function that delegates the i-th
+// positional argument to the i-th completion function. Arguments beyond the
+// provided functions are not completed.
+//
+// Example usage:
+//
+//	cmd := &cobra.Command{
+//	    Use:               "link-channel <probe-id> <channel-id>",
+//	    ValidArgsFunction: PositionalCompletion(ProbeCompletion(), ChannelCompletion()),
+//	}
+func PositionalCompletion(fns ...completionFunc) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= len(fns) {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return fns[len(args)](cmd, nil, toComplete)
+	}
+}
+
+// RepeatedCompletion returns a completion function that completes every
+// positional argument with fn, omitting values already given on the command
+// line. Use it for commands accepting "<id> [id...]".
+func RepeatedCompletion(fn completionFunc) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		completions, directive := fn(cmd, nil, toComplete)
+
+		used := make(map[string]bool, len(args))
+		for _, arg := range args {
+			used[strings.ToLower(arg)] = true
+		}
+
+		filtered := completions[:0]
+		for _, c := range completions {
+			value, _, _ := strings.Cut(c, "\t")
+			if !used[strings.ToLower(value)] {
+				filtered = append(filtered, c)
+			}
+		}
+		return filtered, directive
+	}
+}
+
+// LeadingArgCompletion returns a completion function that completes the first
+// positional argument with first and every following argument with rest,
+// omitting values already given. Use it for commands such as
+// "label <probe-id> <labels...>".
+func LeadingArgCompletion(first, rest completionFunc) completionFunc {
+	repeated := RepeatedCompletion(rest)
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return first(cmd, nil, toComplete)
+		}
+		return repeated(cmd, args[1:], toComplete)
+	}
+}
+
+// ChannelCompletion returns a cobra.ValidArgsFunction that provides
+// notification channel ID completions, described by name and type.
+func ChannelCompletion() completionFunc {
+	return resourceCompletion("channels", fetchChannelCandidates)
+}
+
+// fetchChannelCandidates lists channels for completion.
+func fetchChannelCandidates(ctx context.Context, c *client.Client) ([]completionCandidate, error) {
+	result, err := client.ListChannels(ctx, c, &client.ListChannelsOptions{Limit: 100})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]completionCandidate, 0, len(result.Channels))
+	for _, ch := range result.Channels {
+		desc := fmt.Sprintf("%s (%s)", ch.Name, ch.Type)
+		if !ch.Enabled {
+			desc += " [disabled]"
+		}
+		candidates = append(candidates, completionCandidate{Value: ch.ID.String(), Description: desc})
+	}
+	return candidates, nil
+}
+
+// StatusPageCompletion returns a cobra.ValidArgsFunction that provides status
+// page ID completions, described by name and slug.
+func StatusPageCompletion() completionFunc {
+	return resourceCompletion("status-pages", fetchStatusPageCandidates)
+}
+
+// fetchStatusPageCandidates lists status pages for completion.
+func fetchStatusPageCandidates(ctx context.Context, c *client.Client) ([]completionCandidate, error) {
+	result, err := client.ListStatusPages(ctx, c, &client.ListStatusPagesOptions{Limit: 100})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]completionCandidate, 0, len(result.StatusPages))
+	for _, p := range result.StatusPages {
+		desc := fmt.Sprintf("%s (%s)", p.Name, p.Slug)
+		if !p.Enabled {
+			desc += " [disabled]"
+		}
+		candidates = append(candidates, completionCandidate{
+			Value:       strconv.FormatUint(uint64(p.ID), 10),
+			Description: desc,
+		})
+	}
+	return candidates, nil
+}
+
+// AlertCompletion returns a cobra.ValidArgsFunction that provides alert ID
+// completions, described by severity, status and probe name.
+func AlertCompletion() completionFunc {
+	return resourceCompletion("alerts", fetchAlertCandidates)
+}
+
+// fetchAlertCandidates lists recent alerts for completion.
+func fetchAlertCandidates(ctx context.Context, c *client.Client) ([]completionCandidate, error) {
+	result, err := client.ListAlerts(ctx, c, &client.ListAlertsOptions{Limit: 100})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]completionCandidate, 0, len(result.Alerts))
+	for _, a := range result.Alerts {
+		desc := fmt.Sprintf("%s %s", strings.ToUpper(string(a.Severity)), a.Status)
+		if a.Probe != nil {
+			desc += " - " + a.Probe.Name
+		}
+		candidates = append(candidates, completionCandidate{Value: a.ID.String(), Description: desc})
+	}
+	return candidates, nil
+}
+
+// IncidentCompletion returns a completion function for incident IDs. Incidents
+// are scoped to a status page, so it reads the --status-page-id flag and
+// returns nothing until that flag is set.
+func IncidentCompletion() completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		statusPageID, err := cmd.Flags().GetUint("status-page-id")
+		if err != nil || statusPageID == 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		resource := fmt.Sprintf("incidents:%d", statusPageID)
+		candidates := fetchCompletionCandidates(cmd, resource, func(ctx context.Context, c *client.Client) ([]completionCandidate, error) {
+			return fetchIncidentCandidates(ctx, c, statusPageID)
+		})
+		return filterCompletionCandidates(candidates, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// fetchIncidentCandidates lists a status page's incidents for completion.
+func fetchIncidentCandidates(ctx context.Context, c *client.Client, statusPageID uint) ([]completionCandidate, error) {
+	result, err := client.ListIncidents(ctx, c, statusPageID, &client.ListIncidentsOptions{Limit: 100})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]completionCandidate, 0, len(result.Incidents))
+	for _, inc := range result.Incidents {
+		candidates = append(candidates, completionCandidate{
+			Value:       strconv.FormatUint(uint64(inc.ID), 10),
+			Description: fmt.Sprintf("%s (%s)", inc.Title, inc.Status),
+		})
+	}
+	return candidates, nil
+}
+
+// MuteCompletion returns a cobra.ValidArgsFunction that provides active mute
+// and maintenance window ID completions, described by scope and name.
+func MuteCompletion() completionFunc {
+	return resourceCompletion("mutes", fetchMuteCandidates)
+}
+
+// fetchMuteCandidates lists active mutes for completion.
+func fetchMuteCandidates(ctx context.Context, c *client.Client) ([]completionCandidate, error) {
+	result, err := client.ListMutes(ctx, c, &client.ListMutesOptions{Limit: 100})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]completionCandidate, 0, len(result.Data))
+	for _, m := range result.Data {
+		desc := string(m.ScopeType)
+		switch {
+		case m.IsMaintenanceWindow && m.MaintenanceName != nil && *m.MaintenanceName != "":
+			desc += " maintenance: " + *m.MaintenanceName
+		case m.Reason != nil && *m.Reason != "":
+			desc += ": " + *m.Reason
+		}
+		candidates = append(candidates, completionCandidate{Value: m.ID.String(), Description: desc})
+	}
+	return candidates, nil
+}
+
+// LabelKeyCompletion returns a cobra.ValidArgsFunction that provides label
+// key completions, described by display name and probe count.
+func LabelKeyCompletion() completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 1 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		candidates := labelKeysOnly(fetchCompletionCandidates(cmd, "labels", fetchLabelCandidates))
+		return filterCompletionCandidates(candidates, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// LabelCompletion returns a completion function for "key=value" label
+// arguments. Keys are suggested first; once the user has typed "key=", the
+// values currently in use for that key are suggested.
+func LabelCompletion() completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		candidates := fetchCompletionCandidates(cmd, "labels", fetchLabelCandidates)
+		if !strings.Contains(toComplete, "=") {
+			candidates = labelKeysOnly(candidates)
+		}
+		return filterCompletionCandidates(candidates, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// LabelFilterCompletion returns a completion function for comma-separated
+// --labels filter flags such as "env=production,tier". Only the element
+// after the last comma is completed.
+func LabelFilterCompletion() completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		candidates := fetchCompletionCandidates(cmd, "labels", fetchLabelCandidates)
+
+		_, current := splitCommaList(toComplete)
+		if !strings.Contains(current, "=") {
+			candidates = labelKeysOnly(candidates)
+		}
+		return completeCommaList(candidates, toComplete), cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	}
+}
+
+// fetchLabelCandidates lists label keys and the values in use for each. Keys
+// are returned as bare candidates and values as "key=value" candidates.
+func fetchLabelCandidates(ctx context.Context, c *client.Client) ([]completionCandidate, error) {
+	result, err := client.ListLabelKeys(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []completionCandidate
+	for _, lk := range result.LabelKeys {
+		desc := fmt.Sprintf("%d probe(s)", lk.ProbeCount)
+		if lk.DisplayName != nil && *lk.DisplayName != "" {
+			desc = fmt.Sprintf("%s (%s)", *lk.DisplayName, desc)
+		}
+		candidates = append(candidates, completionCandidate{Value: lk.Key, Description: desc})
+
+		for _, v := range lk.ValuesInUse {
+			candidates = append(candidates, completionCandidate{Value: lk.Key + "=" + v})
+		}
+	}
+	return candidates, nil
+}
+
+// labelKeysOnly drops "key=value" candidates, keeping bare keys.
+func labelKeysOnly(candidates []completionCandidate) []completionCandidate {
+	keys := make([]completionCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		if !strings.Contains(c.Value, "=") {
+			keys = append(keys, c)
+		}
+	}
+	return keys
+}
+
+// RegionCompletion returns a cobra.ValidArgsFunction that provides
+// monitoring region ID completions, described by display name.
+func RegionCompletion() completionFunc {
+	return resourceCompletion("regions", fetchRegionCandidates)
+}
+
+// RegionListCompletion returns a completion function for comma-separated
+// region flags such as --regions. Regions already listed are not suggested
+// again.
+func RegionListCompletion() completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		candidates := fetchCompletionCandidates(cmd, "regions", fetchRegionCandidates)
+		return completeCommaList(candidates, toComplete), cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	}
+}
+
+// fetchRegionCandidates lists monitoring regions for completion.
+func fetchRegionCandidates(ctx context.Context, c *client.Client) ([]completionCandidate, error) {
+	result, err := client.ListRegions(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []completionCandidate
+	for continent, regions := range result.Data {
+		for _, r := range regions {
+			desc := r.DisplayName
+			if desc == "" {
+				desc = r.Name
+			}
+			candidates = append(candidates, completionCandidate{
+				Value:       r.ID,
+				Description: fmt.Sprintf("%s (%s)", desc, continent),
+			})
+		}
+	}
+
+	// Map iteration order is random; keep suggestions stable.
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Value < candidates[j].Value
+	})
+	return candidates, nil
+}
+
+// OrgCompletion returns a cobra.ValidArgsFunction that provides organization
+// slug completions, described by name and role.
+func OrgCompletion() completionFunc {
+	return resourceCompletion("orgs", fetchOrgCandidates)
+}
+
+// fetchOrgCandidates lists the user's organizations for completion.
+func fetchOrgCandidates(ctx context.Context, c *client.Client) ([]completionCandidate, error) {
+	result, err := client.ListOrganizations(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]completionCandidate, 0, len(result.Organizations))
+	for _, org := range result.Organizations {
+		desc := fmt.Sprintf("%s (%s)", org.Name, org.Role)
+		if org.IsCurrent {
+			desc += " [current]"
+		}
+		candidates = append(candidates, completionCandidate{Value: org.Slug, Description: desc})
+	}
+	return candidates, nil
+}
+
+// AgentCompletion returns a completion function that provides agent ID
+// completions, described by name and connection status.
+func AgentCompletion() completionFunc {
+	return resourceCompletion("agents", fetchAgentCandidates)
+}
+
+// fetchAgentCandidates lists registered agents for completion.
+func fetchAgentCandidates(ctx context.Context, c *client.Client) ([]completionCandidate, error) {
+	response, err := client.ListAgents(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]completionCandidate, 0, len(response.Data))
+	for _, a := range response.Data {
+		candidates = append(candidates, completionCandidate{
+			Value:       fmt.Sprint(a.ID),
+			Description: fmt.Sprintf("%s (%s)", a.Name, agentStatus(&a)),
+		})
+	}
+	return candidates, nil
+}
+
+// DeviceCompletion returns a cobra.ValidArgsFunction that provides device ID
+// completions, described by device name.
+func DeviceCompletion() completionFunc {
+	return resourceCompletion("devices", fetchDeviceCandidates)
+}
+
+// fetchDeviceCandidates lists devices for completion.
+func fetchDeviceCandidates(ctx context.Context, c *client.Client) ([]completionCandidate, error) {
+	devices, err := client.ListDevices(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]completionCandidate, 0, len(devices))
+	for _, d := range devices {
+		candidates = append(candidates, completionCandidate{Value: d.ID.String(), Description: d.Name})
+	}
+	return candidates, nil
+}
+
+// alertTypeDescriptions maps alert type values to human-readable descriptions.
+var alertTypeDescriptions = map[string]string{
+	"status_down":         "Probe is down",
+	"ssl_expiry":          "SSL certificate expiring",
+	"ssl_invalid":         "SSL certificate invalid",
+	"slow_response":       "Response time above threshold",
+	"domain_expiry":       "Domain registration expiring",
+	"dns_record_missing":  "Expected DNS record missing",
+	"dns_record_mismatch": "DNS record does not match",
+	"security_headers":    "Security headers missing",
+	"cert_transparency":   "New certificate in CT logs",
+}
+
+// AlertTypeCompletion returns a completion function for --alert-type flag
+// values. It makes no network calls.
+func AlertTypeCompletion() completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		candidates := make([]completionCandidate, 0, len(clierrors.ValidMuteAlertTypes))
+		for _, t := range clierrors.ValidMuteAlertTypes {
+			candidates = append(candidates, completionCandidate{Value: t, Description: alertTypeDescriptions[t]})
+		}
+		return filterCompletionCandidates(candidates, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// splitCommaList splits a partially typed comma-separated value into the
+// already completed prefix (including the trailing comma) and the element
+// currently being typed.
+func splitCommaList(toComplete string) (prefix, current string) {
+	if idx := strings.LastIndex(toComplete, ","); idx >= 0 {
+		return toComplete[:idx+1], toComplete[idx+1:]
+	}
+	return "", toComplete
+}
+
+// completeCommaList completes the last element of a comma-separated value.
+// Each completion carries the already typed prefix so the shell replaces the
+// whole word, and elements already present in the prefix are skipped.
+func completeCommaList(candidates []completionCandidate, toComplete string) []string {
+	prefix, current := splitCommaList(toComplete)
+
+	used := make(map[string]bool)
+	for _, part := range strings.Split(prefix, ",") {
+		key, _, _ := strings.Cut(strings.TrimSpace(part), "=")
+		if key != "" {
+			used[strings.ToLower(key)] = true
+		}
+	}
+
+	var remaining []completionCandidate
+	for _, c := range candidates {
+		key, _, _ := strings.Cut(c.Value, "=")
+		if used[strings.ToLower(key)] {
+			continue
+		}
+		remaining = append(remaining, completionCandidate{Value: prefix + c.Value, Description: c.Description})
+	}
+
+	return filterCompletionCandidates(remaining, prefix+current)
+}
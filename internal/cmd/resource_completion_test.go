@@ -0,0 +1,183 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// staticCompletion returns a completion function with fixed values.
+func staticCompletion(values ...string) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return append([]string(nil), values...), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func TestPositionalCompletion(t *testing.T) {
+	fn := PositionalCompletion(staticCompletion("probe"), staticCompletion("channel"))
+	cmd := &cobra.Command{}
+
+	got, _ := fn(cmd, nil, "")
+	assert.Equal(t, []string{"probe"}, got)
+
+	got, _ = fn(cmd, []string{"p"}, "")
+	assert.Equal(t, []string{"channel"}, got)
+
+	got, directive := fn(cmd, []string{"p", "c"}, "")
+	assert.Empty(t, got)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+}
+
+func TestRepeatedCompletion_SkipsUsedValues(t *testing.T) {
+	fn := RepeatedCompletion(staticCompletion("a\tfirst", "b\tsecond", "c"))
+
+	got, _ := fn(&cobra.Command{}, []string{"A", "c"}, "")
+	assert.Equal(t, []string{"b\tsecond"}, got)
+}
+
+func TestLeadingArgCompletion(t *testing.T) {
+	fn := LeadingArgCompletion(staticCompletion("probe"), staticCompletion("env=prod", "tier=web"))
+	cmd := &cobra.Command{}
+
+	got, _ := fn(cmd, nil, "")
+	assert.Equal(t, []string{"probe"}, got)
+
+	got, _ = fn(cmd, []string{"probe", "env=prod"}, "")
+	assert.Equal(t, []string{"tier=web"}, got)
+}
+
+func TestAlertTypeCompletion(t *testing.T) {
+	got, directive := AlertTypeCompletion()(&cobra.Command{}, nil, "ssl")
+
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	assert.Equal(t, []string{
+		"ssl_expiry\tSSL certificate expiring",
+		"ssl_invalid\tSSL certificate invalid",
+	}, got)
+}
+
+func TestSplitCommaList(t *testing.T) {
+	tests := []struct {
+		input       string
+		wantPrefix  string
+		wantCurrent string
+	}{
+		{"", "", ""},
+		{"nyc", "", "nyc"},
+		{"nyc3,", "nyc3,", ""},
+		{"nyc3,lon1,fr", "nyc3,lon1,", "fr"},
+	}
+
+	for _, tt := range tests {
+		prefix, current := splitCommaList(tt.input)
+		assert.Equal(t, tt.wantPrefix, prefix, tt.input)
+		assert.Equal(t, tt.wantCurrent, current, tt.input)
+	}
+}
+
+func TestCompleteCommaList(t *testing.T) {
+	candidates := []completionCandidate{
+		{Value: "fra1", Description: "Frankfurt"},
+		{Value: "lon1", Description: "London"},
+		{Value: "nyc3", Description: "New York"},
+	}
+
+	assert.Equal(t, []string{"lon1\tLondon"}, completeCommaList(candidates, "lo"))
+	assert.Equal(t, []string{"nyc3,fra1\tFrankfurt", "nyc3,lon1\tLondon"}, completeCommaList(candidates, "nyc3,"))
+	assert.Equal(t, []string{"nyc3,lon1\tLondon"}, completeCommaList(candidates, "nyc3,l"))
+}
+
+func TestRegionListCompletion_FromCache(t *testing.T) {
+	setupTestCompletionCache(t)
+	setupTestConfigWithURL(t, "http://127.0.0.1:0")
+	seedCompletionCache(t, "regions", []completionCandidate{
+		{Value: "fra1", Description: "Frankfurt"},
+		{Value: "nyc3", Description: "New York"},
+	})
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+
+	got, directive := RegionListCompletion()(cmd, nil, "nyc3,")
+	assert.Equal(t, []string{"nyc3,fra1\tFrankfurt"}, got)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp|cobra.ShellCompDirectiveNoSpace, directive)
+}
+
+func TestLabelFilterCompletion_FromCache(t *testing.T) {
+	setupTestCompletionCache(t)
+	setupTestConfigWithURL(t, "http://127.0.0.1:0")
+	seedCompletionCache(t, "labels", []completionCandidate{
+		{Value: "env", Description: "Environment (3 probe(s))"},
+		{Value: "env=production"},
+		{Value: "env=staging"},
+		{Value: "tier", Description: "1 probe(s)"},
+		{Value: "tier=web"},
+	})
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+	fn := LabelFilterCompletion()
+
+	// Keys only until a value is being typed
+	got, _ := fn(cmd, nil, "")
+	assert.Equal(t, []string{"env\tEnvironment (3 probe(s))", "tier\t1 probe(s)"}, got)
+
+	// Values for the key being typed
+	got, _ = fn(cmd, nil, "env=")
+	assert.Equal(t, []string{"env=production", "env=staging"}, got)
+
+	// Keys already used are not suggested again
+	got, _ = fn(cmd, nil, "env=staging,")
+	assert.Equal(t, []string{"env=staging,tier\t1 probe(s)"}, got)
+}
+
+func TestIncidentCompletion_RequiresStatusPage(t *testing.T) {
+	setupTestCompletionCache(t)
+	setupTestConfigWithURL(t, "http://127.0.0.1:0")
+	seedCompletionCache(t, "incidents:7", []completionCandidate{{Value: "12", Description: "Outage (resolved)"}})
+
+	cmd := &cobra.Command{}
+	cmd.Flags().Uint("status-page-id", 0, "")
+
+	got, _ := IncidentCompletion()(cmd, nil, "")
+	assert.Empty(t, got)
+
+	require.NoError(t, cmd.Flags().Set("status-page-id", "7"))
+	got, _ = IncidentCompletion()(cmd, nil, "")
+	assert.Equal(t, []string{"12\tOutage (resolved)"}, got)
+}
+
+func TestResourceCommands_HaveCompletion(t *testing.T) {
+	argCommands := map[string]*cobra.Command{
+		"channel get":        NewChannelGetCmd(),
+		"status-page get":    NewStatusPageGetCmd(),
+		"alert ack":          NewAlertAckCmd(),
+		"mute expire":        NewMuteExpireCmd(),
+		"label delete":       NewLabelDeleteCmd(),
+		"org switch":         NewOrgSwitchCmd(),
+		"device tag list":    NewDeviceTagListCmd(),
+		"probe link-channel": NewProbeLinkChannelCmd(),
+	}
+	for name, cmd := range argCommands {
+		assert.NotNil(t, cmd.ValidArgsFunction, "%s should have ValidArgsFunction set", name)
+	}
+
+	flagCommands := []struct {
+		cmd  *cobra.Command
+		flag string
+	}{
+		{NewProbeCreateCmd(), "regions"},
+		{NewProbeListCmd(), "labels"},
+		{NewMuteCreateCmd(), "alert-type"},
+		{NewIncidentGetCmd(), "incident-id"},
+		{NewAgentGetCmd(), "id"},
+	}
+	for _, tt := range flagCommands {
+		_, ok := tt.cmd.GetFlagCompletionFunc(tt.flag)
+		assert.True(t, ok, "%s --%s should have a completion function", tt.cmd.Name(), tt.flag)
+	}
+}
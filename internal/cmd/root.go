@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -64,10 +65,23 @@ For more information about a command:
 	SilenceUsage:  true,
 	SilenceErrors: true,
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-		return loadConfig()
+		return loadConfig(commandOutputFormats(cmd)...)
 	},
 }
 
+// outputFormatsAnnotation is the command annotation listing extra --output
+// formats that a command renders itself, as a comma-separated list.
+const outputFormatsAnnotation = "stackeye_output_formats"
+
+// commandOutputFormats returns the extra --output formats accepted by cmd.
+func commandOutputFormats(cmd *cobra.Command) []string {
+	formats := cmd.Annotations[outputFormatsAnnotation]
+	if formats == "" {
+		return nil
+	}
+	return strings.Split(formats, ",")
+}
+
 func init() {
 	// Wire up the API client helper to use our config getter
 	api.SetConfigGetter(GetConfig)
@@ -103,6 +117,9 @@ func init() {
 	rootCmd.AddCommand(NewChannelCmd())
 	rootCmd.AddCommand(NewOrgCmd())
 	rootCmd.AddCommand(NewDashboardCmd())
+	rootCmd.AddCommand(NewTopCmd())
+	rootCmd.AddCommand(NewWaitCmd())
+	rootCmd.AddCommand(NewHandoffCmd())
 	rootCmd.AddCommand(NewRegionCmd())
 	rootCmd.AddCommand(NewAPIKeyCmd())
 	rootCmd.AddCommand(NewMuteCmd())
@@ -143,8 +160,10 @@ func init() {
 }
 
 // loadConfig loads the configuration file and applies flag overrides.
-// Called by PersistentPreRunE before any subcommand executes.
-func loadConfig() error {
+// Called by PersistentPreRunE before any subcommand executes. extraFormats
+// are command-specific --output values that are accepted as-is; commands
+// read them back with GetOutputFormat.
+func loadConfig(extraFormats ...string) error {
 	var cfg *config.Config
 	var err error
 
@@ -204,7 +223,10 @@ func loadConfig() error {
 		case "wide":
 			cfg.Preferences.OutputFormat = config.OutputFormatWide
 		default:
-			return clierrors.InvalidValueError("--output", outputFormat, clierrors.ValidOutputFormats)
+			if !slices.Contains(extraFormats, outputFormat) {
+				valid := append(slices.Clone(clierrors.ValidOutputFormats), extraFormats...)
+				return clierrors.InvalidValueError("--output", outputFormat, valid)
+			}
 		}
 	}
 
@@ -276,6 +298,12 @@ func GetDryRun() bool {
 	return dryRun
 }
 
+// GetOutputFormat returns the raw --output flag value. Commands that accept
+// extra formats through outputFormatsAnnotation use this to detect them.
+func GetOutputFormat() string {
+	return outputFormat
+}
+
 // Execute runs the root command and returns any error.
 // This is called by main.main() and handles command execution.
 // Deprecated: Use ExecuteWithExitCode() for proper exit code handling.
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/StackEye-IO/stackeye-go-sdk/config"
+	"github.com/spf13/cobra"
 )
 
 // resetGlobalState resets all global flag variables to their default values.
@@ -126,6 +127,38 @@ func TestLoadConfig_InvalidOutputFormat(t *testing.T) {
 	}
 }
 
+func TestLoadConfig_CommandOutputFormat(t *testing.T) {
+	resetGlobalState()
+	outputFormat = "dot"
+
+	if err := loadConfig("dot", "mermaid"); err != nil {
+		t.Fatalf("loadConfig() failed for command-specific format: %v", err)
+	}
+	if got := GetOutputFormat(); got != "dot" {
+		t.Errorf("GetOutputFormat() = %q, want dot", got)
+	}
+	if got := GetConfig().Preferences.OutputFormat; got == config.OutputFormatJSON || got == config.OutputFormatYAML {
+		t.Errorf("Expected structured output to stay off, got %q", got)
+	}
+
+	resetGlobalState()
+	outputFormat = "dot"
+	if err := loadConfig(); err == nil {
+		t.Error("Expected error for format the command does not accept")
+	}
+}
+
+func TestCommandOutputFormats(t *testing.T) {
+	cmd := &cobra.Command{Annotations: map[string]string{outputFormatsAnnotation: "dot,mermaid"}}
+	got := commandOutputFormats(cmd)
+	if len(got) != 2 || got[0] != "dot" || got[1] != "mermaid" {
+		t.Errorf("commandOutputFormats() = %v, want [dot mermaid]", got)
+	}
+	if got := commandOutputFormats(&cobra.Command{}); got != nil {
+		t.Errorf("commandOutputFormats() without annotation = %v, want nil", got)
+	}
+}
+
 func TestLoadConfig_ContextOverride(t *testing.T) {
 	// Create temp config file with multiple contexts
 	tempDir := t.TempDir()
@@ -29,8 +29,9 @@ func NewStatusPageAddProbeCmd() *cobra.Command {
 	flags := &statusPageAddProbeFlags{}
 
 	cmd := &cobra.Command{
-		Use:   "add-probe <status-page-id>",
-		Short: "Add a probe to a status page",
+		Use:               "add-probe <status-page-id>",
+		Short:             "Add a probe to a status page",
+		ValidArgsFunction: StatusPageCompletion(),
 		Long: `Add a probe to a status page for public display.
 
 This command associates an existing probe with a status page, making the probe's
@@ -27,8 +27,9 @@ func NewStatusPageDeleteCmd() *cobra.Command {
 	flags := &statusPageDeleteFlags{}
 
 	cmd := &cobra.Command{
-		Use:   "delete <id>",
-		Short: "Delete a status page",
+		Use:               "delete <id>",
+		Short:             "Delete a status page",
+		ValidArgsFunction: StatusPageCompletion(),
 		Long: `Delete a status page by its ID.
 
 This permanently removes the status page and all its configuration, including:
@@ -19,8 +19,9 @@ const statusPageDomainVerifyTimeout = 30 * time.Second
 // NewStatusPageDomainVerifyCmd creates and returns the status-page domain-verify subcommand.
 func NewStatusPageDomainVerifyCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "domain-verify <id>",
-		Short: "Get DNS verification record for custom domain",
+		Use:               "domain-verify <id>",
+		Short:             "Get DNS verification record for custom domain",
+		ValidArgsFunction: StatusPageCompletion(),
 		Long: `Get the DNS TXT record required to verify custom domain ownership for a status page.
 
 When you configure a custom domain for your status page, you must prove domain
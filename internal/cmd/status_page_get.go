@@ -19,8 +19,9 @@ const statusPageGetTimeout = 30 * time.Second
 // NewStatusPageGetCmd creates and returns the status-page get subcommand.
 func NewStatusPageGetCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get <id>",
-		Short: "Get details of a status page",
+		Use:               "get <id>",
+		Short:             "Get details of a status page",
+		ValidArgsFunction: StatusPageCompletion(),
 		Long: `Get detailed information about a specific status page.
 
 Displays the full status page configuration including name, slug, visibility
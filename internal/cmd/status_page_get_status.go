@@ -19,8 +19,9 @@ const statusPageGetStatusTimeout = 30 * time.Second
 // NewStatusPageGetStatusCmd creates and returns the status-page get-status subcommand.
 func NewStatusPageGetStatusCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get-status <id>",
-		Short: "Get aggregated status of a status page",
+		Use:               "get-status <id>",
+		Short:             "Get aggregated status of a status page",
+		ValidArgsFunction: StatusPageCompletion(),
 		Long: `Get the current aggregated status of a status page and its probes.
 
 Displays the overall health status of the status page along with the status
@@ -29,8 +29,9 @@ func NewStatusPageRemoveProbeCmd() *cobra.Command {
 	flags := &statusPageRemoveProbeFlags{}
 
 	cmd := &cobra.Command{
-		Use:   "remove-probe <status-page-id>",
-		Short: "Remove a probe from a status page",
+		Use:               "remove-probe <status-page-id>",
+		Short:             "Remove a probe from a status page",
+		ValidArgsFunction: StatusPageCompletion(),
 		Long: `Remove a probe from a status page.
 
 This command removes an existing probe association from a status page. The probe
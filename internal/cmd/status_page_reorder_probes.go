@@ -28,8 +28,9 @@ func NewStatusPageReorderProbesCmd() *cobra.Command {
 	flags := &statusPageReorderProbesFlags{}
 
 	cmd := &cobra.Command{
-		Use:   "reorder-probes <status-page-id>",
-		Short: "Reorder probes on a status page",
+		Use:               "reorder-probes <status-page-id>",
+		Short:             "Reorder probes on a status page",
+		ValidArgsFunction: StatusPageCompletion(),
 		Long: `Reorder the display order of probes on a status page.
 
 This command updates the display order of probes on a status page. The order
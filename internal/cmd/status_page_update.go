@@ -60,8 +60,9 @@ func NewStatusPageUpdateCmd() *cobra.Command {
 	flags := &statusPageUpdateFlags{}
 
 	cmd := &cobra.Command{
-		Use:   "update <id>",
-		Short: "Update an existing status page",
+		Use:               "update <id>",
+		Short:             "Update an existing status page",
+		ValidArgsFunction: StatusPageCompletion(),
 		Long: `Update an existing status page configuration.
 
 Only the specified flags will be updated; all other fields remain unchanged.
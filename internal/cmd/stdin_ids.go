@@ -0,0 +1,172 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// stdinIDArg is the positional argument that makes a multi-ID command read
+// its IDs from standard input.
+const stdinIDArg = "-"
+
+// stdinIDMaxBytes caps how much input is read when IDs come from stdin.
+const stdinIDMaxBytes = 10 << 20
+
+// stdinTTYPath is the terminal confirmation prompts read from once stdin has
+// been used for IDs. It is a variable so tests can point it elsewhere.
+var stdinTTYPath = "/dev/tty"
+
+// readIDArgs expands a "-" argument into the IDs read from the command's
+// stdin. Other arguments are kept in place. The returned flag reports whether
+// stdin was read, in which case interactive prompts are unavailable.
+func readIDArgs(cmd *cobra.Command, args []string) ([]string, bool, error) {
+	stdinIndex := -1
+	for i, arg := range args {
+		if arg != stdinIDArg {
+			continue
+		}
+		if stdinIndex >= 0 {
+			return nil, false, fmt.Errorf("%q can only be given once", stdinIDArg)
+		}
+		stdinIndex = i
+	}
+	if stdinIndex < 0 {
+		return args, false, nil
+	}
+
+	stdinIDs, err := parseIDList(io.LimitReader(cmd.InOrStdin(), stdinIDMaxBytes))
+	if err != nil {
+		return nil, true, err
+	}
+
+	ids := make([]string, 0, len(args)-1+len(stdinIDs))
+	ids = append(ids, args[:stdinIndex]...)
+	ids = append(ids, stdinIDs...)
+	ids = append(ids, args[stdinIndex+1:]...)
+	return ids, true, nil
+}
+
+// parseIDList parses IDs from newline-separated text or a JSON array. JSON
+// array elements may be strings, numbers or objects with an "id" field.
+// Blank lines and lines starting with '#' are ignored; duplicates are removed
+// while preserving order.
+func parseIDList(r io.Reader) ([]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read IDs from stdin: %w", err)
+	}
+
+	data = bytes.TrimSpace(data)
+	var ids []string
+	if bytes.HasPrefix(data, []byte("[")) {
+		ids, err = parseJSONIDList(data)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, line := range strings.Split(string(data), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			ids = append(ids, line)
+		}
+	}
+
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+
+	if len(unique) == 0 {
+		return nil, fmt.Errorf("no IDs found on stdin")
+	}
+	return unique, nil
+}
+
+// parseJSONIDList extracts IDs from a JSON array.
+func parseJSONIDList(data []byte) ([]string, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("failed to parse IDs from stdin: invalid JSON array: %w", err)
+	}
+
+	ids := make([]string, 0, len(items))
+	for i, item := range items {
+		id, err := jsonIDValue(item)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse IDs from stdin: element %d: %w", i, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// jsonIDValue returns the ID held by a JSON string, number or object with an
+// "id" field.
+func jsonIDValue(raw json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return strings.TrimSpace(s), nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(raw, &n); err == nil {
+		return n.String(), nil
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &obj); err == nil {
+		// Table-shaped JSON output uses Go field names ("ID"), so match the
+		// key case-insensitively.
+		var idRaw json.RawMessage
+		for key, value := range obj {
+			if strings.EqualFold(key, "id") {
+				idRaw = value
+				break
+			}
+		}
+		if idRaw == nil {
+			return "", fmt.Errorf("object has no \"id\" field")
+		}
+		var idStr string
+		if err := json.Unmarshal(idRaw, &idStr); err == nil {
+			return strings.TrimSpace(idStr), nil
+		}
+		var idNum int64
+		if err := json.Unmarshal(idRaw, &idNum); err == nil {
+			return strconv.FormatInt(idNum, 10), nil
+		}
+		return "", fmt.Errorf("\"id\" field must be a string or number")
+	}
+
+	return "", fmt.Errorf("expected a string, number or object with an \"id\" field")
+}
+
+// promptFromTTYForStdinIDs prepares commands that read their IDs from stdin
+// to confirm on the terminal: the exhausted pipe cannot answer a prompt, so
+// stdin is reopened from /dev/tty. Without a terminal, --yes is required.
+func promptFromTTYForStdinIDs(fromStdin, yes bool) error {
+	if !fromStdin || yes || GetNoInput() || GetDryRun() {
+		return nil
+	}
+	tty, err := os.Open(stdinTTYPath)
+	if err != nil {
+		return fmt.Errorf("--yes is required when reading IDs from stdin without a terminal")
+	}
+	os.Stdin = tty
+	return nil
+}
@@ -0,0 +1,160 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/StackEye-IO/stackeye-go-sdk/config"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIDList(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{"newline separated", "a\nb\n\nc\n", []string{"a", "b", "c"}},
+		{"crlf and comments", "# exported\r\na\r\n  b  \r\n", []string{"a", "b"}},
+		{"names with spaces", "Production API\nStaging DB\n", []string{"Production API", "Staging DB"}},
+		{"duplicates removed", "a\nb\na\n", []string{"a", "b"}},
+		{"json strings", `["a", "b"]`, []string{"a", "b"}},
+		{"json objects", `[{"id": "a", "name": "x"}, {"id": 42}]`, []string{"a", "42"}},
+		{"table-shaped json", `[{"Status": "UP", "ID": "a"}]`, []string{"a"}},
+		{"json numbers", `[7, 8]`, []string{"7", "8"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseIDList(strings.NewReader(tt.input))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseIDList_ProbeListJSON(t *testing.T) {
+	setTestOutputFormat(t, config.OutputFormatJSON)
+
+	probes := []client.Probe{
+		{ID: uuid.New(), Name: "api", Status: "up"},
+		{ID: uuid.New(), Name: "web", Status: "down"},
+	}
+
+	// Same output as: stackeye probe list -o json --labels env=staging
+	out, err := captureStdout(t, func() error { return printProbeList(probes, "env=staging") })
+	require.NoError(t, err)
+
+	got, err := parseIDList(strings.NewReader(out))
+	require.NoError(t, err)
+	assert.Equal(t, []string{probes[0].ID.String(), probes[1].ID.String()}, got)
+}
+
+func TestParseIDList_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"empty", "  \n", "no IDs found"},
+		{"empty array", "[]", "no IDs found"},
+		{"invalid json", `["a",`, "invalid JSON array"},
+		{"object without id", `[{"name": "x"}]`, `no "id" field`},
+		{"nested array", `[["a"]]`, "element 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseIDList(strings.NewReader(tt.input))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestReadIDArgs(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.SetIn(strings.NewReader("b\nc\n"))
+
+	ids, fromStdin, err := readIDArgs(cmd, []string{"a", "-", "d"})
+	require.NoError(t, err)
+	assert.True(t, fromStdin)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, ids)
+}
+
+func TestReadIDArgs_NoStdin(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.SetIn(strings.NewReader("ignored"))
+
+	ids, fromStdin, err := readIDArgs(cmd, []string{"a", "b"})
+	require.NoError(t, err)
+	assert.False(t, fromStdin)
+	assert.Equal(t, []string{"a", "b"}, ids)
+}
+
+func TestReadIDArgs_DashTwice(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.SetIn(strings.NewReader("a"))
+
+	_, _, err := readIDArgs(cmd, []string{"-", "-"})
+	assert.Error(t, err)
+}
+
+// setTestStdinTTY points stdinTTYPath at path and restores it and os.Stdin
+// when the test finishes.
+func setTestStdinTTY(t *testing.T, path string) {
+	t.Helper()
+	origPath, origStdin := stdinTTYPath, os.Stdin
+	stdinTTYPath = path
+	t.Cleanup(func() {
+		stdinTTYPath = origPath
+		os.Stdin = origStdin
+	})
+}
+
+func TestPromptFromTTYForStdinIDs(t *testing.T) {
+	setTestStdinTTY(t, filepath.Join(t.TempDir(), "missing-tty"))
+
+	assert.NoError(t, promptFromTTYForStdinIDs(false, false))
+	assert.NoError(t, promptFromTTYForStdinIDs(true, true))
+	assert.ErrorContains(t, promptFromTTYForStdinIDs(true, false), "--yes is required")
+}
+
+func TestPromptFromTTYForStdinIDs_ReopensStdinFromTerminal(t *testing.T) {
+	tty := filepath.Join(t.TempDir(), "tty")
+	require.NoError(t, os.WriteFile(tty, []byte("y\n"), 0o600))
+	setTestStdinTTY(t, tty)
+
+	require.NoError(t, promptFromTTYForStdinIDs(true, false))
+	assert.Equal(t, tty, os.Stdin.Name())
+	_ = os.Stdin.Close()
+}
+
+func TestProbePauseCmd_StdinWithoutTerminalRequiresYes(t *testing.T) {
+	setTestStdinTTY(t, filepath.Join(t.TempDir(), "missing-tty"))
+
+	cmd := NewProbePauseCmd()
+	cmd.SetIn(strings.NewReader("550e8400-e29b-41d4-a716-446655440000\n"))
+	cmd.SetArgs([]string{"-"})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--yes is required")
+}
+
+func TestAlertAckCmd_StdinInvalidID(t *testing.T) {
+	cmd := NewAlertAckCmd()
+	cmd.SetIn(strings.NewReader(`[{"id": "not-a-uuid"}]`))
+	cmd.SetArgs([]string{"-"})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid alert ID "not-a-uuid"`)
+}
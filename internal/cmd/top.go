@@ -0,0 +1,345 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-cli/internal/tui"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/StackEye-IO/stackeye-go-sdk/config"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// topTimeout is the maximum time to wait for each API response in the TUI.
+const topTimeout = 15 * time.Second
+
+// topSlowRefreshInterval is how often incidents and region health, which
+// need one request per status page or region, are refreshed.
+const topSlowRefreshInterval = 60 * time.Second
+
+// topRedrawInterval is how often the screen is redrawn between data refreshes
+// (to pick up terminal resizes and keep relative times current).
+const topRedrawInterval = 1 * time.Second
+
+// topRecentResultsLimit is the number of check results shown in probe detail.
+const topRecentResultsLimit = 20
+
+// topIncidentLimit is the number of recent incidents shown.
+const topIncidentLimit = 10
+
+// topFlags holds the flag values for the top command.
+type topFlags struct {
+	interval time.Duration
+}
+
+// NewTopCmd creates and returns the top command.
+func NewTopCmd() *cobra.Command {
+	flags := &topFlags{}
+
+	cmd := &cobra.Command{
+		Use:   "top",
+		Short: "Full-screen live monitoring dashboard",
+		Long: `Open a full-screen, live-updating terminal dashboard.
+
+The screen is split into panes showing probe status, active alerts, recent
+status page incidents and region health. Probes and alerts refresh on every
+interval; incidents and region health refresh every minute.
+
+Keys:
+  Tab / Shift+Tab   Move between panes
+  ↑ ↓ / j k         Move the selection (PgUp/PgDn, g/G to jump)
+  Enter             Show the selected probe's recent check results
+  Esc               Return from probe detail
+  a                 Acknowledge the selected alert
+  p                 Pause or resume the selected probe
+  r                 Refresh now
+  q / Ctrl+C        Quit
+
+Actions ask for confirmation (y/n) in the status line before they run.
+
+'top' requires an interactive terminal. Use 'stackeye dashboard' for a
+one-off summary or 'stackeye probe watch' for a scrolling table.
+
+Examples:
+  # Open the dashboard (default 10s refresh)
+  stackeye top
+
+  # Refresh every 5 seconds
+  stackeye top --interval 5s`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTop(cmd.Context(), flags)
+		},
+	}
+
+	cmd.Flags().DurationVarP(&flags.interval, "interval", "i", 10*time.Second, "refresh interval (minimum 2s)")
+
+	return cmd
+}
+
+// runTop executes the top command logic.
+func runTop(ctx context.Context, flags *topFlags) error {
+	if flags.interval < 2*time.Second {
+		return fmt.Errorf("invalid interval %s: minimum is 2s", flags.interval)
+	}
+
+	if !output.IsInteractive() {
+		return fmt.Errorf("'stackeye top' requires an interactive terminal; use 'stackeye dashboard' for a snapshot")
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	terminal, err := tui.Open()
+	if err != nil {
+		return err
+	}
+	defer terminal.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	m := newTopModel(flags.interval, topColorEnabled())
+	keys := tui.ReadKeys(ctx, terminal.Input())
+	snapshots := make(chan topSnapshot, 1)
+	details := make(chan *topProbeDetail, 1)
+	actionResults := make(chan topActionResult, 1)
+
+	lastSlow := time.Time{}
+	refresh := func(forceSlow bool) {
+		if m.loading {
+			return
+		}
+		slow := forceSlow || time.Since(lastSlow) >= topSlowRefreshInterval
+		if slow {
+			lastSlow = time.Now()
+		}
+		m.loading = true
+		go func() {
+			snapshots <- fetchTopSnapshot(ctx, apiClient, slow)
+		}()
+	}
+	loadDetail := func(probeID uuid.UUID) {
+		go func() {
+			details <- fetchTopProbeDetail(ctx, apiClient, probeID)
+		}()
+	}
+
+	refresh(true)
+	draw := func() {
+		width, height := terminal.Size()
+		terminal.Draw(renderTop(m, width, height, time.Now()))
+	}
+	draw()
+
+	refreshTicker := time.NewTicker(flags.interval)
+	defer refreshTicker.Stop()
+	redrawTicker := time.NewTicker(topRedrawInterval)
+	defer redrawTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case key, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			effect := m.handleKey(key)
+			if effect.quit {
+				return nil
+			}
+			if effect.refresh {
+				refresh(true)
+			}
+			if effect.detail != nil {
+				loadDetail(*effect.detail)
+			}
+			if effect.action != nil {
+				action := effect.action
+				go func() {
+					actionResults <- runTopAction(ctx, apiClient, action)
+				}()
+			}
+
+		case snap := <-snapshots:
+			m.applySnapshot(snap)
+
+		case detail := <-details:
+			m.applyDetail(detail)
+
+		case result := <-actionResults:
+			m.applyActionResult(result)
+			refresh(false)
+			if m.detail != nil {
+				loadDetail(m.detail.probeID)
+			}
+
+		case <-refreshTicker.C:
+			refresh(false)
+
+		case <-redrawTicker.C:
+		}
+
+		draw()
+	}
+}
+
+// topColorEnabled reports whether the TUI may use colors.
+func topColorEnabled() bool {
+	cfg := GetConfig()
+	return cfg == nil || cfg.Preferences == nil || cfg.Preferences.Color != config.ColorModeNever
+}
+
+// fetchTopSnapshot fetches everything shown on the main screen concurrently.
+// Incidents and regions are only fetched when slow is true. Each section
+// records its own error so one failing endpoint does not blank the screen.
+func fetchTopSnapshot(ctx context.Context, apiClient *client.Client, slow bool) topSnapshot {
+	snap := topSnapshot{slow: slow}
+	var wg sync.WaitGroup
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		reqCtx, cancel := context.WithTimeout(ctx, topTimeout)
+		defer cancel()
+		snap.probes, snap.probesErr = fetchAllProbesForExport(reqCtx, apiClient, "", nil)
+	}()
+	go func() {
+		defer wg.Done()
+		reqCtx, cancel := context.WithTimeout(ctx, topTimeout)
+		defer cancel()
+		result, err := client.ListAlerts(reqCtx, apiClient, &client.ListAlertsOptions{
+			Limit:  100,
+			Status: client.AlertStatusActive,
+		})
+		if err != nil {
+			snap.alertsErr = fmt.Errorf("failed to list alerts: %w", err)
+			return
+		}
+		snap.alerts = result.Alerts
+	}()
+
+	if slow {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			snap.incidents, snap.incidentsErr = fetchTopIncidents(ctx, apiClient)
+		}()
+		go func() {
+			defer wg.Done()
+			reqCtx, cancel := context.WithTimeout(ctx, topTimeout)
+			defer cancel()
+			regions, err := client.GetAllRegionsFlat(reqCtx, apiClient)
+			if err != nil {
+				snap.regionsErr = fmt.Errorf("failed to list regions: %w", err)
+				return
+			}
+			snap.regions, snap.regionsErr = fetchAllRegionStatuses(reqCtx, apiClient, regions)
+		}()
+	}
+
+	wg.Wait()
+
+	// Show problems first: down, degraded, then the rest by name
+	sort.SliceStable(snap.probes, func(i, j int) bool {
+		ri, rj := topProbeRank(string(snap.probes[i].Status)), topProbeRank(string(snap.probes[j].Status))
+		if ri != rj {
+			return ri < rj
+		}
+		return snap.probes[i].Name < snap.probes[j].Name
+	})
+	sort.SliceStable(snap.alerts, func(i, j int) bool {
+		return snap.alerts[i].TriggeredAt.After(snap.alerts[j].TriggeredAt)
+	})
+	sort.SliceStable(snap.regions, func(i, j int) bool {
+		return snap.regions[i].Name < snap.regions[j].Name
+	})
+
+	snap.fetchedAt = time.Now()
+	return snap
+}
+
+// fetchTopIncidents returns the most recent incidents across all status pages.
+func fetchTopIncidents(ctx context.Context, apiClient *client.Client) ([]topIncident, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, topTimeout)
+	defer cancel()
+
+	pages, err := client.ListStatusPages(reqCtx, apiClient, &client.ListStatusPagesOptions{Limit: 100})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list status pages: %w", err)
+	}
+
+	var incidents []topIncident
+	for _, page := range pages.StatusPages {
+		result, err := client.ListIncidents(reqCtx, apiClient, page.ID, &client.ListIncidentsOptions{Limit: topIncidentLimit})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list incidents for status page %q: %w", page.Name, err)
+		}
+		for _, inc := range result.Incidents {
+			incidents = append(incidents, topIncident{statusPage: page.Name, incident: inc})
+		}
+	}
+
+	sort.SliceStable(incidents, func(i, j int) bool {
+		return incidents[i].incident.CreatedAt.After(incidents[j].incident.CreatedAt)
+	})
+	if len(incidents) > topIncidentLimit {
+		incidents = incidents[:topIncidentLimit]
+	}
+	return incidents, nil
+}
+
+// fetchTopProbeDetail fetches a probe and its most recent check results.
+func fetchTopProbeDetail(ctx context.Context, apiClient *client.Client, probeID uuid.UUID) *topProbeDetail {
+	detail := &topProbeDetail{probeID: probeID}
+
+	reqCtx, cancel := context.WithTimeout(ctx, topTimeout)
+	defer cancel()
+
+	probe, err := client.GetProbe(reqCtx, apiClient, probeID, "24h")
+	if err != nil {
+		detail.err = fmt.Errorf("failed to get probe: %w", err)
+		return detail
+	}
+	detail.probe = probe
+
+	results, err := client.GetProbeResults(reqCtx, apiClient, probeID, &client.ListProbeResultsOptions{
+		Page:  1,
+		Limit: topRecentResultsLimit,
+	})
+	if err != nil {
+		detail.err = fmt.Errorf("failed to get probe results: %w", err)
+		return detail
+	}
+	detail.results = results
+	return detail
+}
+
+// runTopAction performs a confirmed keyboard action.
+func runTopAction(ctx context.Context, apiClient *client.Client, action *topAction) topActionResult {
+	reqCtx, cancel := context.WithTimeout(ctx, topTimeout)
+	defer cancel()
+
+	var err error
+	switch action.kind {
+	case topActionAck:
+		_, err = client.AcknowledgeAlert(reqCtx, apiClient, action.id, nil)
+	case topActionPause:
+		_, err = client.PauseProbe(reqCtx, apiClient, action.id)
+	case topActionResume:
+		_, err = client.ResumeProbe(reqCtx, apiClient, action.id)
+	}
+
+	return topActionResult{action: action, err: err}
+}
@@ -0,0 +1,628 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/tui"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+)
+
+// topPane identifies a pane on the top main screen.
+type topPane int
+
+// Panes in Tab order.
+const (
+	topPaneProbes topPane = iota
+	topPaneAlerts
+	topPaneIncidents
+	topPaneRegions
+	topPaneCount
+)
+
+// topPaneTitles holds the pane titles, indexed by topPane.
+var topPaneTitles = [topPaneCount]string{"Probes", "Active Alerts", "Recent Incidents", "Regions"}
+
+// topIncident is an incident together with the status page it belongs to.
+type topIncident struct {
+	statusPage string
+	incident   client.Incident
+}
+
+// topSnapshot is one refresh of the data shown on the main screen.
+type topSnapshot struct {
+	probes       []client.Probe
+	probesErr    error
+	alerts       []client.Alert
+	alertsErr    error
+	incidents    []topIncident
+	incidentsErr error
+	regions      []client.RegionStatus
+	regionsErr   error
+	slow         bool // incidents and regions were fetched
+	fetchedAt    time.Time
+}
+
+// topProbeDetail is the drill-down view of a single probe.
+type topProbeDetail struct {
+	probeID uuid.UUID
+	probe   *client.Probe
+	results *client.ProbeResultListResponse
+	err     error
+	loading bool
+}
+
+// topActionKind is a keyboard action that changes state via the API.
+type topActionKind int
+
+// Keyboard actions.
+const (
+	topActionAck topActionKind = iota
+	topActionPause
+	topActionResume
+)
+
+// topAction is an action awaiting or undergoing execution.
+type topAction struct {
+	kind  topActionKind
+	id    uuid.UUID
+	label string // probe or alert description for messages
+}
+
+// topActionResult is the outcome of a topAction.
+type topActionResult struct {
+	action *topAction
+	err    error
+}
+
+// topEffect tells the event loop what to do after a key press.
+type topEffect struct {
+	quit    bool
+	refresh bool
+	detail  *uuid.UUID
+	action  *topAction
+}
+
+// topModel holds all TUI state. It is only accessed from the event loop.
+type topModel struct {
+	snap     topSnapshot
+	focus    topPane
+	cursor   [topPaneCount]int
+	detail   *topProbeDetail
+	pending  *topAction
+	status   string
+	loading  bool
+	interval time.Duration
+	color    bool
+}
+
+// newTopModel creates an empty model.
+func newTopModel(interval time.Duration, color bool) *topModel {
+	return &topModel{
+		interval: interval,
+		color:    color,
+		status:   "Loading...",
+	}
+}
+
+// applySnapshot merges a refresh into the model. Incidents and regions are
+// kept from the previous snapshot when they were not refetched.
+func (m *topModel) applySnapshot(snap topSnapshot) {
+	if !snap.slow {
+		snap.incidents, snap.incidentsErr = m.snap.incidents, m.snap.incidentsErr
+		snap.regions, snap.regionsErr = m.snap.regions, m.snap.regionsErr
+	}
+	m.snap = snap
+	m.loading = false
+	if m.status == "Loading..." {
+		m.status = ""
+	}
+	for p := topPane(0); p < topPaneCount; p++ {
+		m.clampCursor(p)
+	}
+}
+
+// applyDetail shows fetched probe detail if the user is still viewing it.
+func (m *topModel) applyDetail(detail *topProbeDetail) {
+	if m.detail == nil || m.detail.probeID != detail.probeID {
+		return
+	}
+	m.detail = detail
+}
+
+// applyActionResult reports the outcome of a keyboard action.
+func (m *topModel) applyActionResult(result topActionResult) {
+	verb := map[topActionKind]string{
+		topActionAck:    "acknowledge",
+		topActionPause:  "pause",
+		topActionResume: "resume",
+	}[result.action.kind]
+
+	if result.err != nil {
+		m.status = fmt.Sprintf("Failed to %s %s: %v", verb, result.action.label, result.err)
+		return
+	}
+
+	past := map[topActionKind]string{
+		topActionAck:    "Acknowledged",
+		topActionPause:  "Paused",
+		topActionResume: "Resumed",
+	}[result.action.kind]
+	m.status = fmt.Sprintf("%s %s", past, result.action.label)
+}
+
+// paneLen returns the number of selectable rows in a pane.
+func (m *topModel) paneLen(p topPane) int {
+	switch p {
+	case topPaneProbes:
+		return len(m.snap.probes)
+	case topPaneAlerts:
+		return len(m.snap.alerts)
+	case topPaneIncidents:
+		return len(m.snap.incidents)
+	case topPaneRegions:
+		return len(m.snap.regions)
+	}
+	return 0
+}
+
+// clampCursor keeps a pane's cursor within its rows.
+func (m *topModel) clampCursor(p topPane) {
+	n := m.paneLen(p)
+	if m.cursor[p] >= n {
+		m.cursor[p] = n - 1
+	}
+	if m.cursor[p] < 0 {
+		m.cursor[p] = 0
+	}
+}
+
+// moveCursor moves the focused pane's selection by delta rows.
+func (m *topModel) moveCursor(delta int) {
+	m.cursor[m.focus] += delta
+	m.clampCursor(m.focus)
+}
+
+// selectedProbe returns the probe selected in the probes pane or shown in
+// the detail view.
+func (m *topModel) selectedProbe() *client.Probe {
+	if m.detail != nil {
+		return m.detail.probe
+	}
+	if m.focus != topPaneProbes || len(m.snap.probes) == 0 {
+		return nil
+	}
+	return &m.snap.probes[m.cursor[topPaneProbes]]
+}
+
+// handleKey updates the model for a key press and returns the side effects
+// the event loop should perform.
+func (m *topModel) handleKey(k tui.Key) topEffect {
+	if k.Type == tui.KeyCtrlC {
+		return topEffect{quit: true}
+	}
+
+	// A pending action consumes the next key as its confirmation
+	if m.pending != nil {
+		action := m.pending
+		m.pending = nil
+		if k.Type == tui.KeyRune && (k.Rune == 'y' || k.Rune == 'Y') {
+			m.status = "Working..."
+			return topEffect{action: action}
+		}
+		m.status = "Cancelled."
+		return topEffect{}
+	}
+
+	if m.detail != nil {
+		return m.handleDetailKey(k)
+	}
+
+	switch k.Type {
+	case tui.KeyTab, tui.KeyRight:
+		m.focus = (m.focus + 1) % topPaneCount
+	case tui.KeyBackTab, tui.KeyLeft:
+		m.focus = (m.focus + topPaneCount - 1) % topPaneCount
+	case tui.KeyUp:
+		m.moveCursor(-1)
+	case tui.KeyDown:
+		m.moveCursor(1)
+	case tui.KeyPageUp:
+		m.moveCursor(-10)
+	case tui.KeyPageDown:
+		m.moveCursor(10)
+	case tui.KeyHome:
+		m.cursor[m.focus] = 0
+	case tui.KeyEnd:
+		m.cursor[m.focus] = m.paneLen(m.focus) - 1
+		m.clampCursor(m.focus)
+	case tui.KeyEnter:
+		return m.openDetail()
+	case tui.KeyRune:
+		return m.handleRune(k.Rune)
+	}
+
+	return topEffect{}
+}
+
+// handleRune handles printable keys on the main screen.
+func (m *topModel) handleRune(r rune) topEffect {
+	switch r {
+	case 'q':
+		return topEffect{quit: true}
+	case 'k':
+		m.moveCursor(-1)
+	case 'j':
+		m.moveCursor(1)
+	case 'g':
+		m.cursor[m.focus] = 0
+	case 'G':
+		m.cursor[m.focus] = m.paneLen(m.focus) - 1
+		m.clampCursor(m.focus)
+	case 'r':
+		m.status = "Refreshing..."
+		return topEffect{refresh: true}
+	case 'a':
+		m.promptAck()
+	case 'p':
+		m.promptPause(m.selectedProbe())
+	}
+	return topEffect{}
+}
+
+// handleDetailKey handles keys in the probe detail view.
+func (m *topModel) handleDetailKey(k tui.Key) topEffect {
+	switch k.Type {
+	case tui.KeyEscape, tui.KeyBackspace, tui.KeyLeft:
+		m.detail = nil
+	case tui.KeyRune:
+		switch k.Rune {
+		case 'q':
+			return topEffect{quit: true}
+		case 'r':
+			m.detail.loading = true
+			id := m.detail.probeID
+			return topEffect{refresh: true, detail: &id}
+		case 'p':
+			m.promptPause(m.detail.probe)
+		}
+	}
+	return topEffect{}
+}
+
+// openDetail drills into the selected probe (or the probe of the selected
+// alert).
+func (m *topModel) openDetail() topEffect {
+	var id uuid.UUID
+	switch m.focus {
+	case topPaneProbes:
+		if len(m.snap.probes) == 0 {
+			return topEffect{}
+		}
+		id = m.snap.probes[m.cursor[topPaneProbes]].ID
+	case topPaneAlerts:
+		if len(m.snap.alerts) == 0 || m.snap.alerts[m.cursor[topPaneAlerts]].Probe == nil {
+			return topEffect{}
+		}
+		id = m.snap.alerts[m.cursor[topPaneAlerts]].Probe.ID
+	default:
+		return topEffect{}
+	}
+
+	m.detail = &topProbeDetail{probeID: id, loading: true}
+	return topEffect{detail: &id}
+}
+
+// promptAck asks to acknowledge the selected alert.
+func (m *topModel) promptAck() {
+	if m.focus != topPaneAlerts || len(m.snap.alerts) == 0 {
+		m.status = "Select an alert in the Active Alerts pane to acknowledge it."
+		return
+	}
+
+	alert := m.snap.alerts[m.cursor[topPaneAlerts]]
+	if alert.Status != client.AlertStatusActive {
+		m.status = "Alert is already acknowledged."
+		return
+	}
+
+	label := "alert " + alert.ID.String()
+	if alert.Probe != nil {
+		label = fmt.Sprintf("alert on %q", alert.Probe.Name)
+	}
+	m.pending = &topAction{kind: topActionAck, id: alert.ID, label: label}
+	m.status = fmt.Sprintf("Acknowledge %s? (y/n)", label)
+}
+
+// promptPause asks to pause (or resume, if paused) a probe.
+func (m *topModel) promptPause(probe *client.Probe) {
+	if probe == nil {
+		m.status = "Select a probe in the Probes pane to pause or resume it."
+		return
+	}
+
+	kind, verb := topActionPause, "Pause"
+	if probe.Status == string(client.ProbeStatusPaused) {
+		kind, verb = topActionResume, "Resume"
+	}
+
+	label := fmt.Sprintf("probe %q", probe.Name)
+	m.pending = &topAction{kind: kind, id: probe.ID, label: label}
+	m.status = fmt.Sprintf("%s %s? (y/n)", verb, label)
+}
+
+// renderTop renders the whole screen into exactly height lines.
+func renderTop(m *topModel, width, height int, now time.Time) []string {
+	if height < 8 || width < 40 {
+		return []string{tui.Fit("Terminal too small for stackeye top", width)}
+	}
+
+	lines := []string{renderTopHeader(m, width, now)}
+	bodyHeight := height - 3
+
+	if m.detail != nil {
+		pane := m.detailPane()
+		lines = append(lines, pane.Render(width, bodyHeight)...)
+	} else {
+		topHeight := bodyHeight * 3 / 5
+		bottomHeight := bodyHeight - topHeight
+		leftWidth := width * 11 / 20
+		rightWidth := width - leftWidth
+		widths := []int{leftWidth, rightWidth}
+
+		probes, alerts := m.pane(topPaneProbes), m.pane(topPaneAlerts)
+		incidents, regions := m.pane(topPaneIncidents), m.pane(topPaneRegions)
+
+		lines = append(lines, tui.HStack(widths, probes.Render(leftWidth, topHeight), alerts.Render(rightWidth, topHeight))...)
+		lines = append(lines, tui.HStack(widths, incidents.Render(leftWidth, bottomHeight), regions.Render(rightWidth, bottomHeight))...)
+	}
+
+	lines = append(lines, tui.Fit(" "+m.status, width))
+
+	help := " Tab pane  ↑↓ select  Enter detail  a ack  p pause/resume  r refresh  q quit"
+	if m.detail != nil {
+		help = " Esc back  p pause/resume  r refresh  q quit"
+	}
+	return append(lines, tui.Fit(help, width))
+}
+
+// renderTopHeader renders the summary line at the top of the screen.
+func renderTopHeader(m *topModel, width int, now time.Time) string {
+	counts := make(map[string]int)
+	for _, p := range m.snap.probes {
+		counts[p.Status]++
+	}
+
+	left := fmt.Sprintf(" StackEye top   probes %d  up %d  down %d  degraded %d  paused %d   alerts %d",
+		len(m.snap.probes), counts["up"], counts["down"], counts["degraded"], counts["paused"], len(m.snap.alerts))
+
+	right := "loading "
+	if !m.snap.fetchedAt.IsZero() {
+		right = fmt.Sprintf("updated %s ago, every %s ", formatTopAge(now.Sub(m.snap.fetchedAt)), m.interval)
+	}
+
+	gap := width - len([]rune(left)) - len([]rune(right))
+	if gap < 1 {
+		return tui.Fit(left, width)
+	}
+	return left + strings.Repeat(" ", gap) + right
+}
+
+// pane builds the pane for one section of the main screen.
+func (m *topModel) pane(p topPane) *tui.Pane {
+	pane := &tui.Pane{
+		Title:    topPaneTitles[p],
+		Selected: m.cursor[p],
+		Focused:  m.focus == p,
+		Color:    m.color,
+	}
+
+	switch p {
+	case topPaneProbes:
+		pane.Title = fmt.Sprintf("Probes (%d)", len(m.snap.probes))
+		pane.Header = fmt.Sprintf("  %-9s %-28s %8s %8s", "STATUS", "NAME", "UPTIME", "AVG")
+		pane.Lines, pane.Empty = topProbeLines(m.snap.probes), topEmpty(m.snap.probesErr, "No probes")
+	case topPaneAlerts:
+		pane.Title = fmt.Sprintf("Active Alerts (%d)", len(m.snap.alerts))
+		pane.Header = fmt.Sprintf(" %-9s %-24s %s", "SEVERITY", "PROBE", "AGE")
+		pane.Lines, pane.Empty = topAlertLines(m.snap.alerts), topEmpty(m.snap.alertsErr, "No active alerts")
+	case topPaneIncidents:
+		pane.Header = fmt.Sprintf(" %-14s %-30s %s", "STATUS", "TITLE", "AGE")
+		pane.Lines, pane.Empty = topIncidentLines(m.snap.incidents), topEmpty(m.snap.incidentsErr, "No incidents")
+	case topPaneRegions:
+		pane.Header = fmt.Sprintf(" %-8s %-20s %-10s %s", "CODE", "NAME", "STATUS", "HEALTH")
+		pane.Lines, pane.Empty = topRegionLines(m.snap.regions), topEmpty(m.snap.regionsErr, "No regions")
+	}
+
+	if m.snap.fetchedAt.IsZero() {
+		pane.Empty = "Loading..."
+	}
+	return pane
+}
+
+// topEmpty returns the placeholder for an empty pane, showing the fetch error
+// if there was one.
+func topEmpty(err error, empty string) string {
+	if err != nil {
+		return "Error: " + err.Error()
+	}
+	return empty
+}
+
+// topProbeLines formats probes for the probes pane.
+func topProbeLines(probes []client.Probe) []tui.Line {
+	lines := make([]tui.Line, 0, len(probes))
+	for _, p := range probes {
+		status := p.Status
+		lines = append(lines, tui.Line{
+			Text: fmt.Sprintf("%s %-9s %-28s %7.2f%% %6.0fms",
+				getStatusIcon(status), status, tui.Fit(p.Name, 28), p.Uptime, p.AvgResponseTimeMs),
+			Style: topStatusStyle(status),
+		})
+	}
+	return lines
+}
+
+// topAlertLines formats alerts for the alerts pane.
+func topAlertLines(alerts []client.Alert) []tui.Line {
+	lines := make([]tui.Line, 0, len(alerts))
+	for _, a := range alerts {
+		probe := "-"
+		if a.Probe != nil {
+			probe = a.Probe.Name
+		}
+
+		style := tui.StyleNormal
+		switch a.Severity {
+		case client.AlertSeverityCritical:
+			style = tui.StyleBad
+		case client.AlertSeverityWarning:
+			style = tui.StyleWarning
+		}
+
+		lines = append(lines, tui.Line{
+			Text:  fmt.Sprintf(" %-9s %-24s %s", a.Severity, tui.Fit(probe, 24), formatTopAge(time.Since(a.TriggeredAt))),
+			Style: style,
+		})
+	}
+	return lines
+}
+
+// topIncidentLines formats incidents for the incidents pane.
+func topIncidentLines(incidents []topIncident) []tui.Line {
+	lines := make([]tui.Line, 0, len(incidents))
+	for _, i := range incidents {
+		style := tui.StyleWarning
+		if i.incident.Status == "resolved" {
+			style = tui.StyleMuted
+		}
+		title := i.incident.Title + " (" + i.statusPage + ")"
+		lines = append(lines, tui.Line{
+			Text:  fmt.Sprintf(" %-14s %-30s %s", i.incident.Status, tui.Fit(title, 30), formatTopAge(time.Since(i.incident.CreatedAt))),
+			Style: style,
+		})
+	}
+	return lines
+}
+
+// topRegionLines formats region health for the regions pane.
+func topRegionLines(regions []client.RegionStatus) []tui.Line {
+	lines := make([]tui.Line, 0, len(regions))
+	for _, r := range regions {
+		style := tui.StyleNormal
+		switch r.HealthStatus {
+		case "healthy":
+			style = tui.StyleGood
+		case "warning":
+			style = tui.StyleWarning
+		case "degraded":
+			style = tui.StyleBad
+		}
+		lines = append(lines, tui.Line{
+			Text:  fmt.Sprintf(" %-8s %-20s %-10s %s", r.ID, tui.Fit(r.Name, 20), r.Status, r.HealthStatus),
+			Style: style,
+		})
+	}
+	return lines
+}
+
+// detailPane builds the full-screen probe detail pane.
+func (m *topModel) detailPane() *tui.Pane {
+	d := m.detail
+	pane := &tui.Pane{Title: "Probe", Selected: -1, Focused: true, Color: m.color}
+
+	if d.probe == nil {
+		pane.Empty = "Loading..."
+		if d.err != nil {
+			pane.Empty = "Error: " + d.err.Error()
+		}
+		return pane
+	}
+
+	p := d.probe
+	status := p.Status
+	pane.Title = p.Name
+
+	lastCheck := "never"
+	if p.LastCheckedAt != nil {
+		lastCheck = formatTopAge(time.Since(*p.LastCheckedAt)) + " ago"
+	}
+
+	lines := []tui.Line{
+		{Text: fmt.Sprintf(" Status:        %s %s", getStatusIcon(status), status), Style: topStatusStyle(status)},
+		{Text: fmt.Sprintf(" Target:        %s (%s)", p.URL, p.CheckType)},
+		{Text: fmt.Sprintf(" Interval:      %ds", p.IntervalSeconds)},
+		{Text: fmt.Sprintf(" Regions:       %s", strings.Join(p.Regions, ", "))},
+		{Text: fmt.Sprintf(" Uptime (24h):  %.2f%%", p.Uptime)},
+		{Text: fmt.Sprintf(" Avg response:  %.0fms", p.AvgResponseTimeMs)},
+		{Text: fmt.Sprintf(" Last check:    %s", lastCheck)},
+		{Text: fmt.Sprintf(" ID:            %s", p.ID), Style: tui.StyleMuted},
+		{},
+		{Text: fmt.Sprintf(" %-20s %-10s %-8s %8s %5s  %s", "CHECKED", "REGION", "STATUS", "RESP", "CODE", "ERROR"), Style: tui.StyleHeader},
+	}
+
+	switch {
+	case d.err != nil:
+		lines = append(lines, tui.Line{Text: " Error: " + d.err.Error(), Style: tui.StyleBad})
+	case d.results == nil || len(d.results.Results) == 0:
+		lines = append(lines, tui.Line{Text: " No recent check results", Style: tui.StyleMuted})
+	default:
+		for _, r := range d.results.Results {
+			code := "-"
+			if r.StatusCode != nil {
+				code = fmt.Sprintf("%d", *r.StatusCode)
+			}
+			errMsg := ""
+			if r.ErrorMessage != nil {
+				errMsg = *r.ErrorMessage
+			}
+			lines = append(lines, tui.Line{
+				Text: fmt.Sprintf(" %-20s %-10s %-8s %6dms %5s  %s",
+					r.CheckedAt.Local().Format("2006-01-02 15:04:05"), r.Region, r.Status, r.ResponseTimeMs, code, errMsg),
+				Style: topStatusStyle(r.Status),
+			})
+		}
+	}
+
+	pane.Lines = lines
+	return pane
+}
+
+// topStatusStyle maps a probe or result status to a line style.
+func topStatusStyle(status string) tui.Style {
+	switch status {
+	case "up", "success":
+		return tui.StyleGood
+	case "down", "failure", "error", "timeout":
+		return tui.StyleBad
+	case "degraded":
+		return tui.StyleWarning
+	case "paused", "pending":
+		return tui.StyleMuted
+	}
+	return tui.StyleNormal
+}
+
+// topProbeRank orders probe statuses so problems are listed first.
+func topProbeRank(status string) int {
+	switch status {
+	case "down":
+		return 0
+	case "degraded":
+		return 1
+	case "pending":
+		return 2
+	case "up":
+		return 3
+	default:
+		return 4
+	}
+}
+
+// formatTopAge formats a duration compactly (e.g. "12s", "5m", "3h", "2d").
+func formatTopAge(d time.Duration) string {
+	return formatTriageAge(d)
+}
@@ -0,0 +1,270 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/tui"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	"github.com/google/uuid"
+)
+
+func runeKey(r rune) tui.Key {
+	return tui.Key{Type: tui.KeyRune, Rune: r}
+}
+
+func newTestTopModel() *topModel {
+	m := newTopModel(10*time.Second, false)
+	m.applySnapshot(topSnapshot{
+		slow: true,
+		probes: []client.Probe{
+			{ID: uuid.New(), Name: "api", Status: "down"},
+			{ID: uuid.New(), Name: "web", Status: "up"},
+			{ID: uuid.New(), Name: "old", Status: "paused"},
+		},
+		alerts: []client.Alert{
+			{
+				ID:          uuid.New(),
+				Status:      client.AlertStatusActive,
+				Severity:    client.AlertSeverityCritical,
+				Probe:       &client.AlertProbe{ID: uuid.New(), Name: "api"},
+				TriggeredAt: time.Now().Add(-5 * time.Minute),
+			},
+		},
+		regions:   []client.RegionStatus{{ID: "nyc3", Name: "New York", Status: "active", HealthStatus: "healthy"}},
+		fetchedAt: time.Now(),
+	})
+	return m
+}
+
+func TestNewTopCmd(t *testing.T) {
+	cmd := NewTopCmd()
+
+	if cmd.Use != "top" {
+		t.Errorf("expected Use='top', got %q", cmd.Use)
+	}
+
+	flag := cmd.Flags().Lookup("interval")
+	if flag == nil {
+		t.Fatal("expected --interval flag")
+	}
+	if flag.Shorthand != "i" {
+		t.Errorf("expected shorthand 'i', got %q", flag.Shorthand)
+	}
+	if flag.DefValue != "10s" {
+		t.Errorf("expected default 10s, got %q", flag.DefValue)
+	}
+}
+
+func TestRunTop_InvalidInterval(t *testing.T) {
+	err := runTop(context.Background(), &topFlags{interval: time.Second})
+	if err == nil || !strings.Contains(err.Error(), "minimum is 2s") {
+		t.Errorf("expected minimum interval error, got %v", err)
+	}
+}
+
+func TestTopModel_PaneNavigation(t *testing.T) {
+	m := newTestTopModel()
+
+	m.handleKey(tui.Key{Type: tui.KeyTab})
+	if m.focus != topPaneAlerts {
+		t.Errorf("expected alerts pane focused, got %d", m.focus)
+	}
+
+	m.handleKey(tui.Key{Type: tui.KeyBackTab})
+	m.handleKey(tui.Key{Type: tui.KeyBackTab})
+	if m.focus != topPaneRegions {
+		t.Errorf("expected focus to wrap to regions pane, got %d", m.focus)
+	}
+}
+
+func TestTopModel_CursorClamped(t *testing.T) {
+	m := newTestTopModel()
+
+	m.handleKey(runeKey('j'))
+	m.handleKey(tui.Key{Type: tui.KeyPageDown})
+	if m.cursor[topPaneProbes] != 2 {
+		t.Errorf("expected cursor at last probe (2), got %d", m.cursor[topPaneProbes])
+	}
+
+	m.handleKey(runeKey('g'))
+	if m.cursor[topPaneProbes] != 0 {
+		t.Errorf("expected cursor at top, got %d", m.cursor[topPaneProbes])
+	}
+
+	// A smaller snapshot pulls the cursor back into range
+	m.cursor[topPaneProbes] = 2
+	m.applySnapshot(topSnapshot{probes: m.snap.probes[:1], fetchedAt: time.Now()})
+	if m.cursor[topPaneProbes] != 0 {
+		t.Errorf("expected cursor clamped to 0, got %d", m.cursor[topPaneProbes])
+	}
+}
+
+func TestTopModel_FastSnapshotKeepsSlowData(t *testing.T) {
+	m := newTestTopModel()
+
+	m.applySnapshot(topSnapshot{slow: false, fetchedAt: time.Now()})
+	if len(m.snap.regions) != 1 {
+		t.Errorf("expected regions to be kept from the previous slow refresh, got %d", len(m.snap.regions))
+	}
+}
+
+func TestTopModel_EnterOpensDetail(t *testing.T) {
+	m := newTestTopModel()
+	probeID := m.snap.probes[0].ID
+
+	effect := m.handleKey(tui.Key{Type: tui.KeyEnter})
+	if effect.detail == nil || *effect.detail != probeID {
+		t.Fatalf("expected detail load for %s, got %v", probeID, effect.detail)
+	}
+	if m.detail == nil {
+		t.Fatal("expected detail view to be open")
+	}
+
+	// Results for another probe are ignored
+	m.applyDetail(&topProbeDetail{probeID: uuid.New()})
+	if m.detail.probeID != probeID {
+		t.Error("expected stale detail to be ignored")
+	}
+
+	m.handleKey(tui.Key{Type: tui.KeyEscape})
+	if m.detail != nil {
+		t.Error("expected Esc to close the detail view")
+	}
+}
+
+func TestTopModel_EnterOnAlertOpensProbe(t *testing.T) {
+	m := newTestTopModel()
+	m.focus = topPaneAlerts
+
+	effect := m.handleKey(tui.Key{Type: tui.KeyEnter})
+	if effect.detail == nil || *effect.detail != m.snap.alerts[0].Probe.ID {
+		t.Errorf("expected detail for the alert's probe, got %v", effect.detail)
+	}
+}
+
+func TestTopModel_AckRequiresConfirmation(t *testing.T) {
+	m := newTestTopModel()
+	m.focus = topPaneAlerts
+
+	effect := m.handleKey(runeKey('a'))
+	if effect.action != nil {
+		t.Fatal("expected no action before confirmation")
+	}
+	if !strings.Contains(m.status, "(y/n)") {
+		t.Errorf("expected confirmation prompt, got %q", m.status)
+	}
+
+	effect = m.handleKey(runeKey('y'))
+	if effect.action == nil || effect.action.kind != topActionAck || effect.action.id != m.snap.alerts[0].ID {
+		t.Fatalf("expected ack action for alert, got %+v", effect.action)
+	}
+}
+
+func TestTopModel_ActionCancelled(t *testing.T) {
+	m := newTestTopModel()
+
+	m.handleKey(runeKey('p'))
+	effect := m.handleKey(runeKey('n'))
+	if effect.action != nil {
+		t.Error("expected action to be cancelled")
+	}
+	if m.pending != nil {
+		t.Error("expected pending action to be cleared")
+	}
+}
+
+func TestTopModel_PauseOrResume(t *testing.T) {
+	m := newTestTopModel()
+
+	m.handleKey(runeKey('p'))
+	effect := m.handleKey(runeKey('y'))
+	if effect.action == nil || effect.action.kind != topActionPause {
+		t.Fatalf("expected pause action, got %+v", effect.action)
+	}
+
+	m.handleKey(runeKey('G'))
+	m.handleKey(runeKey('p'))
+	effect = m.handleKey(runeKey('y'))
+	if effect.action == nil || effect.action.kind != topActionResume {
+		t.Fatalf("expected resume action for paused probe, got %+v", effect.action)
+	}
+}
+
+func TestTopModel_ActionResult(t *testing.T) {
+	m := newTestTopModel()
+	action := &topAction{kind: topActionPause, label: `probe "api"`}
+
+	m.applyActionResult(topActionResult{action: action})
+	if m.status != `Paused probe "api"` {
+		t.Errorf("unexpected status %q", m.status)
+	}
+
+	m.applyActionResult(topActionResult{action: action, err: errors.New("boom")})
+	if !strings.Contains(m.status, "Failed to pause") || !strings.Contains(m.status, "boom") {
+		t.Errorf("unexpected status %q", m.status)
+	}
+}
+
+func TestTopModel_Quit(t *testing.T) {
+	m := newTestTopModel()
+
+	if !m.handleKey(runeKey('q')).quit {
+		t.Error("expected q to quit")
+	}
+	if !m.handleKey(tui.Key{Type: tui.KeyCtrlC}).quit {
+		t.Error("expected Ctrl+C to quit")
+	}
+}
+
+func TestRenderTop_Dimensions(t *testing.T) {
+	m := newTestTopModel()
+
+	for _, size := range [][2]int{{80, 24}, {120, 40}, {200, 60}} {
+		width, height := size[0], size[1]
+		lines := renderTop(m, width, height, time.Now())
+		if len(lines) != height {
+			t.Errorf("%dx%d: expected %d lines, got %d", width, height, height, len(lines))
+		}
+		for i, line := range lines {
+			if n := utf8.RuneCountInString(line); n != width {
+				t.Errorf("%dx%d: line %d has width %d: %q", width, height, i, n, line)
+			}
+		}
+	}
+}
+
+func TestRenderTop_Detail(t *testing.T) {
+	m := newTestTopModel()
+	m.handleKey(tui.Key{Type: tui.KeyEnter})
+	probe := m.snap.probes[0]
+	m.applyDetail(&topProbeDetail{probeID: probe.ID, probe: &probe})
+
+	lines := renderTop(m, 100, 30, time.Now())
+	if len(lines) != 30 {
+		t.Fatalf("expected 30 lines, got %d", len(lines))
+	}
+	screen := strings.Join(lines, "\n")
+	if !strings.Contains(screen, "api") || !strings.Contains(screen, "No recent check results") {
+		t.Errorf("expected probe detail on screen, got:\n%s", screen)
+	}
+}
+
+func TestRenderTop_TooSmall(t *testing.T) {
+	m := newTestTopModel()
+
+	lines := renderTop(m, 30, 5, time.Now())
+	if len(lines) != 1 || !strings.Contains(lines[0], "too small") {
+		t.Errorf("expected too-small message, got %q", lines)
+	}
+}
+
+func TestTopProbeRank(t *testing.T) {
+	if !(topProbeRank("down") < topProbeRank("degraded") && topProbeRank("degraded") < topProbeRank("up") && topProbeRank("up") < topProbeRank("paused")) {
+		t.Error("expected down < degraded < up < paused")
+	}
+}
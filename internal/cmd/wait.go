@@ -0,0 +1,441 @@
+// Package cmd implements the CLI commands for StackEye.
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/StackEye-IO/stackeye-cli/internal/api"
+	clierrors "github.com/StackEye-IO/stackeye-cli/internal/errors"
+	"github.com/StackEye-IO/stackeye-cli/internal/output"
+	"github.com/StackEye-IO/stackeye-go-sdk/client"
+	sdkoutput "github.com/StackEye-IO/stackeye-go-sdk/output"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// waitRequestTimeout is the maximum time to wait for a single API response.
+const waitRequestTimeout = 30 * time.Second
+
+// waitMinInterval is the shortest allowed polling interval.
+const waitMinInterval = 2 * time.Second
+
+// validWaitConditions lists the conditions accepted by --for.
+var validWaitConditions = []string{"status=up", "status=down", "status=degraded"}
+
+// Wait outcomes reported in the summary.
+const (
+	waitResultMet     = "met"
+	waitResultFailed  = "failed"
+	waitResultTimeout = "timeout"
+)
+
+// waitFlags holds the flag values for the wait command.
+type waitFlags struct {
+	forCond     string
+	labels      string
+	timeout     time.Duration
+	interval    time.Duration
+	consecutive int
+	failAfter   int
+}
+
+// waitProbeState tracks one probe while waiting.
+type waitProbeState struct {
+	id          uuid.UUID
+	name        string
+	status      string
+	lastChecked time.Time
+	consecutive int // consecutive checks meeting the condition
+	failing     int // consecutive checks reporting down
+	met         bool
+}
+
+// WaitProbeResult is the final state of one probe.
+type WaitProbeResult struct {
+	ProbeID     uuid.UUID `json:"probe_id" yaml:"probe_id"`
+	Name        string    `json:"name" yaml:"name"`
+	Status      string    `json:"status" yaml:"status"`
+	Consecutive int       `json:"consecutive_checks" yaml:"consecutive_checks"`
+	Met         bool      `json:"met" yaml:"met"`
+}
+
+// WaitResult is the outcome of a wait command.
+type WaitResult struct {
+	Condition      string            `json:"condition" yaml:"condition"`
+	Result         string            `json:"result" yaml:"result"`
+	Required       int               `json:"required_checks" yaml:"required_checks"`
+	ElapsedSeconds float64           `json:"elapsed_seconds" yaml:"elapsed_seconds"`
+	Probes         []WaitProbeResult `json:"probes" yaml:"probes"`
+}
+
+// waitRow is a row in the wait summary table.
+type waitRow struct {
+	Probe  string `table:"PROBE"`
+	Status string `table:"STATUS"`
+	Checks string `table:"CHECKS"`
+	Result string `table:"RESULT"`
+}
+
+// NewWaitCmd creates and returns the wait command.
+func NewWaitCmd() *cobra.Command {
+	flags := &waitFlags{}
+
+	cmd := &cobra.Command{
+		Use:               "wait [probe...]",
+		Short:             "Wait until probes reach a status",
+		ValidArgsFunction: ProbeCompletion(),
+		Long: `Wait until probes reach a status, for use as a deployment gate.
+
+Runs an immediate test check of every probe, then polls until each probe has
+met the --for condition for --consecutive checks in a row. Only checks that
+ran after the command started count, so a status left over from before a
+deployment is ignored. Once a probe has met the condition it stays met.
+
+Probes are given as names or UUIDs, selected with --labels, or both.
+
+Conditions:
+  status=up        Probe checks succeed (default)
+  status=down      Probe checks fail
+  status=degraded  Probe is degraded
+
+Exit Codes:
+  0  Every probe met the condition
+  1  A probe reported down for --fail-after consecutive checks
+  9  --timeout elapsed before every probe met the condition
+
+Progress is written to stderr; a summary is printed to stdout when the wait
+ends (use -o json for machine-readable output).
+
+Examples:
+  # Gate a deployment on two probes
+  stackeye wait --for status=up api-health web-health --timeout 10m
+
+  # Require three good checks in a row from every probe of a release
+  stackeye wait -l release=v2 --consecutive 3
+
+  # Combine names and a selector
+  stackeye wait --for status=up api-health -l release=v2 --timeout 10m
+
+  # Never fail early, only time out
+  stackeye wait api-health --fail-after 0`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && flags.labels == "" {
+				return fmt.Errorf("requires at least one probe or --labels")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWait(cmd.Context(), args, flags)
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.forCond, "for", "status=up", "condition to wait for: status=up, status=down, status=degraded")
+	cmd.Flags().StringVarP(&flags.labels, "labels", "l", "", "also wait for probes matching labels: key=value,key!=value,key (AND logic)")
+	cmd.Flags().DurationVar(&flags.timeout, "timeout", 10*time.Minute, "give up after this long")
+	cmd.Flags().DurationVarP(&flags.interval, "interval", "i", 15*time.Second, "polling interval (minimum 2s)")
+	cmd.Flags().IntVar(&flags.consecutive, "consecutive", 1, "consecutive checks that must meet the condition")
+	cmd.Flags().IntVar(&flags.failAfter, "fail-after", 3, "fail once a probe reports down this many checks in a row (0 to never fail early)")
+
+	_ = cmd.RegisterFlagCompletionFunc("labels", LabelFilterCompletion())
+	_ = cmd.RegisterFlagCompletionFunc("for", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return validWaitConditions, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	return cmd
+}
+
+// runWait executes the wait command logic.
+func runWait(ctx context.Context, args []string, flags *waitFlags) error {
+	target, err := parseWaitCondition(flags.forCond)
+	if err != nil {
+		return err
+	}
+	if flags.timeout <= 0 {
+		return fmt.Errorf("invalid timeout %v: must be positive", flags.timeout)
+	}
+	if flags.interval < waitMinInterval {
+		return fmt.Errorf("invalid interval %v: minimum is %v", flags.interval, waitMinInterval)
+	}
+	if flags.consecutive < 1 {
+		return fmt.Errorf("invalid consecutive %d: must be at least 1", flags.consecutive)
+	}
+	if flags.failAfter < 0 {
+		return fmt.Errorf("invalid fail-after %d: must be 0 or greater", flags.failAfter)
+	}
+	if flags.labels != "" {
+		if _, err := parseLabelSelector(flags.labels); err != nil {
+			return err
+		}
+	}
+
+	apiClient, err := api.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize API client: %w", err)
+	}
+
+	probeIDs, err := resolveWaitProbes(ctx, apiClient, args, flags.labels)
+	if err != nil {
+		return err
+	}
+	if len(probeIDs) == 0 {
+		return fmt.Errorf("no probes match selector %q", flags.labels)
+	}
+
+	start := time.Now()
+	waitCtx, cancel := context.WithTimeout(ctx, flags.timeout)
+	defer cancel()
+
+	states, err := startWait(waitCtx, apiClient, probeIDs, target, flags)
+	if err != nil {
+		return waitStartError(waitCtx, err, flags)
+	}
+
+	result := waitResultMet
+poll:
+	for !waitAllMet(states) {
+		if waitFailedProbe(states, flags.failAfter) != nil {
+			result = waitResultFailed
+			break
+		}
+
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			result = waitResultTimeout
+			break poll
+		case <-time.After(flags.interval):
+			pollWaitProbes(waitCtx, apiClient, states, target, flags)
+		}
+	}
+
+	if err := printWaitResult(os.Stdout, states, flags, result, time.Since(start)); err != nil {
+		return err
+	}
+	return waitOutcomeError(states, flags, result)
+}
+
+// parseWaitCondition parses a --for value and returns the target status.
+func parseWaitCondition(cond string) (string, error) {
+	for _, valid := range validWaitConditions {
+		if cond == valid {
+			return strings.TrimPrefix(cond, "status="), nil
+		}
+	}
+	return "", clierrors.InvalidValueError("--for", cond, validWaitConditions)
+}
+
+// resolveWaitProbes returns the IDs of the named probes followed by any
+// probes matching the selector, without duplicates.
+func resolveWaitProbes(ctx context.Context, apiClient *client.Client, args []string, selector string) ([]uuid.UUID, error) {
+	ids, err := ResolveProbeIDs(ctx, apiClient, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if selector != "" {
+		probes, err := selectProbesByLabels(ctx, apiClient, selector)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range probes {
+			ids = append(ids, p.ID)
+		}
+	}
+
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := ids[:0]
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique, nil
+}
+
+// startWait records each probe's last check time as the baseline and runs an
+// immediate test check, which counts as the first check.
+func startWait(ctx context.Context, apiClient *client.Client, ids []uuid.UUID, target string, flags *waitFlags) ([]*waitProbeState, error) {
+	states := make([]*waitProbeState, 0, len(ids))
+	for _, id := range ids {
+		reqCtx, cancel := context.WithTimeout(ctx, waitRequestTimeout)
+		probe, err := client.GetProbe(reqCtx, apiClient, id, "")
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("failed to get probe %s: %w", id, err)
+		}
+
+		state := &waitProbeState{id: probe.ID, name: probe.Name, status: probe.Status}
+		if probe.LastCheckedAt != nil {
+			state.lastChecked = *probe.LastCheckedAt
+		}
+		states = append(states, state)
+
+		result, err := client.TestProbe(reqCtx, apiClient, probeToTestRequest(probe))
+		cancel()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: test check failed: %v\n", probe.Name, err)
+			continue
+		}
+		state.observe(result.Status, target, flags.consecutive)
+		fmt.Fprintf(os.Stderr, "%s: test check %s (%d/%d)\n", state.name, result.Status, state.consecutive, flags.consecutive)
+	}
+	return states, nil
+}
+
+// waitStartError maps a startWait failure to the timeout exit code when
+// --timeout expired before the initial checks finished.
+func waitStartError(waitCtx context.Context, err error, flags *waitFlags) error {
+	if errors.Is(waitCtx.Err(), context.DeadlineExceeded) {
+		return clierrors.WithExitCode(clierrors.ExitTimeout,
+			fmt.Errorf("timed out after %v starting wait: %w", flags.timeout, err))
+	}
+	return err
+}
+
+// pollWaitProbes fetches every probe that has not met the condition and
+// counts checks that ran since the previous poll.
+func pollWaitProbes(ctx context.Context, apiClient *client.Client, states []*waitProbeState, target string, flags *waitFlags) {
+	for _, state := range states {
+		if state.met {
+			continue
+		}
+
+		reqCtx, cancel := context.WithTimeout(ctx, waitRequestTimeout)
+		probe, err := client.GetProbe(reqCtx, apiClient, state.id, "")
+		cancel()
+		if err != nil {
+			if ctx.Err() == nil {
+				fmt.Fprintf(os.Stderr, "Error polling %s: %v (retrying...)\n", state.name, err)
+			}
+			continue
+		}
+
+		if probe.LastCheckedAt == nil || !probe.LastCheckedAt.After(state.lastChecked) {
+			continue
+		}
+		state.lastChecked = *probe.LastCheckedAt
+		state.observe(probe.Status, target, flags.consecutive)
+		fmt.Fprintf(os.Stderr, "%s: %s (%d/%d)\n", state.name, probe.Status, state.consecutive, flags.consecutive)
+	}
+}
+
+// observe records the status reported by one check.
+func (s *waitProbeState) observe(status, target string, required int) {
+	s.status = status
+	if status == target {
+		s.consecutive++
+		s.failing = 0
+		if s.consecutive >= required {
+			s.met = true
+		}
+		return
+	}
+
+	s.consecutive = 0
+	if status == string(client.ProbeStatusDown) && target != status {
+		s.failing++
+	} else {
+		s.failing = 0
+	}
+}
+
+// waitAllMet reports whether every probe has met the condition.
+func waitAllMet(states []*waitProbeState) bool {
+	for _, s := range states {
+		if !s.met {
+			return false
+		}
+	}
+	return true
+}
+
+// waitFailedProbe returns the first probe that has reported down failAfter
+// times in a row, or nil. failAfter 0 never fails.
+func waitFailedProbe(states []*waitProbeState, failAfter int) *waitProbeState {
+	if failAfter == 0 {
+		return nil
+	}
+	for _, s := range states {
+		if !s.met && s.failing >= failAfter {
+			return s
+		}
+	}
+	return nil
+}
+
+// waitOutcomeError maps the outcome to the command's exit code.
+func waitOutcomeError(states []*waitProbeState, flags *waitFlags, result string) error {
+	switch result {
+	case waitResultFailed:
+		failed := waitFailedProbe(states, flags.failAfter)
+		return clierrors.WithExitCode(clierrors.ExitError,
+			fmt.Errorf("probe %q reported down for %d consecutive checks", failed.name, failed.failing))
+	case waitResultTimeout:
+		pending := 0
+		for _, s := range states {
+			if !s.met {
+				pending++
+			}
+		}
+		return clierrors.WithExitCode(clierrors.ExitTimeout,
+			fmt.Errorf("timed out after %v waiting for %d of %d probes to meet %s", flags.timeout, pending, len(states), flags.forCond))
+	default:
+		return nil
+	}
+}
+
+// printWaitResult prints the final state of every probe.
+func printWaitResult(w io.Writer, states []*waitProbeState, flags *waitFlags, result string, elapsed time.Duration) error {
+	summary := &WaitResult{
+		Condition:      flags.forCond,
+		Result:         result,
+		Required:       flags.consecutive,
+		ElapsedSeconds: elapsed.Round(time.Second).Seconds(),
+	}
+	rows := make([]waitRow, 0, len(states))
+	for _, s := range states {
+		summary.Probes = append(summary.Probes, WaitProbeResult{
+			ProbeID:     s.id,
+			Name:        s.name,
+			Status:      s.status,
+			Consecutive: s.consecutive,
+			Met:         s.met,
+		})
+
+		rowResult := "waiting"
+		switch {
+		case s.met:
+			rowResult = waitResultMet
+		case flags.failAfter > 0 && s.failing >= flags.failAfter:
+			rowResult = waitResultFailed
+		}
+		rows = append(rows, waitRow{
+			Probe:  s.name,
+			Status: s.status,
+			Checks: fmt.Sprintf("%d/%d", min(s.consecutive, flags.consecutive), flags.consecutive),
+			Result: rowResult,
+		})
+	}
+
+	format := output.NewPrinter(GetConfig()).Format()
+	if format == sdkoutput.FormatJSON || format == sdkoutput.FormatYAML {
+		return output.Print(summary)
+	}
+
+	if err := output.Print(rows); err != nil {
+		return err
+	}
+	if result == waitResultMet {
+		fmt.Fprintf(w, "\nAll %d probes met %s after %v.\n", len(states), flags.forCond, elapsed.Round(time.Second))
+	}
+	return nil
+}
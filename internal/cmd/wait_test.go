@@ -0,0 +1,229 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	clierrors "github.com/StackEye-IO/stackeye-cli/internal/errors"
+	"github.com/google/uuid"
+)
+
+func defaultWaitFlags() *waitFlags {
+	return &waitFlags{
+		forCond:     "status=up",
+		timeout:     10 * time.Minute,
+		interval:    15 * time.Second,
+		consecutive: 2,
+		failAfter:   3,
+	}
+}
+
+func TestNewWaitCmd(t *testing.T) {
+	cmd := NewWaitCmd()
+
+	if cmd.Use != "wait [probe...]" {
+		t.Errorf("expected Use to be 'wait [probe...]', got %q", cmd.Use)
+	}
+
+	defaults := map[string]string{
+		"for":         "status=up",
+		"timeout":     "10m0s",
+		"interval":    "15s",
+		"consecutive": "1",
+		"fail-after":  "3",
+		"labels":      "",
+	}
+	for name, want := range defaults {
+		flag := cmd.Flags().Lookup(name)
+		if flag == nil {
+			t.Errorf("expected %q flag to be defined", name)
+			continue
+		}
+		if flag.DefValue != want {
+			t.Errorf("flag %q: expected default %q, got %q", name, want, flag.DefValue)
+		}
+	}
+}
+
+func TestWaitCmd_Args(t *testing.T) {
+	cmd := NewWaitCmd()
+
+	if err := cmd.Args(cmd, []string{}); err == nil {
+		t.Error("expected error without probes or --labels")
+	}
+	if err := cmd.Args(cmd, []string{"api", "web"}); err != nil {
+		t.Errorf("expected probes to be accepted, got %v", err)
+	}
+
+	if err := cmd.Flags().Set("labels", "release=v2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Args(cmd, []string{}); err != nil {
+		t.Errorf("expected --labels alone to be accepted, got %v", err)
+	}
+	if err := cmd.Args(cmd, []string{"api"}); err != nil {
+		t.Errorf("expected probes and --labels together to be accepted, got %v", err)
+	}
+}
+
+func TestRunWait_Validation(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*waitFlags)
+		want   string
+	}{
+		{"invalid condition", func(f *waitFlags) { f.forCond = "status=sideways" }, "--for"},
+		{"zero timeout", func(f *waitFlags) { f.timeout = 0 }, "invalid timeout"},
+		{"short interval", func(f *waitFlags) { f.interval = time.Second }, "invalid interval"},
+		{"zero consecutive", func(f *waitFlags) { f.consecutive = 0 }, "invalid consecutive"},
+		{"negative fail-after", func(f *waitFlags) { f.failAfter = -1 }, "invalid fail-after"},
+		{"invalid selector", func(f *waitFlags) { f.labels = "=v2" }, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := defaultWaitFlags()
+			tt.modify(flags)
+
+			err := runWait(t.Context(), []string{"api"}, flags)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if strings.Contains(err.Error(), "API client") {
+				t.Errorf("expected validation before API client initialization, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestParseWaitCondition(t *testing.T) {
+	for cond, want := range map[string]string{"status=up": "up", "status=down": "down", "status=degraded": "degraded"} {
+		got, err := parseWaitCondition(cond)
+		if err != nil || got != want {
+			t.Errorf("parseWaitCondition(%q) = %q, %v; want %q", cond, got, err, want)
+		}
+	}
+
+	for _, cond := range []string{"", "up", "status=paused", "health=up"} {
+		if _, err := parseWaitCondition(cond); err == nil {
+			t.Errorf("parseWaitCondition(%q): expected error", cond)
+		}
+	}
+}
+
+func TestWaitProbeState_Observe(t *testing.T) {
+	s := &waitProbeState{}
+
+	s.observe("up", "up", 2)
+	if s.consecutive != 1 || s.met {
+		t.Errorf("after one up: expected 1 check and not met, got %+v", s)
+	}
+
+	s.observe("down", "up", 2)
+	if s.consecutive != 0 || s.failing != 1 {
+		t.Errorf("after down: expected reset and 1 failing, got %+v", s)
+	}
+
+	s.observe("degraded", "up", 2)
+	if s.failing != 0 {
+		t.Errorf("expected degraded to reset the failing streak, got %+v", s)
+	}
+
+	s.observe("up", "up", 2)
+	s.observe("up", "up", 2)
+	if !s.met || s.status != "up" {
+		t.Errorf("after two ups: expected met, got %+v", s)
+	}
+}
+
+func TestWaitProbeState_ObserveDownTarget(t *testing.T) {
+	s := &waitProbeState{}
+	s.observe("down", "down", 1)
+	if !s.met || s.failing != 0 {
+		t.Errorf("expected down to meet a status=down wait without failing, got %+v", s)
+	}
+}
+
+func TestWaitFailedProbe(t *testing.T) {
+	states := []*waitProbeState{
+		{name: "api", met: true},
+		{name: "web", failing: 3},
+	}
+
+	if got := waitFailedProbe(states, 3); got == nil || got.name != "web" {
+		t.Errorf("expected web to have failed, got %+v", got)
+	}
+	if got := waitFailedProbe(states, 4); got != nil {
+		t.Errorf("expected no failure below the threshold, got %+v", got)
+	}
+	if got := waitFailedProbe(states, 0); got != nil {
+		t.Errorf("expected fail-after 0 to never fail, got %+v", got)
+	}
+}
+
+func TestWaitAllMet(t *testing.T) {
+	if !waitAllMet([]*waitProbeState{{met: true}, {met: true}}) {
+		t.Error("expected all met")
+	}
+	if waitAllMet([]*waitProbeState{{met: true}, {}}) {
+		t.Error("expected not all met")
+	}
+}
+
+func TestWaitOutcomeError(t *testing.T) {
+	flags := defaultWaitFlags()
+	states := []*waitProbeState{
+		{id: uuid.New(), name: "api", met: true},
+		{id: uuid.New(), name: "web", failing: 3},
+	}
+
+	if err := waitOutcomeError(states, flags, waitResultMet); err != nil {
+		t.Errorf("expected no error when met, got %v", err)
+	}
+
+	var exitErr *clierrors.ExitCodeError
+
+	err := waitOutcomeError(states, flags, waitResultFailed)
+	if !errors.As(err, &exitErr) || exitErr.Code != clierrors.ExitError {
+		t.Errorf("expected exit code %d on failure, got %v", clierrors.ExitError, err)
+	}
+	if !strings.Contains(err.Error(), `"web"`) {
+		t.Errorf("expected failed probe in message, got %v", err)
+	}
+
+	err = waitOutcomeError(states, flags, waitResultTimeout)
+	if !errors.As(err, &exitErr) || exitErr.Code != clierrors.ExitTimeout {
+		t.Errorf("expected exit code %d on timeout, got %v", clierrors.ExitTimeout, err)
+	}
+	if !strings.Contains(err.Error(), "1 of 2 probes") {
+		t.Errorf("expected pending count in message, got %v", err)
+	}
+}
+
+func TestWaitStartError(t *testing.T) {
+	flags := defaultWaitFlags()
+	apiErr := errors.New("failed to get probe: context deadline exceeded")
+
+	if err := waitStartError(context.Background(), apiErr, flags); err != apiErr {
+		t.Errorf("expected original error while --timeout has not expired, got %v", err)
+	}
+
+	expired, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	<-expired.Done()
+
+	var exitErr *clierrors.ExitCodeError
+	err := waitStartError(expired, apiErr, flags)
+	if !errors.As(err, &exitErr) || exitErr.Code != clierrors.ExitTimeout {
+		t.Errorf("expected exit code %d when --timeout expired, got %v", clierrors.ExitTimeout, err)
+	}
+	if !errors.Is(err, apiErr) {
+		t.Errorf("expected wrapped API error, got %v", err)
+	}
+}
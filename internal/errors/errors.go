@@ -80,6 +80,33 @@ const (
 	ExitSIGTERM = 143
 )
 
+// ExitCodeError is returned by commands whose outcome maps to a specific exit
+// code, such as a deployment gate that distinguishes a timeout from a failed
+// check. Err is printed like any other error; a nil Err exits silently.
+type ExitCodeError struct {
+	Code int
+	Err  error
+}
+
+// Error implements the error interface.
+func (e *ExitCodeError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("exit status %d", e.Code)
+	}
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *ExitCodeError) Unwrap() error {
+	return e.Err
+}
+
+// WithExitCode wraps err so that HandleError exits with code. Pass a nil err
+// to exit with code without printing anything.
+func WithExitCode(code int, err error) error {
+	return &ExitCodeError{Code: code, Err: err}
+}
+
 // errWriter is the destination for error messages.
 // Can be overridden for testing.
 var errWriter io.Writer = os.Stderr
@@ -118,6 +145,15 @@ func HandleError(err error) int {
 		fmt.Fprintf(errWriter, "[debug] Error message: %s\n", err.Error())
 	}
 
+	// Commands that chose their own exit code
+	var exitErr *ExitCodeError
+	if errors.As(err, &exitErr) {
+		if exitErr.Err != nil {
+			formatter.PrintError(exitErr.Err.Error())
+		}
+		return exitErr.Code
+	}
+
 	// Check for API errors first (most specific)
 	if apiErr := client.IsAPIError(err); apiErr != nil {
 		if debug {
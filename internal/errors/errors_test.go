@@ -357,6 +357,34 @@ func TestHandleError_WrappedError(t *testing.T) {
 	}
 }
 
+func TestHandleError_ExitCodeError(t *testing.T) {
+	buf := setupTest()
+
+	err := fmt.Errorf("wait: %w", WithExitCode(ExitTimeout, errors.New("timed out waiting for 2 probes")))
+
+	code := HandleError(err)
+
+	if code != ExitTimeout {
+		t.Errorf("HandleError(ExitCodeError) = %d, want %d", code, ExitTimeout)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte("timed out waiting for 2 probes")) {
+		t.Errorf("Expected error message in output, got: %s", buf.String())
+	}
+}
+
+func TestHandleError_ExitCodeError_Silent(t *testing.T) {
+	buf := setupTest()
+
+	code := HandleError(WithExitCode(42, nil))
+
+	if code != 42 {
+		t.Errorf("HandleError(silent ExitCodeError) = %d, want 42", code)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Expected no output, got: %q", buf.String())
+	}
+}
+
 func TestExitCodeName(t *testing.T) {
 	tests := []struct {
 		code int
@@ -0,0 +1,154 @@
+// Package output provides CLI output helpers that bridge the CLI's global flags
+// with the SDK's output formatters.
+package output
+
+import (
+	"math"
+	"strings"
+)
+
+// sparkBlocks are the block characters used by Sparkline, lowest to highest.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// brailleBase is the Unicode code point of the empty braille pattern.
+const brailleBase = 0x2800
+
+// brailleDots maps a dot position within a braille cell (column 0-1, row
+// 0-3 from the top) to its bit.
+var brailleDots = [2][4]rune{
+	{0x01, 0x02, 0x04, 0x40},
+	{0x08, 0x10, 0x20, 0x80},
+}
+
+// Sparkline renders values as a single line of block characters scaled
+// between the smallest and largest value. NaN values are rendered as gaps.
+func Sparkline(values []float64) string {
+	lo, hi, ok := SeriesRange(values)
+	if !ok {
+		return strings.Repeat(" ", len(values))
+	}
+
+	var sb strings.Builder
+	for _, v := range values {
+		if math.IsNaN(v) {
+			sb.WriteRune(' ')
+			continue
+		}
+		idx := 0
+		if hi > lo {
+			idx = int(math.Round((v - lo) / (hi - lo) * float64(len(sparkBlocks)-1)))
+		}
+		sb.WriteRune(sparkBlocks[idx])
+	}
+	return sb.String()
+}
+
+// BrailleChart renders values as a line chart of exactly height lines of
+// width braille characters. Each character holds two points horizontally,
+// so values are resampled to 2*width points. The chart is scaled between lo
+// and hi; NaN values break the line.
+func BrailleChart(values []float64, width, height int, lo, hi float64) []string {
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+
+	cols, rows := width*2, height*4
+	points := ResampleSeries(values, cols)
+
+	cells := make([][]rune, height)
+	for i := range cells {
+		cells[i] = make([]rune, width)
+	}
+
+	set := func(x, y int) {
+		// y counts dots from the bottom of the chart
+		row := rows - 1 - y
+		cells[row/4][x/2] |= brailleDots[x%2][row%4]
+	}
+
+	dotY := func(v float64) int {
+		if hi <= lo {
+			return rows / 2
+		}
+		y := int(math.Round((v - lo) / (hi - lo) * float64(rows-1)))
+		return max(0, min(rows-1, y))
+	}
+
+	prev := -1
+	for x, v := range points {
+		if math.IsNaN(v) {
+			prev = -1
+			continue
+		}
+		y := dotY(v)
+		// Fill the vertical gap from the previous point so steep changes
+		// stay connected
+		from, to := y, y
+		if prev >= 0 {
+			from, to = min(prev, y), max(prev, y)
+		}
+		for yy := from; yy <= to; yy++ {
+			set(x, yy)
+		}
+		prev = y
+	}
+
+	lines := make([]string, height)
+	for i, row := range cells {
+		var sb strings.Builder
+		for _, bits := range row {
+			sb.WriteRune(brailleBase + bits)
+		}
+		lines[i] = sb.String()
+	}
+	return lines
+}
+
+// ResampleSeries stretches or shrinks values to exactly n points. When
+// shrinking, each output point is the mean of the non-NaN values it covers
+// (NaN if there are none); when stretching, values are repeated.
+func ResampleSeries(values []float64, n int) []float64 {
+	out := make([]float64, n)
+	if len(values) == 0 {
+		for i := range out {
+			out[i] = math.NaN()
+		}
+		return out
+	}
+
+	for i := range out {
+		start := i * len(values) / n
+		end := (i + 1) * len(values) / n
+		if end <= start {
+			end = start + 1
+		}
+
+		sum, count := 0.0, 0
+		for _, v := range values[start:end] {
+			if !math.IsNaN(v) {
+				sum += v
+				count++
+			}
+		}
+		if count == 0 {
+			out[i] = math.NaN()
+		} else {
+			out[i] = sum / float64(count)
+		}
+	}
+	return out
+}
+
+// SeriesRange returns the smallest and largest non-NaN values. ok is false
+// when there are none.
+func SeriesRange(values []float64) (lo, hi float64, ok bool) {
+	lo, hi = math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		lo, hi = math.Min(lo, v), math.Max(hi, v)
+		ok = true
+	}
+	return lo, hi, ok
+}
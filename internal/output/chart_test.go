@@ -0,0 +1,94 @@
+package output
+
+import (
+	"math"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSparkline(t *testing.T) {
+	got := Sparkline([]float64{1, 2, 3, 4, 5, 6, 7, 8})
+	if got != "▁▂▃▄▅▆▇█" {
+		t.Errorf("expected full block range, got %q", got)
+	}
+}
+
+func TestSparkline_Gaps(t *testing.T) {
+	got := Sparkline([]float64{10, math.NaN(), 20})
+	if got != "▁ █" {
+		t.Errorf("expected NaN rendered as a gap, got %q", got)
+	}
+}
+
+func TestSparkline_Flat(t *testing.T) {
+	got := Sparkline([]float64{5, 5, 5})
+	if got != "▁▁▁" {
+		t.Errorf("expected flat line, got %q", got)
+	}
+}
+
+func TestSparkline_Empty(t *testing.T) {
+	got := Sparkline([]float64{math.NaN(), math.NaN()})
+	if got != "  " {
+		t.Errorf("expected blanks for all-NaN values, got %q", got)
+	}
+}
+
+func TestBrailleChart_Dimensions(t *testing.T) {
+	lines := BrailleChart([]float64{1, 5, 3, 9, 2}, 10, 4, 1, 9)
+
+	if len(lines) != 4 {
+		t.Fatalf("expected 4 lines, got %d", len(lines))
+	}
+	for i, line := range lines {
+		if n := utf8.RuneCountInString(line); n != 10 {
+			t.Errorf("line %d: expected 10 characters, got %d", i, n)
+		}
+	}
+}
+
+func TestBrailleChart_Extremes(t *testing.T) {
+	// Rising line: lowest dot on the bottom-left, highest on the top-right
+	lines := BrailleChart([]float64{0, 1}, 1, 2, 0, 1)
+
+	bottom := []rune(lines[1])[0] - brailleBase
+	top := []rune(lines[0])[0] - brailleBase
+	if bottom&brailleDots[0][3] == 0 {
+		t.Errorf("expected bottom-left dot set, got %08b", bottom)
+	}
+	if top&brailleDots[1][0] == 0 {
+		t.Errorf("expected top-right dot set, got %08b", top)
+	}
+}
+
+func TestBrailleChart_InvalidSize(t *testing.T) {
+	if lines := BrailleChart([]float64{1}, 0, 4, 0, 1); lines != nil {
+		t.Errorf("expected nil for zero width, got %v", lines)
+	}
+}
+
+func TestResampleSeries(t *testing.T) {
+	got := ResampleSeries([]float64{1, 3, math.NaN(), math.NaN(), 5, 7}, 3)
+	if got[0] != 2 || !math.IsNaN(got[1]) || got[2] != 6 {
+		t.Errorf("unexpected shrink result: %v", got)
+	}
+
+	got = ResampleSeries([]float64{1, 2}, 4)
+	want := []float64{1, 1, 2, 2}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("stretch[%d]: expected %v, got %v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestSeriesRange(t *testing.T) {
+	lo, hi, ok := SeriesRange([]float64{3, math.NaN(), 1, 7})
+	if !ok || lo != 1 || hi != 7 {
+		t.Errorf("expected 1..7, got %v..%v (ok=%v)", lo, hi, ok)
+	}
+
+	if _, _, ok := SeriesRange([]float64{math.NaN()}); ok {
+		t.Error("expected ok=false for all-NaN values")
+	}
+}
@@ -0,0 +1,166 @@
+package probecheck
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxBodyBytes limits how much of a response body is read for keyword and
+// JSONPath validation.
+const maxBodyBytes = 10 << 20
+
+// userAgent identifies local checks in server logs.
+const userAgent = "stackeye-cli-local-check"
+
+// checkHTTP performs an HTTP check, filling in the status code and
+// certificate expiry on result.
+func checkHTTP(ctx context.Context, cfg Config, result *Result) error {
+	method := strings.ToUpper(cfg.Method)
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	var body io.Reader
+	if cfg.Body != "" {
+		body = strings.NewReader(cfg.Body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, cfg.URL, body)
+	if err != nil {
+		return fmt.Errorf("invalid request: %w", err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	for k, v := range cfg.Headers {
+		if strings.EqualFold(k, "Host") {
+			req.Host = v
+			continue
+		}
+		req.Header.Set(k, v)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DisableKeepAlives = true
+	client := &http.Client{
+		Transport: transport,
+		CheckRedirect: func(_ *http.Request, via []*http.Request) error {
+			if !cfg.FollowRedirects {
+				return http.ErrUseLastResponse
+			}
+			if len(via) > cfg.MaxRedirects {
+				return fmt.Errorf("stopped after %d redirects (max_redirects)", cfg.MaxRedirects)
+			}
+			return nil
+		},
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, readErr := io.ReadAll(io.LimitReader(resp.Body, maxBodyBytes))
+
+	statusCode := resp.StatusCode
+	result.StatusCode = &statusCode
+	if resp.TLS != nil && len(resp.TLS.PeerCertificates) > 0 {
+		days := int(time.Until(resp.TLS.PeerCertificates[0].NotAfter).Hours() / 24)
+		result.SSLExpiryDays = &days
+	}
+
+	if readErr != nil {
+		return fmt.Errorf("failed to read response body: %w", readErr)
+	}
+
+	expected := cfg.ExpectedStatusCodes
+	if len(expected) == 0 {
+		expected = []int{http.StatusOK}
+	}
+	if !slices.Contains(expected, statusCode) {
+		return fmt.Errorf("unexpected status code %d (expected %s)", statusCode, formatStatusCodes(expected))
+	}
+
+	if cfg.KeywordCheck != "" {
+		if err := checkKeyword(string(data), cfg.KeywordCheck, cfg.KeywordCheckType); err != nil {
+			return err
+		}
+	}
+
+	if cfg.JSONPathCheck != "" {
+		if err := checkJSONPath(data, cfg.JSONPathCheck, cfg.JSONPathExpected); err != nil {
+			return err
+		}
+	}
+
+	if cfg.SSLCheckEnabled && cfg.SSLExpiryThresholdDays > 0 && result.SSLExpiryDays != nil &&
+		*result.SSLExpiryDays <= cfg.SSLExpiryThresholdDays {
+		return &degradedError{msg: fmt.Sprintf("SSL certificate expires in %d days (threshold %d)",
+			*result.SSLExpiryDays, cfg.SSLExpiryThresholdDays)}
+	}
+
+	return nil
+}
+
+// checkKeyword validates the response body against a keyword check.
+func checkKeyword(body, keyword, checkType string) error {
+	found := strings.Contains(body, keyword)
+	if checkType == "not_contains" {
+		if found {
+			return fmt.Errorf("response body contains keyword %q", keyword)
+		}
+		return nil
+	}
+	if !found {
+		return fmt.Errorf("response body does not contain keyword %q", keyword)
+	}
+	return nil
+}
+
+// checkJSONPath validates the response body against a JSONPath check.
+func checkJSONPath(body []byte, expr string, expected *string) error {
+	path, err := ParseJSONPath(expr)
+	if err != nil {
+		return err
+	}
+
+	var doc any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return fmt.Errorf("response body is not valid JSON: %w", err)
+	}
+
+	values := path.Evaluate(doc)
+	if len(values) == 0 {
+		return fmt.Errorf("JSONPath %s did not match any value", expr)
+	}
+	if expected == nil {
+		return nil
+	}
+	for _, v := range values {
+		if MatchesJSONValue(v, *expected) {
+			return nil
+		}
+	}
+	return fmt.Errorf("JSONPath %s is %q, expected %q", expr, FormatJSONValue(values[0]), *expected)
+}
+
+// formatStatusCodes joins status codes for error messages.
+func formatStatusCodes(codes []int) string {
+	parts := make([]string, len(codes))
+	for i, c := range codes {
+		parts[i] = strconv.Itoa(c)
+	}
+	return strings.Join(parts, ", ")
+}
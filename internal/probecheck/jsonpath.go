@@ -0,0 +1,231 @@
+package probecheck
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrUnsupportedJSONPath is returned by ParseJSONPath for syntactically valid
+// JSONPath features that local checks cannot evaluate, such as filters,
+// slices, unions and recursive descent.
+var ErrUnsupportedJSONPath = errors.New("unsupported JSONPath feature")
+
+// JSONPath is a parsed JSONPath expression.
+//
+// The supported subset covers what probe checks use in practice: the root
+// "$", dot and bracket member access ($.a.b, $['a-b']), array indexes
+// including negative ones ($.items[0], $.items[-1]) and wildcards ($.items[*],
+// $.data.*).
+type JSONPath struct {
+	expr     string
+	segments []jsonPathSegment
+}
+
+// jsonPathSegment is one step of a JSONPath expression.
+type jsonPathSegment struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// ParseJSONPath parses a JSONPath expression. Errors wrapping
+// ErrUnsupportedJSONPath indicate valid syntax outside the supported subset.
+func ParseJSONPath(expr string) (*JSONPath, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, errors.New("JSONPath expression is empty")
+	}
+	if expr[0] != '$' {
+		return nil, fmt.Errorf("JSONPath %q must start with \"$\"", expr)
+	}
+
+	p := &JSONPath{expr: expr}
+	i := 1
+	for i < len(expr) {
+		switch expr[i] {
+		case '.':
+			if i+1 < len(expr) && expr[i+1] == '.' {
+				return nil, fmt.Errorf("JSONPath %q: %w: recursive descent (..)", expr, ErrUnsupportedJSONPath)
+			}
+			i++
+			if i < len(expr) && expr[i] == '*' {
+				p.segments = append(p.segments, jsonPathSegment{wildcard: true})
+				i++
+				continue
+			}
+			start := i
+			for i < len(expr) && isJSONPathNameChar(expr[i]) {
+				i++
+			}
+			if start == i {
+				return nil, fmt.Errorf("JSONPath %q: expected a field name at offset %d", expr, start)
+			}
+			p.segments = append(p.segments, jsonPathSegment{key: expr[start:i]})
+
+		case '[':
+			seg, next, err := parseJSONPathBracket(expr, i)
+			if err != nil {
+				return nil, err
+			}
+			p.segments = append(p.segments, seg)
+			i = next
+
+		default:
+			return nil, fmt.Errorf("JSONPath %q: unexpected %q at offset %d", expr, expr[i], i)
+		}
+	}
+
+	return p, nil
+}
+
+// parseJSONPathBracket parses the bracket segment starting at expr[open] and
+// returns the segment and the offset just past the closing bracket.
+func parseJSONPathBracket(expr string, open int) (jsonPathSegment, int, error) {
+	i := open + 1
+	if i < len(expr) && (expr[i] == '\'' || expr[i] == '"') {
+		quote := expr[i]
+		end := strings.IndexByte(expr[i+1:], quote)
+		if end < 0 {
+			return jsonPathSegment{}, 0, fmt.Errorf("JSONPath %q: unterminated string at offset %d", expr, i)
+		}
+		key := expr[i+1 : i+1+end]
+		closing := i + 1 + end + 1
+		if closing >= len(expr) || expr[closing] != ']' {
+			return jsonPathSegment{}, 0, fmt.Errorf("JSONPath %q: expected \"]\" at offset %d", expr, closing)
+		}
+		return jsonPathSegment{key: key}, closing + 1, nil
+	}
+
+	end := strings.IndexByte(expr[i:], ']')
+	if end < 0 {
+		return jsonPathSegment{}, 0, fmt.Errorf("JSONPath %q: unterminated \"[\" at offset %d", expr, open)
+	}
+	inner := strings.TrimSpace(expr[i : i+end])
+	next := i + end + 1
+
+	switch {
+	case inner == "":
+		return jsonPathSegment{}, 0, fmt.Errorf("JSONPath %q: empty brackets at offset %d", expr, open)
+	case inner == "*":
+		return jsonPathSegment{wildcard: true}, next, nil
+	case strings.HasPrefix(inner, "?"), strings.HasPrefix(inner, "("):
+		return jsonPathSegment{}, 0, fmt.Errorf("JSONPath %q: %w: filter expression [%s]", expr, ErrUnsupportedJSONPath, inner)
+	case strings.Contains(inner, ":"):
+		return jsonPathSegment{}, 0, fmt.Errorf("JSONPath %q: %w: array slice [%s]", expr, ErrUnsupportedJSONPath, inner)
+	case strings.Contains(inner, ","):
+		return jsonPathSegment{}, 0, fmt.Errorf("JSONPath %q: %w: union [%s]", expr, ErrUnsupportedJSONPath, inner)
+	}
+
+	index, err := strconv.Atoi(inner)
+	if err != nil {
+		return jsonPathSegment{}, 0, fmt.Errorf("JSONPath %q: invalid array index %q", expr, inner)
+	}
+	return jsonPathSegment{index: index, isIndex: true}, next, nil
+}
+
+// isJSONPathNameChar reports whether c may appear in a dot-notation member name.
+func isJSONPathNameChar(c byte) bool {
+	return c == '_' || c == '-' || c == '$' ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') ||
+		c >= 0x80
+}
+
+// String returns the original expression.
+func (p *JSONPath) String() string {
+	return p.expr
+}
+
+// Evaluate returns every value in doc matched by the path. doc is a value
+// decoded by encoding/json into an interface{}.
+func (p *JSONPath) Evaluate(doc any) []any {
+	current := []any{doc}
+	for _, seg := range p.segments {
+		var next []any
+		for _, v := range current {
+			next = append(next, seg.apply(v)...)
+		}
+		if len(next) == 0 {
+			return nil
+		}
+		current = next
+	}
+	return current
+}
+
+// apply returns the values selected by the segment from v.
+func (s jsonPathSegment) apply(v any) []any {
+	switch node := v.(type) {
+	case map[string]any:
+		if s.wildcard {
+			keys := make([]string, 0, len(node))
+			for k := range node {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			values := make([]any, 0, len(keys))
+			for _, k := range keys {
+				values = append(values, node[k])
+			}
+			return values
+		}
+		if s.isIndex {
+			return nil
+		}
+		if child, ok := node[s.key]; ok {
+			return []any{child}
+		}
+	case []any:
+		if s.wildcard {
+			return node
+		}
+		if !s.isIndex {
+			return nil
+		}
+		i := s.index
+		if i < 0 {
+			i += len(node)
+		}
+		if i >= 0 && i < len(node) {
+			return []any{node[i]}
+		}
+	}
+	return nil
+}
+
+// FormatJSONValue renders a decoded JSON value the way it is compared with
+// an expected value: strings without quotes, numbers in shortest form and
+// objects and arrays as compact JSON.
+func FormatJSONValue(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return val
+	case bool:
+		return strconv.FormatBool(val)
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	default:
+		data, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Sprint(val)
+		}
+		return string(data)
+	}
+}
+
+// MatchesJSONValue reports whether a decoded JSON value equals expected.
+// Numbers compare numerically, so 1, 1.0 and 1e0 are equal.
+func MatchesJSONValue(v any, expected string) bool {
+	if num, ok := v.(float64); ok {
+		if want, err := strconv.ParseFloat(strings.TrimSpace(expected), 64); err == nil {
+			return num == want
+		}
+	}
+	return FormatJSONValue(v) == expected
+}
@@ -0,0 +1,113 @@
+package probecheck
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseJSONPath_Errors(t *testing.T) {
+	tests := []struct {
+		expr        string
+		unsupported bool
+	}{
+		{"", false},
+		{"status", false},
+		{"$.", false},
+		{"$.a[", false},
+		{"$.a[]", false},
+		{"$.a['b", false},
+		{"$.a[x]", false},
+		{"$.a b", false},
+		{"$..a", true},
+		{"$.a[?(@.b == 1)]", true},
+		{"$.a[0:2]", true},
+		{"$.a[0,1]", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := ParseJSONPath(tt.expr)
+			if err == nil {
+				t.Fatalf("ParseJSONPath(%q) succeeded, want error", tt.expr)
+			}
+			if got := errors.Is(err, ErrUnsupportedJSONPath); got != tt.unsupported {
+				t.Errorf("errors.Is(err, ErrUnsupportedJSONPath) = %v, want %v (err: %v)", got, tt.unsupported, err)
+			}
+		})
+	}
+}
+
+func TestJSONPath_Evaluate(t *testing.T) {
+	var doc any
+	body := `{
+		"status": "ok",
+		"version": 2,
+		"meta-data": {"region": "eu"},
+		"items": [{"id": 1}, {"id": 2}, {"id": 3}],
+		"flags": {"b": true, "a": false}
+	}`
+	if err := json.Unmarshal([]byte(body), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{"$", []string{`{"flags":{"a":false,"b":true},"items":[{"id":1},{"id":2},{"id":3}],"meta-data":{"region":"eu"},"status":"ok","version":2}`}},
+		{"$.status", []string{"ok"}},
+		{"$.version", []string{"2"}},
+		{"$.meta-data.region", []string{"eu"}},
+		{"$['meta-data']['region']", []string{"eu"}},
+		{`$["status"]`, []string{"ok"}},
+		{"$.items[0].id", []string{"1"}},
+		{"$.items[-1].id", []string{"3"}},
+		{"$.items[*].id", []string{"1", "2", "3"}},
+		{"$.flags.*", []string{"false", "true"}},
+		{"$.items[5]", nil},
+		{"$.missing", nil},
+		{"$.status.length", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			path, err := ParseJSONPath(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseJSONPath(%q) error: %v", tt.expr, err)
+			}
+			var got []string
+			for _, v := range path.Evaluate(doc) {
+				got = append(got, FormatJSONValue(v))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Evaluate(%q) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchesJSONValue(t *testing.T) {
+	tests := []struct {
+		value    any
+		expected string
+		want     bool
+	}{
+		{"ok", "ok", true},
+		{"ok", "OK", false},
+		{float64(1), "1", true},
+		{float64(1), "1.0", true},
+		{float64(1.5), "1.50", true},
+		{float64(2), "1", false},
+		{true, "true", true},
+		{nil, "null", true},
+		{"1", "1", true},
+	}
+
+	for _, tt := range tests {
+		if got := MatchesJSONValue(tt.value, tt.expected); got != tt.want {
+			t.Errorf("MatchesJSONValue(%v, %q) = %v, want %v", tt.value, tt.expected, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package probecheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// checkTCP opens and closes a TCP connection to target (host:port).
+func checkTCP(ctx context.Context, target string) error {
+	addr := target
+	if strings.Contains(target, "://") {
+		u, err := url.Parse(target)
+		if err != nil {
+			return fmt.Errorf("invalid target %q: %w", target, err)
+		}
+		addr = u.Host
+	}
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		return fmt.Errorf("TCP checks need a host:port target, got %q", target)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("connection failed: %w", err)
+	}
+	return conn.Close()
+}
+
+// checkDNS resolves the target host name.
+func checkDNS(ctx context.Context, target string) error {
+	host := targetHost(target)
+	if host == "" {
+		return fmt.Errorf("invalid target %q", target)
+	}
+
+	addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		return fmt.Errorf("DNS resolution failed: %w", err)
+	}
+	if len(addrs) == 0 {
+		return fmt.Errorf("DNS resolution for %s returned no addresses", host)
+	}
+	return nil
+}
+
+// pingRTTPattern extracts the round-trip time from ping output on Linux,
+// macOS and Windows ("time=12.3 ms", "time<1ms").
+var pingRTTPattern = regexp.MustCompile(`time[=<]\s*([0-9.]+)\s*ms`)
+
+// checkPing sends a single ICMP echo using the system ping command, which
+// holds the privileges needed for raw sockets, and returns the round-trip
+// time.
+func checkPing(ctx context.Context, target string) (time.Duration, error) {
+	host := targetHost(target)
+	if host == "" {
+		return 0, fmt.Errorf("invalid target %q", target)
+	}
+
+	countFlag := "-c"
+	if runtime.GOOS == "windows" {
+		countFlag = "-n"
+	}
+
+	out, err := exec.CommandContext(ctx, "ping", countFlag, "1", host).CombinedOutput()
+	if err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return 0, errors.New("ping checks need the system ping command, which was not found")
+		}
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		return 0, fmt.Errorf("host %s did not respond to ping: %s", host, lastLine(string(out)))
+	}
+
+	return parsePingRTT(string(out)), nil
+}
+
+// parsePingRTT returns the first round-trip time in ping output, or zero
+// if none is found.
+func parsePingRTT(out string) time.Duration {
+	m := pingRTTPattern.FindStringSubmatch(out)
+	if m == nil {
+		return 0
+	}
+	ms, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(ms * float64(time.Millisecond))
+}
+
+// targetHost extracts the host name from a URL, host:port or bare host.
+func targetHost(target string) string {
+	target = strings.TrimSpace(target)
+	if strings.Contains(target, "://") {
+		u, err := url.Parse(target)
+		if err != nil {
+			return ""
+		}
+		return u.Hostname()
+	}
+	if host, _, err := net.SplitHostPort(target); err == nil {
+		return host
+	}
+	return target
+}
+
+// lastLine returns the last non-empty line of s.
+func lastLine(s string) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
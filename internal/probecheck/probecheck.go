@@ -0,0 +1,131 @@
+// Package probecheck runs probe checks from the local machine.
+//
+// Checks follow the same rules as checks run from StackEye's monitoring
+// regions (expected status codes, keyword and JSONPath validation, redirect
+// limits and SSL expiry thresholds), so a probe definition can be tried
+// against internal or local services before it is created.
+//
+// Usage:
+//
+//	result := probecheck.Run(ctx, probecheck.Config{
+//		URL:                 "http://localhost:8080/health",
+//		ExpectedStatusCodes: []int{200},
+//		KeywordCheck:        "ok",
+//	})
+package probecheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Check result statuses, matching the statuses reported by the API.
+const (
+	StatusUp       = "up"
+	StatusDown     = "down"
+	StatusDegraded = "degraded"
+)
+
+// DefaultTimeout is used when Config.Timeout is not set.
+const DefaultTimeout = 10 * time.Second
+
+// Config describes a single check. The fields mirror the probe configuration.
+type Config struct {
+	// CheckType is http (default), tcp, dns_resolve or ping.
+	CheckType string
+	// URL is the target URL for HTTP checks, host:port for TCP checks and a
+	// host name (or URL) for DNS and ping checks.
+	URL     string
+	Method  string
+	Headers map[string]string
+	Body    string
+	Timeout time.Duration
+
+	// ExpectedStatusCodes defaults to 200 when empty.
+	ExpectedStatusCodes []int
+	KeywordCheck        string
+	// KeywordCheckType is contains (default) or not_contains.
+	KeywordCheckType string
+	JSONPathCheck    string
+	// JSONPathExpected is compared with the JSONPath result; when nil the
+	// path only has to match a value.
+	JSONPathExpected *string
+
+	FollowRedirects bool
+	MaxRedirects    int
+
+	SSLCheckEnabled        bool
+	SSLExpiryThresholdDays int
+}
+
+// Result is the outcome of a check.
+type Result struct {
+	Status         string
+	ResponseTimeMs int
+	StatusCode     *int
+	ErrorMessage   *string
+	SSLExpiryDays  *int
+	CheckedAt      time.Time
+}
+
+// degradedError marks a check that succeeded with a warning, such as a
+// certificate close to expiry.
+type degradedError struct {
+	msg string
+}
+
+func (e *degradedError) Error() string {
+	return e.msg
+}
+
+// Run executes the check described by cfg. Failures are reported in the
+// result rather than as an error.
+func Run(ctx context.Context, cfg Config) *Result {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result := &Result{Status: StatusUp, CheckedAt: time.Now().UTC()}
+	start := time.Now()
+
+	var rtt time.Duration
+	var err error
+	switch cfg.CheckType {
+	case "", "http":
+		err = checkHTTP(ctx, cfg, result)
+	case "tcp":
+		err = checkTCP(ctx, cfg.URL)
+	case "dns_resolve":
+		err = checkDNS(ctx, cfg.URL)
+	case "ping":
+		rtt, err = checkPing(ctx, cfg.URL)
+	default:
+		err = fmt.Errorf("unsupported check type %q", cfg.CheckType)
+	}
+
+	if rtt <= 0 {
+		rtt = time.Since(start)
+	}
+	result.ResponseTimeMs = int(rtt.Milliseconds())
+
+	if err != nil {
+		var degraded *degradedError
+		if errors.As(err, &degraded) {
+			result.Status = StatusDegraded
+		} else {
+			result.Status = StatusDown
+		}
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			err = fmt.Errorf("timed out after %s", timeout)
+		}
+		msg := err.Error()
+		result.ErrorMessage = &msg
+	}
+
+	return result
+}
@@ -0,0 +1,311 @@
+package probecheck
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"healthy","version":"1.2.0","checks":[{"name":"db","ok":true}]}`))
+	})
+	mux.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Method + " " + r.Header.Get("X-Token")))
+	})
+	mux.HandleFunc("/error", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+	})
+	mux.HandleFunc("/redirect/", func(w http.ResponseWriter, r *http.Request) {
+		n := strings.TrimPrefix(r.URL.Path, "/redirect/")
+		if n == "0" {
+			_, _ = w.Write([]byte("done"))
+			return
+		}
+		next := map[string]string{"3": "2", "2": "1", "1": "0"}[n]
+		http.Redirect(w, r, "/redirect/"+next, http.StatusFound)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestRun_HTTP(t *testing.T) {
+	srv := newTestServer(t)
+
+	tests := []struct {
+		name       string
+		cfg        Config
+		wantStatus string
+		wantCode   int
+		wantErr    string
+	}{
+		{
+			name:       "default expectations",
+			cfg:        Config{URL: srv.URL + "/health"},
+			wantStatus: StatusUp,
+			wantCode:   200,
+		},
+		{
+			name:       "unexpected status code",
+			cfg:        Config{URL: srv.URL + "/error"},
+			wantStatus: StatusDown,
+			wantCode:   503,
+			wantErr:    "unexpected status code 503 (expected 200)",
+		},
+		{
+			name:       "expected status codes",
+			cfg:        Config{URL: srv.URL + "/error", ExpectedStatusCodes: []int{200, 503}},
+			wantStatus: StatusUp,
+			wantCode:   503,
+		},
+		{
+			name:       "keyword contains",
+			cfg:        Config{URL: srv.URL + "/health", KeywordCheck: "healthy"},
+			wantStatus: StatusUp,
+			wantCode:   200,
+		},
+		{
+			name:       "keyword missing",
+			cfg:        Config{URL: srv.URL + "/health", KeywordCheck: "degraded"},
+			wantStatus: StatusDown,
+			wantCode:   200,
+			wantErr:    `does not contain keyword "degraded"`,
+		},
+		{
+			name:       "keyword not_contains",
+			cfg:        Config{URL: srv.URL + "/health", KeywordCheck: "healthy", KeywordCheckType: "not_contains"},
+			wantStatus: StatusDown,
+			wantCode:   200,
+			wantErr:    `contains keyword "healthy"`,
+		},
+		{
+			name:       "method and headers",
+			cfg:        Config{URL: srv.URL + "/echo", Method: "post", Headers: map[string]string{"X-Token": "abc"}, KeywordCheck: "POST abc"},
+			wantStatus: StatusUp,
+			wantCode:   200,
+		},
+		{
+			name:       "json path expected",
+			cfg:        Config{URL: srv.URL + "/health", JSONPathCheck: "$.version", JSONPathExpected: strPtr("1.2.0")},
+			wantStatus: StatusUp,
+			wantCode:   200,
+		},
+		{
+			name:       "json path wildcard",
+			cfg:        Config{URL: srv.URL + "/health", JSONPathCheck: "$.checks[*].ok", JSONPathExpected: strPtr("true")},
+			wantStatus: StatusUp,
+			wantCode:   200,
+		},
+		{
+			name:       "json path mismatch",
+			cfg:        Config{URL: srv.URL + "/health", JSONPathCheck: "$.version", JSONPathExpected: strPtr("2.0.0")},
+			wantStatus: StatusDown,
+			wantCode:   200,
+			wantErr:    `JSONPath $.version is "1.2.0", expected "2.0.0"`,
+		},
+		{
+			name:       "json path without match",
+			cfg:        Config{URL: srv.URL + "/health", JSONPathCheck: "$.uptime"},
+			wantStatus: StatusDown,
+			wantCode:   200,
+			wantErr:    "did not match any value",
+		},
+		{
+			name:       "json path on non-JSON body",
+			cfg:        Config{URL: srv.URL + "/echo", JSONPathCheck: "$.status"},
+			wantStatus: StatusDown,
+			wantCode:   200,
+			wantErr:    "not valid JSON",
+		},
+		{
+			name:       "redirects followed",
+			cfg:        Config{URL: srv.URL + "/redirect/3", FollowRedirects: true, MaxRedirects: 3},
+			wantStatus: StatusUp,
+			wantCode:   200,
+		},
+		{
+			name:       "redirect limit exceeded",
+			cfg:        Config{URL: srv.URL + "/redirect/3", FollowRedirects: true, MaxRedirects: 2},
+			wantStatus: StatusDown,
+			wantErr:    "stopped after 2 redirects",
+		},
+		{
+			name:       "redirects not followed",
+			cfg:        Config{URL: srv.URL + "/redirect/1", ExpectedStatusCodes: []int{302}},
+			wantStatus: StatusUp,
+			wantCode:   302,
+		},
+		{
+			name:       "timeout",
+			cfg:        Config{URL: srv.URL + "/slow", Timeout: 50 * time.Millisecond},
+			wantStatus: StatusDown,
+			wantErr:    "timed out after 50ms",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Run(context.Background(), tt.cfg)
+
+			if got.Status != tt.wantStatus {
+				t.Errorf("Status = %q, want %q (error: %v)", got.Status, tt.wantStatus, derefString(got.ErrorMessage))
+			}
+			if tt.wantCode != 0 {
+				if got.StatusCode == nil || *got.StatusCode != tt.wantCode {
+					t.Errorf("StatusCode = %v, want %d", derefInt(got.StatusCode), tt.wantCode)
+				}
+			}
+			if tt.wantErr == "" {
+				if got.ErrorMessage != nil {
+					t.Errorf("ErrorMessage = %q, want none", *got.ErrorMessage)
+				}
+			} else if got.ErrorMessage == nil || !strings.Contains(*got.ErrorMessage, tt.wantErr) {
+				t.Errorf("ErrorMessage = %q, want it to contain %q", derefString(got.ErrorMessage), tt.wantErr)
+			}
+			if got.CheckedAt.IsZero() {
+				t.Error("CheckedAt should be set")
+			}
+		})
+	}
+}
+
+func TestRun_HTTPS_UntrustedCertificate(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	t.Cleanup(srv.Close)
+
+	// The test server's certificate is not trusted, so the check fails, but
+	// the failure must come from certificate verification.
+	got := Run(context.Background(), Config{URL: srv.URL})
+	if got.Status != StatusDown {
+		t.Fatalf("Status = %q, want %q", got.Status, StatusDown)
+	}
+	if got.ErrorMessage == nil || !strings.Contains(*got.ErrorMessage, "certificate") {
+		t.Errorf("ErrorMessage = %q, want a certificate error", derefString(got.ErrorMessage))
+	}
+}
+
+func TestRun_TCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.Close()
+		}
+	}()
+
+	got := Run(context.Background(), Config{CheckType: "tcp", URL: addr})
+	if got.Status != StatusUp {
+		t.Errorf("open port: Status = %q, want %q (error: %v)", got.Status, StatusUp, derefString(got.ErrorMessage))
+	}
+
+	got = Run(context.Background(), Config{CheckType: "tcp", URL: "tcp://" + addr})
+	if got.Status != StatusUp {
+		t.Errorf("tcp:// URL: Status = %q, want %q (error: %v)", got.Status, StatusUp, derefString(got.ErrorMessage))
+	}
+
+	_ = ln.Close()
+	got = Run(context.Background(), Config{CheckType: "tcp", URL: addr})
+	if got.Status != StatusDown {
+		t.Errorf("closed port: Status = %q, want %q", got.Status, StatusDown)
+	}
+
+	got = Run(context.Background(), Config{CheckType: "tcp", URL: "localhost"})
+	if got.ErrorMessage == nil || !strings.Contains(*got.ErrorMessage, "host:port") {
+		t.Errorf("missing port: ErrorMessage = %q, want host:port hint", derefString(got.ErrorMessage))
+	}
+}
+
+func TestRun_DNS(t *testing.T) {
+	got := Run(context.Background(), Config{CheckType: "dns_resolve", URL: "localhost"})
+	if got.Status != StatusUp {
+		t.Errorf("Status = %q, want %q (error: %v)", got.Status, StatusUp, derefString(got.ErrorMessage))
+	}
+
+	got = Run(context.Background(), Config{CheckType: "dns_resolve", URL: "name.invalid"})
+	if got.Status != StatusDown {
+		t.Errorf("invalid name: Status = %q, want %q", got.Status, StatusDown)
+	}
+}
+
+func TestRun_UnsupportedCheckType(t *testing.T) {
+	got := Run(context.Background(), Config{CheckType: "smtp", URL: "mail.example.com"})
+	if got.Status != StatusDown {
+		t.Errorf("Status = %q, want %q", got.Status, StatusDown)
+	}
+	if got.ErrorMessage == nil || !strings.Contains(*got.ErrorMessage, `unsupported check type "smtp"`) {
+		t.Errorf("ErrorMessage = %q", derefString(got.ErrorMessage))
+	}
+}
+
+func TestParsePingRTT(t *testing.T) {
+	tests := []struct {
+		name string
+		out  string
+		want time.Duration
+	}{
+		{"linux", "64 bytes from 127.0.0.1: icmp_seq=1 ttl=64 time=0.045 ms", 45 * time.Microsecond},
+		{"macos", "64 bytes from 1.1.1.1: icmp_seq=0 ttl=57 time=12.345 ms", 12345 * time.Microsecond},
+		{"windows", "Reply from 1.1.1.1: bytes=32 time=14ms TTL=57", 14 * time.Millisecond},
+		{"windows sub-millisecond", "Reply from 127.0.0.1: bytes=32 time<1ms TTL=128", time.Millisecond},
+		{"no reply", "Request timed out.", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parsePingRTT(tt.out); got != tt.want {
+				t.Errorf("parsePingRTT() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTargetHost(t *testing.T) {
+	tests := map[string]string{
+		"example.com":              "example.com",
+		"example.com:443":          "example.com",
+		"https://example.com/path": "example.com",
+		"[::1]:53":                 "::1",
+	}
+	for in, want := range tests {
+		if got := targetHost(in); got != want {
+			t.Errorf("targetHost(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return "<nil>"
+	}
+	return *s
+}
+
+func derefInt(i *int) any {
+	if i == nil {
+		return "<nil>"
+	}
+	return *i
+}
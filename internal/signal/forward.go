@@ -0,0 +1,61 @@
+package signal
+
+import (
+	"os"
+	ossignal "os/signal"
+	"sync/atomic"
+	"syscall"
+)
+
+// childSignaled records that a SIGINT or SIGTERM arrived while a child
+// process was running under Forward. The child decides how to exit, so
+// Handler.ExitCode returns the command exit code instead of the signal code.
+var childSignaled atomic.Bool
+
+// inForeground reports whether the CLI is in the foreground process group of
+// its controlling terminal. Replaced in tests.
+var inForeground = terminalForeground
+
+// Forward relays SIGINT and SIGTERM received by the CLI to proc until the
+// returned stop function is called. Use it while the CLI waits on a child
+// process, so the child can shut down cleanly before the CLI exits.
+//
+// The child shares the CLI's process group, so a Ctrl+C typed in the terminal
+// already reaches it. SIGINT is therefore only relayed when the CLI is not in
+// the terminal's foreground, e.g. when it was sent with kill; many tools treat
+// a second SIGINT as a request to force quit.
+func Forward(proc *os.Process) (stop func()) {
+	sigCh := make(chan os.Signal, 1)
+	ossignal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+
+	done := make(chan struct{})
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		for {
+			select {
+			case sig := <-sigCh:
+				childSignaled.Store(true)
+				if sig == syscall.SIGINT && inForeground() {
+					continue
+				}
+				// The child may already have exited; nothing to do then
+				_ = proc.Signal(sig)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		ossignal.Stop(sigCh)
+		close(done)
+		<-exited
+		// A signal delivered just before Stop may not have been read yet
+		select {
+		case <-sigCh:
+			childSignaled.Store(true)
+		default:
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package signal
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestForward_RelaysSignalToChild(t *testing.T) {
+	child := exec.Command("sleep", "10")
+	if err := child.Start(); err != nil {
+		t.Skipf("cannot start child process: %v", err)
+	}
+
+	stop := Forward(child.Process)
+	defer stop()
+	defer childSignaled.Store(false)
+
+	if err := syscall.Kill(syscall.Getpid(), syscall.SIGTERM); err != nil {
+		t.Fatalf("failed to send SIGTERM: %v", err)
+	}
+
+	waitErr := make(chan error, 1)
+	go func() { waitErr <- child.Wait() }()
+
+	select {
+	case err := <-waitErr:
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			t.Fatalf("expected child to be terminated by a signal, got %v", err)
+		}
+		status, ok := exitErr.Sys().(syscall.WaitStatus)
+		if ok && status.Signal() != syscall.SIGTERM {
+			t.Errorf("child terminated by %v; want SIGTERM", status.Signal())
+		}
+	case <-time.After(2 * time.Second):
+		_ = child.Process.Kill()
+		t.Fatal("child did not exit within 2 seconds after SIGTERM")
+	}
+}
+
+func TestForward_Stop(t *testing.T) {
+	child := exec.Command("sleep", "10")
+	if err := child.Start(); err != nil {
+		t.Skipf("cannot start child process: %v", err)
+	}
+	defer func() {
+		_ = child.Process.Kill()
+		_ = child.Wait()
+	}()
+
+	stop := Forward(child.Process)
+	stop()
+}
+
+// startSIGINTCounter starts a shell that appends a line to a file for every
+// SIGINT it receives, and waits until its trap is installed.
+func startSIGINTCounter(t *testing.T) (*exec.Cmd, string) {
+	t.Helper()
+	dir := t.TempDir()
+	countFile := filepath.Join(dir, "count")
+	readyFile := filepath.Join(dir, "ready")
+
+	child := exec.Command("sh", "-c", `trap 'echo INT >> "$1"' INT; touch "$2"; while :; do sleep 0.05; done`,
+		"sh", countFile, readyFile)
+	if err := child.Start(); err != nil {
+		t.Skipf("cannot start child process: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = child.Process.Kill()
+		_ = child.Wait()
+	})
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if _, err := os.Stat(readyFile); err == nil {
+			return child, countFile
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("child did not start within 2 seconds")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// countSIGINTs returns how many SIGINTs the child has recorded.
+func countSIGINTs(t *testing.T, countFile string) int {
+	t.Helper()
+	data, err := os.ReadFile(countFile)
+	if os.IsNotExist(err) {
+		return 0
+	}
+	if err != nil {
+		t.Fatalf("failed to read count file: %v", err)
+	}
+	return strings.Count(string(data), "INT")
+}
+
+// waitForSIGINTs waits until the child has recorded at least n SIGINTs.
+func waitForSIGINTs(t *testing.T, countFile string, n int) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for countSIGINTs(t, countFile) < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("child did not record %d SIGINT(s) within 2 seconds", n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestForward_SIGINTCount(t *testing.T) {
+	tests := []struct {
+		name       string
+		foreground bool
+	}{
+		// Ctrl+C: the terminal signals the whole process group
+		{"terminal foreground", true},
+		// kill -INT <pid>: only the CLI is signaled
+		{"not in foreground", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			originalForeground := inForeground
+			defer func() { inForeground = originalForeground }()
+			inForeground = func() bool { return tt.foreground }
+			defer childSignaled.Store(false)
+
+			child, countFile := startSIGINTCounter(t)
+			stop := Forward(child.Process)
+
+			if tt.foreground {
+				// Let the child record its copy first; standard signals that
+				// arrive together are merged into one
+				if err := child.Process.Signal(syscall.SIGINT); err != nil {
+					t.Fatalf("failed to send SIGINT to child: %v", err)
+				}
+				waitForSIGINTs(t, countFile, 1)
+			}
+			if err := syscall.Kill(syscall.Getpid(), syscall.SIGINT); err != nil {
+				t.Fatalf("failed to send SIGINT: %v", err)
+			}
+
+			time.Sleep(500 * time.Millisecond)
+			stop()
+
+			if got := countSIGINTs(t, countFile); got != 1 {
+				t.Errorf("child received %d SIGINT(s); want 1", got)
+			}
+			if !childSignaled.Load() {
+				t.Error("expected the signal to be recorded as handed to the child")
+			}
+		})
+	}
+}
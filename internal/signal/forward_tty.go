@@ -0,0 +1,27 @@
+//go:build darwin || linux
+
+package signal
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// terminalForeground reports whether the CLI's process group is the
+// foreground process group of its controlling terminal, in which case
+// terminal-generated signals reach every process in the group.
+func terminalForeground() bool {
+	tty, err := os.Open("/dev/tty")
+	if err != nil {
+		// No controlling terminal
+		return false
+	}
+	defer tty.Close()
+
+	pgrp, err := unix.IoctlGetInt(int(tty.Fd()), unix.TIOCGPGRP)
+	if err != nil {
+		return false
+	}
+	return pgrp == unix.Getpgrp()
+}
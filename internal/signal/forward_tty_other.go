@@ -0,0 +1,9 @@
+//go:build !darwin && !linux
+
+package signal
+
+// terminalForeground always reports false on platforms without process
+// groups, so every signal is relayed to the child.
+func terminalForeground() bool {
+	return false
+}
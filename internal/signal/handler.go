@@ -96,11 +96,12 @@ func (h *Handler) Signal() os.Signal {
 }
 
 // ExitCode returns the appropriate exit code. If a signal was caught, it returns
-// the POSIX signal exit code (130 for SIGINT, 143 for SIGTERM). Otherwise it
+// the POSIX signal exit code (130 for SIGINT, 143 for SIGTERM). Otherwise, or
+// if the signal arrived while a child process was running under Forward, it
 // returns the provided command exit code unchanged.
 func (h *Handler) ExitCode(cmdExitCode int) int {
 	sig := h.Signal()
-	if sig == nil {
+	if sig == nil || childSignaled.Load() {
 		return cmdExitCode
 	}
 	switch sig {
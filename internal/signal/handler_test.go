@@ -140,3 +140,16 @@ func TestExitCodeConstants(t *testing.T) {
 		t.Errorf("errors.ExitSIGTERM = %d; want 143", errors.ExitSIGTERM)
 	}
 }
+
+func TestHandler_ExitCodeAfterChildSignaled(t *testing.T) {
+	_, h := Setup()
+	defer h.Cancel()
+	defer childSignaled.Store(false)
+
+	h.caught.Store(syscall.SIGINT)
+	childSignaled.Store(true)
+
+	if got := h.ExitCode(3); got != 3 {
+		t.Errorf("ExitCode(3) = %d; want the child's exit code 3", got)
+	}
+}
@@ -0,0 +1,151 @@
+package tui
+
+import (
+	"context"
+	"io"
+	"unicode/utf8"
+)
+
+// KeyType identifies a decoded key press.
+type KeyType int
+
+// Key types produced by DecodeKeys. KeyRune carries the typed character in
+// Key.Rune.
+const (
+	KeyRune KeyType = iota
+	KeyUp
+	KeyDown
+	KeyLeft
+	KeyRight
+	KeyTab
+	KeyBackTab
+	KeyEnter
+	KeyEscape
+	KeyBackspace
+	KeyPageUp
+	KeyPageDown
+	KeyHome
+	KeyEnd
+	KeyCtrlC
+)
+
+// Key is a single decoded key press.
+type Key struct {
+	Type KeyType
+	Rune rune
+}
+
+// ReadKeys decodes key presses from r until the context is canceled or the
+// reader fails, delivering them on the returned channel. The channel is
+// closed when reading stops.
+func ReadKeys(ctx context.Context, r io.Reader) <-chan Key {
+	keys := make(chan Key)
+
+	go func() {
+		defer close(keys)
+		buf := make([]byte, 64)
+		for {
+			n, err := r.Read(buf)
+			if n > 0 {
+				for _, k := range DecodeKeys(buf[:n]) {
+					select {
+					case keys <- k:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return keys
+}
+
+// escapeSequences maps CSI/SS3 sequences (without the leading ESC) to keys.
+var escapeSequences = map[string]KeyType{
+	"[A":  KeyUp,
+	"[B":  KeyDown,
+	"[C":  KeyRight,
+	"[D":  KeyLeft,
+	"OA":  KeyUp,
+	"OB":  KeyDown,
+	"OC":  KeyRight,
+	"OD":  KeyLeft,
+	"[Z":  KeyBackTab,
+	"[5~": KeyPageUp,
+	"[6~": KeyPageDown,
+	"[H":  KeyHome,
+	"[F":  KeyEnd,
+	"[1~": KeyHome,
+	"[4~": KeyEnd,
+}
+
+// DecodeKeys decodes one read's worth of raw terminal input into key presses.
+// Unknown escape sequences are dropped; a lone ESC is reported as KeyEscape.
+func DecodeKeys(b []byte) []Key {
+	var keys []Key
+
+	for len(b) > 0 {
+		switch c := b[0]; {
+		case c == 0x1b:
+			if len(b) == 1 {
+				keys = append(keys, Key{Type: KeyEscape})
+				b = b[1:]
+				continue
+			}
+			consumed, key, ok := decodeEscape(b[1:])
+			if ok {
+				keys = append(keys, key)
+			}
+			b = b[1+consumed:]
+		case c == 0x03:
+			keys = append(keys, Key{Type: KeyCtrlC})
+			b = b[1:]
+		case c == '\t':
+			keys = append(keys, Key{Type: KeyTab})
+			b = b[1:]
+		case c == '\r' || c == '\n':
+			keys = append(keys, Key{Type: KeyEnter})
+			b = b[1:]
+		case c == 0x7f || c == 0x08:
+			keys = append(keys, Key{Type: KeyBackspace})
+			b = b[1:]
+		case c < 0x20:
+			// Ignore other control characters
+			b = b[1:]
+		default:
+			r, size := utf8.DecodeRune(b)
+			keys = append(keys, Key{Type: KeyRune, Rune: r})
+			b = b[size:]
+		}
+	}
+
+	return keys
+}
+
+// decodeEscape decodes the bytes following an ESC. It returns how many bytes
+// were consumed and whether they formed a known key.
+func decodeEscape(b []byte) (int, Key, bool) {
+	if b[0] != '[' && b[0] != 'O' {
+		// ESC followed by a regular key (e.g. Alt+key): report ESC only
+		return 0, Key{Type: KeyEscape}, true
+	}
+
+	// A CSI sequence ends with a byte in the range 0x40-0x7e
+	end := 1
+	for end < len(b) && (b[end] < 0x40 || b[end] > 0x7e) {
+		end++
+	}
+	if end == len(b) {
+		return len(b), Key{}, false
+	}
+
+	seq := string(b[:end+1])
+	if t, ok := escapeSequences[seq]; ok {
+		return end + 1, Key{Type: t}, true
+	}
+	return end + 1, Key{}, false
+}
@@ -0,0 +1,62 @@
+package tui
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestDecodeKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []Key
+	}{
+		{"runes", "qj", []Key{{Type: KeyRune, Rune: 'q'}, {Type: KeyRune, Rune: 'j'}}},
+		{"unicode rune", "é", []Key{{Type: KeyRune, Rune: 'é'}}},
+		{"arrows", "\x1b[A\x1b[B\x1b[C\x1b[D", []Key{{Type: KeyUp}, {Type: KeyDown}, {Type: KeyRight}, {Type: KeyLeft}}},
+		{"ss3 arrows", "\x1bOA\x1bOB", []Key{{Type: KeyUp}, {Type: KeyDown}}},
+		{"back tab", "\x1b[Z", []Key{{Type: KeyBackTab}}},
+		{"paging", "\x1b[5~\x1b[6~", []Key{{Type: KeyPageUp}, {Type: KeyPageDown}}},
+		{"home end", "\x1b[H\x1b[F\x1b[1~\x1b[4~", []Key{{Type: KeyHome}, {Type: KeyEnd}, {Type: KeyHome}, {Type: KeyEnd}}},
+		{"lone escape", "\x1b", []Key{{Type: KeyEscape}}},
+		{"alt key", "\x1bx", []Key{{Type: KeyEscape}, {Type: KeyRune, Rune: 'x'}}},
+		{"control keys", "\t\r\x7f\x03", []Key{{Type: KeyTab}, {Type: KeyEnter}, {Type: KeyBackspace}, {Type: KeyCtrlC}}},
+		{"unknown sequence dropped", "\x1b[99~a", []Key{{Type: KeyRune, Rune: 'a'}}},
+		{"other control ignored", "\x01a", []Key{{Type: KeyRune, Rune: 'a'}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DecodeKeys([]byte(tt.input))
+			if len(got) != len(tt.want) {
+				t.Fatalf("DecodeKeys(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("key %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestReadKeys(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var got []Key
+	for k := range ReadKeys(ctx, strings.NewReader("ab\x1b[A")) {
+		got = append(got, k)
+	}
+
+	want := []Key{{Type: KeyRune, Rune: 'a'}, {Type: KeyRune, Rune: 'b'}, {Type: KeyUp}}
+	if len(got) != len(want) {
+		t.Fatalf("ReadKeys = %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("key %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package tui
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Style is the color applied to a whole pane line.
+type Style int
+
+// Line styles. Colors are only emitted when the pane has Color set.
+const (
+	StyleNormal Style = iota
+	StyleGood
+	StyleWarning
+	StyleBad
+	StyleMuted
+	StyleHeader
+)
+
+// styleCodes maps styles to ANSI SGR codes.
+var styleCodes = map[Style]string{
+	StyleGood:    "32",
+	StyleWarning: "33",
+	StyleBad:     "31",
+	StyleMuted:   "90",
+	StyleHeader:  "1",
+}
+
+// Line is one row of pane content.
+type Line struct {
+	Text  string
+	Style Style
+}
+
+// Pane is a bordered box with a title and scrollable lines. When Focused, the
+// border is highlighted and the Selected line (if >= 0) is shown in reverse
+// video and kept in view.
+type Pane struct {
+	Title    string
+	Header   string // optional column header, not scrolled or selectable
+	Lines    []Line
+	Selected int
+	Focused  bool
+	Color    bool
+	Empty    string // shown when there are no lines
+}
+
+// Render draws the pane into exactly height lines of exactly width columns.
+func (p *Pane) Render(width, height int) []string {
+	if width < 4 || height < 2 {
+		return blankLines(width, height)
+	}
+	inner := width - 2
+
+	border := "─"
+	if p.Focused {
+		border = "━"
+	}
+
+	title := ""
+	if p.Title != "" {
+		title = " " + p.Title + " "
+	}
+	top := "┌" + Fit(border+title+strings.Repeat(border, inner), inner) + "┐"
+	bottom := "└" + strings.Repeat(border, inner) + "┘"
+	if p.Focused && p.Color {
+		top = colorize("1", top)
+	}
+
+	lines := []string{top}
+	rows := height - 2
+
+	if p.Header != "" && rows > 0 {
+		lines = append(lines, "│"+p.style(Fit(p.Header, inner), StyleHeader)+"│")
+		rows--
+	}
+
+	if len(p.Lines) == 0 && p.Empty != "" && rows > 0 {
+		lines = append(lines, "│"+p.style(Fit(p.Empty, inner), StyleMuted)+"│")
+		rows--
+	}
+
+	offset := 0
+	if p.Selected >= rows {
+		offset = p.Selected - rows + 1
+	}
+
+	for i := 0; i < rows; i++ {
+		idx := offset + i
+		if idx >= len(p.Lines) {
+			lines = append(lines, "│"+strings.Repeat(" ", inner)+"│")
+			continue
+		}
+		line := p.Lines[idx]
+		text := Fit(line.Text, inner)
+		if p.Focused && idx == p.Selected {
+			if p.Color {
+				text = colorize("7", text)
+			} else {
+				text = "> " + Fit(line.Text, inner-2)
+			}
+		} else {
+			text = p.style(text, line.Style)
+		}
+		lines = append(lines, "│"+text+"│")
+	}
+
+	return append(lines, bottom)
+}
+
+// style applies a line style when colors are enabled.
+func (p *Pane) style(text string, s Style) string {
+	if !p.Color {
+		return text
+	}
+	code, ok := styleCodes[s]
+	if !ok {
+		return text
+	}
+	return colorize(code, text)
+}
+
+// colorize wraps text in an ANSI SGR sequence.
+func colorize(code, text string) string {
+	return "\033[" + code + "m" + text + "\033[0m"
+}
+
+// Fit truncates or pads plain text to exactly width runes. Truncated text
+// ends with "…".
+func Fit(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	n := utf8.RuneCountInString(s)
+	if n == width {
+		return s
+	}
+	if n < width {
+		return s + strings.Repeat(" ", width-n)
+	}
+
+	runes := []rune(s)
+	if width == 1 {
+		return string(runes[:1])
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+// HStack places blocks of lines side by side. Blocks shorter than the
+// tallest are padded with spaces of the given widths.
+func HStack(widths []int, blocks ...[]string) []string {
+	height := 0
+	for _, b := range blocks {
+		if len(b) > height {
+			height = len(b)
+		}
+	}
+
+	lines := make([]string, height)
+	for i := range lines {
+		var sb strings.Builder
+		for j, b := range blocks {
+			if i < len(b) {
+				sb.WriteString(b[i])
+			} else if j < len(widths) {
+				sb.WriteString(strings.Repeat(" ", widths[j]))
+			}
+		}
+		lines[i] = sb.String()
+	}
+	return lines
+}
+
+// blankLines returns height lines of width spaces.
+func blankLines(width, height int) []string {
+	if width < 0 {
+		width = 0
+	}
+	lines := make([]string, 0, height)
+	for i := 0; i < height; i++ {
+		lines = append(lines, strings.Repeat(" ", width))
+	}
+	return lines
+}
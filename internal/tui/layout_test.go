@@ -0,0 +1,107 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		s     string
+		width int
+		want  string
+	}{
+		{"abc", 5, "abc  "},
+		{"abc", 3, "abc"},
+		{"abcdef", 4, "abc…"},
+		{"héllo", 3, "hé…"},
+		{"abc", 1, "a"},
+		{"abc", 0, ""},
+	}
+
+	for _, tt := range tests {
+		if got := Fit(tt.s, tt.width); got != tt.want {
+			t.Errorf("Fit(%q, %d) = %q, want %q", tt.s, tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestPaneRender_Dimensions(t *testing.T) {
+	pane := &Pane{
+		Title:  "Probes",
+		Header: "NAME",
+		Lines:  []Line{{Text: "one"}, {Text: "a very long line that will not fit in the pane"}},
+	}
+
+	lines := pane.Render(20, 6)
+	if len(lines) != 6 {
+		t.Fatalf("expected 6 lines, got %d", len(lines))
+	}
+	for i, line := range lines {
+		if n := utf8.RuneCountInString(line); n != 20 {
+			t.Errorf("line %d has width %d, want 20: %q", i, n, line)
+		}
+	}
+	if !strings.Contains(lines[0], "Probes") {
+		t.Errorf("expected title in top border, got %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "NAME") {
+		t.Errorf("expected header on second line, got %q", lines[1])
+	}
+}
+
+func TestPaneRender_Empty(t *testing.T) {
+	pane := &Pane{Title: "Alerts", Empty: "No active alerts"}
+
+	lines := pane.Render(30, 4)
+	if !strings.Contains(lines[1], "No active alerts") {
+		t.Errorf("expected empty message, got %q", lines[1])
+	}
+}
+
+func TestPaneRender_SelectionScrollsIntoView(t *testing.T) {
+	var content []Line
+	for _, s := range []string{"a", "b", "c", "d", "e", "f"} {
+		content = append(content, Line{Text: s})
+	}
+	pane := &Pane{Lines: content, Selected: 5, Focused: true}
+
+	// 3 content rows: lines d, e, f with f selected
+	lines := pane.Render(10, 5)
+	if !strings.HasPrefix(lines[3], "│> f") {
+		t.Errorf("expected selected line f to be visible with marker, got %q", lines[3])
+	}
+	if !strings.HasPrefix(lines[1], "│d") {
+		t.Errorf("expected view to scroll to d, got %q", lines[1])
+	}
+}
+
+func TestPaneRender_Color(t *testing.T) {
+	pane := &Pane{Lines: []Line{{Text: "down", Style: StyleBad}}, Selected: -1, Color: true}
+
+	lines := pane.Render(10, 3)
+	if !strings.Contains(lines[1], "\033[31m") {
+		t.Errorf("expected red line, got %q", lines[1])
+	}
+
+	pane.Color = false
+	lines = pane.Render(10, 3)
+	if strings.Contains(lines[1], "\033[") {
+		t.Errorf("expected no escape codes without color, got %q", lines[1])
+	}
+}
+
+func TestHStack(t *testing.T) {
+	got := HStack([]int{2, 3}, []string{"ab", "cd"}, []string{"xyz"})
+	want := []string{"abxyz", "cd   "}
+
+	if len(got) != len(want) {
+		t.Fatalf("HStack = %q, want %q", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Package tui provides minimal full-screen terminal primitives for the
+// StackEye CLI: raw-mode input, an alternate screen, key decoding and helpers
+// for laying out bordered panes.
+//
+// It deliberately avoids a widget framework. Commands keep their own state,
+// render it to a slice of lines with the layout helpers and hand the frame to
+// Terminal.Draw.
+//
+// Usage:
+//
+//	t, err := tui.Open()
+//	if err != nil {
+//	    return err
+//	}
+//	defer t.Close()
+//	keys := tui.ReadKeys(ctx, t.Input())
+//	width, height := t.Size()
+//	t.Draw(render(width, height))
+package tui
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/term"
+)
+
+// ANSI control sequences used by the terminal.
+const (
+	enterAltScreen = "\033[?1049h"
+	exitAltScreen  = "\033[?1049l"
+	hideCursor     = "\033[?25l"
+	showCursor     = "\033[?25h"
+	cursorHome     = "\033[H"
+	clearLine      = "\033[K"
+	clearBelow     = "\033[J"
+)
+
+// Terminal is a full-screen terminal session in raw mode on the alternate
+// screen buffer. Close must be called to restore the user's terminal.
+type Terminal struct {
+	in       *os.File
+	out      *bufio.Writer
+	outFd    int
+	oldState *term.State
+	once     sync.Once
+}
+
+// Open switches stdin to raw mode and stdout to the alternate screen. It
+// returns an error when stdin or stdout is not a terminal.
+func Open() (*Terminal, error) {
+	inFd := int(os.Stdin.Fd())
+	outFd := int(os.Stdout.Fd())
+	if !term.IsTerminal(inFd) || !term.IsTerminal(outFd) {
+		return nil, fmt.Errorf("an interactive terminal is required")
+	}
+
+	oldState, err := term.MakeRaw(inFd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enable raw terminal mode: %w", err)
+	}
+
+	t := &Terminal{
+		in:       os.Stdin,
+		out:      bufio.NewWriter(os.Stdout),
+		outFd:    outFd,
+		oldState: oldState,
+	}
+	_, _ = t.out.WriteString(enterAltScreen + hideCursor)
+	_ = t.out.Flush()
+	return t, nil
+}
+
+// Close leaves the alternate screen and restores the original terminal mode.
+// It is safe to call more than once.
+func (t *Terminal) Close() {
+	t.once.Do(func() {
+		_, _ = t.out.WriteString(showCursor + exitAltScreen)
+		_ = t.out.Flush()
+		_ = term.Restore(int(t.in.Fd()), t.oldState)
+	})
+}
+
+// Input returns the raw-mode input stream for ReadKeys.
+func (t *Terminal) Input() io.Reader {
+	return t.in
+}
+
+// Size returns the terminal width and height, falling back to 80x24 when the
+// size cannot be determined.
+func (t *Terminal) Size() (int, int) {
+	width, height, err := term.GetSize(t.outFd)
+	if err != nil || width <= 0 || height <= 0 {
+		return 80, 24
+	}
+	return width, height
+}
+
+// Draw replaces the screen contents with lines. Lines must already fit the
+// terminal width; in raw mode each line is terminated with "\r\n".
+func (t *Terminal) Draw(lines []string) {
+	var b strings.Builder
+	b.WriteString(cursorHome)
+	for i, line := range lines {
+		if i > 0 {
+			b.WriteString("\r\n")
+		}
+		b.WriteString(line)
+		b.WriteString(clearLine)
+	}
+	b.WriteString(clearBelow)
+
+	_, _ = t.out.WriteString(b.String())
+	_ = t.out.Flush()
+}